@@ -0,0 +1,489 @@
+// Package buildtsm converts line protocol or CSV into TSM files offline, to be
+// attached to a running server with "influxd import-tsm".
+package buildtsm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+const (
+	// DefaultShardDuration is the default time range of each output directory.
+	DefaultShardDuration = 7 * 24 * time.Hour
+
+	// DefaultMaxBufferedPoints is the default number of values held in memory
+	// before they are written out to TSM files.
+	DefaultMaxBufferedPoints = 10000000
+
+	// windowDirFormat is the time format of output directory names.
+	windowDirFormat = "20060102T150405Z"
+)
+
+// Command represents the program execution for "influx_inspect buildtsm".
+type Command struct {
+	// Standard input/output, overridden for testing.
+	Stdin  io.Reader
+	Stderr io.Writer
+	Stdout io.Writer
+
+	in                string
+	out               string
+	format            string
+	precision         string
+	measurement       string
+	tags              string
+	shardDuration     time.Duration
+	maxPointsPerBlock int
+	maxBufferedPoints int
+
+	tmp      string // directory the output is built in
+	tagSet   map[string]bool
+	windows  map[int64]*window
+	buffered int
+	values   int
+	files    int
+}
+
+// NewCommand returns a new instance of Command.
+func NewCommand() *Command {
+	return &Command{
+		Stdin:  os.Stdin,
+		Stderr: os.Stderr,
+		Stdout: os.Stdout,
+	}
+}
+
+// Run executes the command.
+func (cmd *Command) Run(args ...string) error {
+	fs := flag.NewFlagSet("buildtsm", flag.ExitOnError)
+	fs.StringVar(&cmd.in, "in", "", "Optional: file to read from (defaults to stdin)")
+	fs.StringVar(&cmd.out, "out", "", "Directory to write TSM files to")
+	fs.StringVar(&cmd.format, "format", "line", "Input format: line or csv")
+	fs.StringVar(&cmd.precision, "precision", "n", "Precision of input timestamps: n, u, ms, s, m or h")
+	fs.StringVar(&cmd.measurement, "measurement", "", "Measurement name of CSV rows")
+	fs.StringVar(&cmd.tags, "tags", "", "Comma-separated CSV columns to store as tags")
+	fs.DurationVar(&cmd.shardDuration, "shard-duration", DefaultShardDuration, "Time range of each output directory; should match the shard group duration of the target retention policy")
+	fs.IntVar(&cmd.maxPointsPerBlock, "max-points-per-block", tsdb.DefaultMaxPointsPerBlock, "Maximum number of values per TSM block")
+	fs.IntVar(&cmd.maxBufferedPoints, "max-buffered-points", DefaultMaxBufferedPoints, "Number of values to hold in memory before writing TSM files")
+
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = func() {
+		fmt.Fprintf(cmd.Stdout, "Converts line protocol or CSV into TSM files.\n\n")
+		fmt.Fprintf(cmd.Stdout, "Usage: %s buildtsm [flags]\n\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if err := cmd.validate(); err != nil {
+		return err
+	}
+
+	var r io.Reader = cmd.Stdin
+	if cmd.in != "" {
+		f, err := os.Open(cmd.in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return cmd.build(r)
+}
+
+func (cmd *Command) validate() error {
+	if cmd.out == "" {
+		return errors.New("must specify an output directory")
+	}
+	switch cmd.format {
+	case "line":
+		if cmd.measurement != "" || cmd.tags != "" {
+			return errors.New("-measurement and -tags only apply to csv input")
+		}
+	case "csv":
+		if cmd.measurement == "" {
+			return errors.New("must specify a measurement for csv input")
+		}
+	default:
+		return fmt.Errorf("unknown format %q: must be line or csv", cmd.format)
+	}
+	switch cmd.precision {
+	case "n", "u", "ms", "s", "m", "h":
+	default:
+		return fmt.Errorf("unknown precision %q", cmd.precision)
+	}
+	if cmd.shardDuration <= 0 {
+		return errors.New("shard duration must be positive")
+	}
+	if cmd.maxPointsPerBlock <= 0 {
+		return errors.New("max points per block must be positive")
+	}
+
+	cmd.tagSet = make(map[string]bool)
+	for _, tag := range strings.Split(cmd.tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			cmd.tagSet[tag] = true
+		}
+	}
+	return nil
+}
+
+// build writes the output into a temporary directory beside the output
+// directory, which is renamed to the output directory once all of the input
+// has been written. A failed build leaves no partial output behind.
+func (cmd *Command) build(r io.Reader) error {
+	if empty, err := isEmptyDir(cmd.out); err != nil {
+		return err
+	} else if !empty {
+		return fmt.Errorf("output directory %s is not empty", cmd.out)
+	}
+
+	parent := filepath.Dir(filepath.Clean(cmd.out))
+	if err := os.MkdirAll(parent, 0777); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(parent, "."+filepath.Base(cmd.out)+"-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	cmd.tmp = tmp
+	cmd.windows = make(map[int64]*window)
+
+	if cmd.format == "csv" {
+		err = cmd.readCSV(r)
+	} else {
+		err = cmd.readLineProtocol(r)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.flush(); err != nil {
+		return err
+	}
+
+	// Replace the empty output directory, if any, with the built one.
+	if err := os.Remove(cmd.out); err != nil && !os.IsNotExist(err) {
+		return err
+	} else if err := os.Rename(tmp, cmd.out); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Stderr, "wrote %d values to %d TSM files in %d directories\n", cmd.values, cmd.files, len(cmd.windows))
+	return nil
+}
+
+// isEmptyDir returns true if path is an empty directory or does not exist.
+func isEmptyDir(path string) (bool, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	if _, err := f.Readdirnames(1); err == io.EOF {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (cmd *Command) readLineProtocol(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	scanner.Split(models.ScanLines)
+
+	now := time.Now().UTC()
+	next := 1
+	for scanner.Scan() {
+		// String field values may span several lines.
+		lineno := next
+		next += 1 + bytes.Count(scanner.Bytes(), []byte{'\n'})
+
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		points, err := models.ParsePointsWithPrecision(line, now, cmd.precision)
+		if err != nil {
+			return fmt.Errorf("line %d: %s", lineno, err)
+		}
+		for _, p := range points {
+			if err := cmd.addPoint(p); err != nil {
+				return fmt.Errorf("line %d: %s", lineno, err)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+func (cmd *Command) readCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	header = append([]string(nil), header...)
+
+	timeCol := -1
+	for i, name := range header {
+		if name == "time" {
+			timeCol = i
+		}
+	}
+	if timeCol < 0 {
+		return errors.New("csv header has no time column")
+	}
+
+	multiplier := models.GetPrecisionMultiplier(cmd.precision)
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		t, err := parseCSVTime(record[timeCol], multiplier)
+		if err != nil {
+			return fmt.Errorf("row %d: %s", row, err)
+		}
+
+		tags := make(map[string]string)
+		fields := make(models.Fields)
+		for i, v := range record {
+			if i == timeCol || v == "" {
+				continue
+			} else if cmd.tagSet[header[i]] {
+				tags[header[i]] = v
+			} else {
+				fields[header[i]] = parseCSVValue(v)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		p, err := models.NewPoint(cmd.measurement, models.NewTags(tags), fields, t)
+		if err != nil {
+			return fmt.Errorf("row %d: %s", row, err)
+		}
+		if err := cmd.addPoint(p); err != nil {
+			return fmt.Errorf("row %d: %s", row, err)
+		}
+	}
+}
+
+// parseCSVTime parses a CSV timestamp, either as an integer in the given
+// precision or as an RFC3339 time.
+func parseCSVTime(s string, multiplier int64) (time.Time, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(0, n*multiplier).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	return t.UTC(), nil
+}
+
+// parseCSVValue infers the type of a CSV field value. Numbers are stored as
+// floats, as CSV does not distinguish integers.
+func parseCSVValue(s string) interface{} {
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return v
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return s
+}
+
+// addPoint buffers the fields of p in the window covering its time.
+func (cmd *Command) addPoint(p models.Point) error {
+	start := p.Time().Truncate(cmd.shardDuration).UnixNano()
+	w := cmd.windows[start]
+	if w == nil {
+		w = newWindow(filepath.Join(cmd.tmp, time.Unix(0, start).UTC().Format(windowDirFormat)))
+		cmd.windows[start] = w
+	}
+
+	fields, err := p.Fields()
+	if err != nil {
+		return err
+	}
+
+	seriesKey := string(p.Key())
+	ts := p.UnixNano()
+	for name, value := range fields {
+		key := tsm1.SeriesFieldKey(seriesKey, name)
+		if err := w.add(key, tsm1.NewValue(ts, value)); err != nil {
+			return err
+		}
+		cmd.buffered++
+	}
+
+	if cmd.maxBufferedPoints > 0 && cmd.buffered >= cmd.maxBufferedPoints {
+		return cmd.flush()
+	}
+	return nil
+}
+
+// flush writes out the buffered values of all windows.
+func (cmd *Command) flush() error {
+	starts := make([]int64, 0, len(cmd.windows))
+	for start := range cmd.windows {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	for _, start := range starts {
+		n, err := cmd.windows[start].flush(cmd.maxPointsPerBlock)
+		if err != nil {
+			return err
+		} else if n > 0 {
+			cmd.values += n
+			cmd.files++
+		}
+	}
+	cmd.buffered = 0
+	return nil
+}
+
+// window holds the values of a single shard duration until they are written
+// to a TSM file in its directory.
+type window struct {
+	dir        string
+	generation int
+	values     map[string]tsm1.Values
+
+	// types holds the block type of each key, so a type conflict is reported
+	// even when the conflicting values end up in different files.
+	types map[string]byte
+}
+
+func newWindow(dir string) *window {
+	return &window{
+		dir:    dir,
+		values: make(map[string]tsm1.Values),
+		types:  make(map[string]byte),
+	}
+}
+
+func (w *window) add(key string, v tsm1.Value) error {
+	typ, err := blockType(v)
+	if err != nil {
+		return err
+	}
+	if prev, ok := w.types[key]; ok && prev != typ {
+		return fmt.Errorf("field type conflict: %s", key)
+	}
+	w.types[key] = typ
+	w.values[key] = append(w.values[key], v)
+	return nil
+}
+
+// blockType returns the type of block v is encoded in.
+func blockType(v tsm1.Value) (byte, error) {
+	switch v.(type) {
+	case tsm1.FloatValue:
+		return tsm1.BlockFloat64, nil
+	case tsm1.IntegerValue:
+		return tsm1.BlockInteger, nil
+	case tsm1.UnsignedValue:
+		return tsm1.BlockUnsigned, nil
+	case tsm1.BooleanValue:
+		return tsm1.BlockBoolean, nil
+	case tsm1.StringValue:
+		return tsm1.BlockString, nil
+	}
+	return 0, fmt.Errorf("unsupported value type: %T", v.Value())
+}
+
+// flush writes the buffered values to a new TSM file, returning the number
+// of values written.
+func (w *window) flush(maxPointsPerBlock int) (int, error) {
+	if len(w.values) == 0 {
+		return 0, nil
+	}
+
+	if err := os.MkdirAll(w.dir, 0777); err != nil {
+		return 0, err
+	}
+
+	w.generation++
+	path := filepath.Join(w.dir, fmt.Sprintf("%09d-%09d.%s", w.generation, 1, tsm1.TSMFileExtension))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	tw, err := tsm1.NewTSMWriter(f)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := make([]string, 0, len(w.values))
+	for key := range w.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var n int
+	for _, key := range keys {
+		values := w.values[key].Deduplicate()
+		for i := 0; i < len(values); i += maxPointsPerBlock {
+			j := i + maxPointsPerBlock
+			if j > len(values) {
+				j = len(values)
+			}
+			if err := tw.Write([]byte(key), values[i:j]); err != nil {
+				return 0, err
+			}
+		}
+		n += len(values)
+	}
+
+	if err := tw.WriteIndex(); err != nil {
+		return 0, err
+	}
+	if err := tw.Close(); err != nil {
+		return 0, err
+	}
+
+	w.values = make(map[string]tsm1.Values)
+	return n, nil
+}
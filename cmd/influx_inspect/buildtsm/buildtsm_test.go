@@ -0,0 +1,182 @@
+package buildtsm_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/cmd/influx_inspect/buildtsm"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+func TestCommand_LineProtocol(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	cmd := NewCommand(strings.Join([]string{
+		"# comment",
+		"cpu,host=a value=1 0",
+		"cpu,host=a value=2 1000000000",
+		"cpu,host=a value=3 1000000000",
+		"cpu,host=b value=4,idle=true 2000000000",
+		"cpu,host=a value=5 86400000000000",
+	}, "\n"))
+	if err := cmd.Run("-out", dir, "-shard-duration", "24h", "-max-points-per-block", "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	day0 := ReadTSMDir(t, filepath.Join(dir, "19700101T000000Z"))
+	if got, exp := day0, map[string][]interface{}{
+		"cpu,host=a#!~#value": {1.0, 3.0},
+		"cpu,host=b#!~#idle":  {true},
+		"cpu,host=b#!~#value": {4.0},
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected values:\n\ngot=%v\n\nexp=%v", got, exp)
+	}
+
+	day1 := ReadTSMDir(t, filepath.Join(dir, "19700102T000000Z"))
+	if got, exp := day1, map[string][]interface{}{
+		"cpu,host=a#!~#value": {5.0},
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected values:\n\ngot=%v\n\nexp=%v", got, exp)
+	}
+}
+
+func TestCommand_CSV(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	cmd := NewCommand(strings.Join([]string{
+		"time,host,value,status",
+		"1,a,1.5,ok",
+		"1970-01-01T00:00:02Z,b,,false",
+	}, "\n"))
+	if err := cmd.Run("-out", dir, "-format", "csv", "-measurement", "cpu", "-tags", "host", "-precision", "s"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Directories are aligned like shard groups, so the default week starts
+	// on the Monday before the epoch.
+	values := ReadTSMDir(t, filepath.Join(dir, "19691229T000000Z"))
+	if got, exp := values, map[string][]interface{}{
+		"cpu,host=a#!~#status": {"ok"},
+		"cpu,host=a#!~#value":  {1.5},
+		"cpu,host=b#!~#status": {false},
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected values:\n\ngot=%v\n\nexp=%v", got, exp)
+	}
+}
+
+func TestCommand_FieldTypeConflict(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	cmd := NewCommand("cpu value=1 0\ncpu value=\"x\" 1")
+	if err := cmd.Run("-out", dir); err == nil || !strings.Contains(err.Error(), "field type conflict") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCommand_MultiLineString(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	cmd := NewCommand("log msg=\"first\nsecond\" 0\nlog msg=\"third\" 1")
+	if err := cmd.Run("-out", dir, "-shard-duration", "24h"); err != nil {
+		t.Fatal(err)
+	}
+
+	values := ReadTSMDir(t, filepath.Join(dir, "19700101T000000Z"))
+	if got, exp := values, map[string][]interface{}{
+		"log#!~#msg": {"first\nsecond", "third"},
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected values:\n\ngot=%v\n\nexp=%v", got, exp)
+	}
+}
+
+func TestCommand_ParseErrorLeavesNoOutput(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "out")
+
+	cmd := NewCommand("cpu value=\"a\nb\" 0\ncpu value= 1")
+	if err := cmd.Run("-out", out); err == nil || !strings.HasPrefix(err.Error(), "line 3:") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Neither the output directory nor the temporary directory remain.
+	if fis, err := ioutil.ReadDir(dir); err != nil {
+		t.Fatal(err)
+	} else if len(fis) != 0 {
+		t.Fatalf("unexpected files: %v", fis)
+	}
+}
+
+func TestCommand_OutputNotEmpty(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "x"), nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := NewCommand("cpu value=1 0")
+	if err := cmd.Run("-out", dir); err == nil || !strings.Contains(err.Error(), "is not empty") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// NewCommand returns a command reading input from s.
+func NewCommand(s string) *buildtsm.Command {
+	cmd := buildtsm.NewCommand()
+	cmd.Stdin = strings.NewReader(s)
+	cmd.Stdout = ioutil.Discard
+	cmd.Stderr = &bytes.Buffer{}
+	return cmd
+}
+
+// ReadTSMDir returns the values of each key in the TSM files of dir.
+func ReadTSMDir(t *testing.T, dir string) map[string][]interface{} {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tsm"))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(files) == 0 {
+		t.Fatalf("no tsm files in %s", dir)
+	}
+
+	m := make(map[string][]interface{})
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := tsm1.NewTSMReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < r.KeyCount(); i++ {
+			key, _ := r.KeyAt(i)
+			values, err := r.ReadAll(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range values {
+				m[string(key)] = append(m[string(key)], v.Value())
+			}
+		}
+		r.Close()
+	}
+	return m
+}
+
+// MustTempDir returns a temporary directory.
+func MustTempDir() string {
+	dir, err := ioutil.TempDir("", "buildtsm-")
+	if err != nil {
+		panic(err)
+	}
+	return dir
+}
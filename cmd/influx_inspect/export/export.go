@@ -31,6 +31,7 @@ type Command struct {
 	dataDir         string
 	walDir          string
 	out             string
+	format          string
 	database        string
 	retentionPolicy string
 	startTime       int64
@@ -60,7 +61,8 @@ func (cmd *Command) Run(args ...string) error {
 	fs := flag.NewFlagSet("export", flag.ExitOnError)
 	fs.StringVar(&cmd.dataDir, "datadir", os.Getenv("HOME")+"/.influxdb/data", "Data storage path")
 	fs.StringVar(&cmd.walDir, "waldir", os.Getenv("HOME")+"/.influxdb/wal", "WAL storage path")
-	fs.StringVar(&cmd.out, "out", os.Getenv("HOME")+"/.influxdb/export", "Destination file to export to")
+	fs.StringVar(&cmd.out, "out", os.Getenv("HOME")+"/.influxdb/export", "Destination file to export to, or directory for the parquet format")
+	fs.StringVar(&cmd.format, "format", "line", "Output format: line or parquet")
 	fs.StringVar(&cmd.database, "database", "", "Optional: the database to export")
 	fs.StringVar(&cmd.retentionPolicy, "retention", "", "Optional: the retention policy to export (requires -database)")
 	fs.StringVar(&start, "start", "", "Optional: the start time to export (RFC3339 format)")
@@ -113,6 +115,9 @@ func (cmd *Command) validate() error {
 	if cmd.startTime != 0 && cmd.endTime != 0 && cmd.endTime < cmd.startTime {
 		return fmt.Errorf("end time before start time")
 	}
+	if cmd.format != "line" && cmd.format != "parquet" {
+		return fmt.Errorf("unknown format %q", cmd.format)
+	}
 	return nil
 }
 
@@ -123,6 +128,9 @@ func (cmd *Command) export() error {
 	if err := cmd.walkWALFiles(); err != nil {
 		return err
 	}
+	if cmd.format == "parquet" {
+		return cmd.writeParquet()
+	}
 	return cmd.write()
 }
 
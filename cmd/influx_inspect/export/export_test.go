@@ -7,6 +7,8 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -148,6 +150,138 @@ func Test_exportTSMFile(t *testing.T) {
 	}
 }
 
+func Test_exportParquet(t *testing.T) {
+	tsmFile := writeCorpusToTSMFile(corpus{
+		tsm1.SeriesFieldKey("cpu,host=a", "value"): []tsm1.Value{
+			tsm1.NewValue(1, float64(1.5)),
+			tsm1.NewValue(2, float64(2.5)),
+		},
+		tsm1.SeriesFieldKey("cpu,host=a", "n"): []tsm1.Value{
+			tsm1.NewValue(2, int64(3)),
+		},
+		tsm1.SeriesFieldKey("cpu,host=b,region=west", "value"): []tsm1.Value{
+			tsm1.NewValue(1, float64(4.5)),
+		},
+		tsm1.SeriesFieldKey("disk/io,host=a", "host"): []tsm1.Value{
+			tsm1.NewValue(1, "sda"),
+		},
+	})
+	defer os.Remove(tsmFile.Name())
+
+	dir, err := ioutil.TempDir("", "export_test_parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := newCommand()
+	cmd.out = dir
+	cmd.manifest = map[string]struct{}{"db/rp": {}}
+	cmd.tsmFiles = map[string][]string{"db/rp": {tsmFile.Name()}}
+
+	// Verify the columns and rows of a measurement.
+	e := &parquetExporter{cmd: cmd, measurements: make(map[string]*parquetMeasurement)}
+	if err := e.openTSMFiles([]string{tsmFile.Name()}); err != nil {
+		t.Fatal(err)
+	}
+	e.scanTSMFile(e.tsm[0])
+	e.closeTSMFiles()
+
+	cpu := e.measurements["cpu"]
+	var names []string
+	for _, f := range cpu.schema() {
+		names = append(names, f.Name)
+	}
+	if exp := []string{"time", "host", "region", "n", "value"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("unexpected columns: got %v, exp %v", names, exp)
+	}
+
+	rows := cmd.parquetRows(cpu, []byte("cpu,host=a"), map[string][]tsm1.Value{
+		"value": {tsm1.NewValue(1, float64(1.5)), tsm1.NewValue(2, float64(2.5))},
+		"n":     {tsm1.NewValue(2, int64(3))},
+	})
+	if exp := [][]interface{}{
+		{int64(1), "a", nil, nil, float64(1.5)},
+		{int64(2), "a", nil, int64(3), float64(2.5)},
+	}; !reflect.DeepEqual(rows, exp) {
+		t.Fatalf("unexpected rows: got %v, exp %v", rows, exp)
+	}
+
+	// A field with the same name as a tag is renamed.
+	names = names[:0]
+	for _, f := range e.measurements["disk/io"].schema() {
+		names = append(names, f.Name)
+	}
+	if exp := []string{"time", "host", "host_1"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("unexpected columns: got %v, exp %v", names, exp)
+	}
+
+	// Verify a file is written per measurement.
+	if err := cmd.writeParquet(); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"cpu.parquet", "disk%2Fio.parquet"} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, "db", "rp", name))
+		if err != nil {
+			t.Fatal(err)
+		} else if !bytes.HasPrefix(b, []byte("PAR1")) || !bytes.HasSuffix(b, []byte("PAR1")) {
+			t.Fatalf("%s is not a parquet file", name)
+		}
+	}
+}
+
+func Test_exportParquet_TSMAndWAL(t *testing.T) {
+	tsmFile := writeCorpusToTSMFile(corpus{
+		tsm1.SeriesFieldKey("cpu,host=a", "value"): []tsm1.Value{
+			tsm1.NewValue(1, float64(1)),
+			tsm1.NewValue(2, float64(2)),
+		},
+	})
+	defer os.Remove(tsmFile.Name())
+
+	walFile := writeCorpusToWALFile(corpus{
+		tsm1.SeriesFieldKey("cpu,host=a", "value"): []tsm1.Value{
+			tsm1.NewValue(2, float64(20)),
+			tsm1.NewValue(3, float64(30)),
+		},
+		tsm1.SeriesFieldKey("mem,host=a", "free"): []tsm1.Value{
+			tsm1.NewValue(1, int64(10)),
+		},
+	})
+	defer os.Remove(walFile.Name())
+
+	cmd := newCommand()
+	e := &parquetExporter{cmd: cmd, measurements: make(map[string]*parquetMeasurement), walFiles: []string{walFile.Name()}}
+	if err := e.openTSMFiles([]string{tsmFile.Name()}); err != nil {
+		t.Fatal(err)
+	}
+	defer e.closeTSMFiles()
+	e.scanTSMFile(e.tsm[0])
+	if err := e.scanWALFiles("db/rp"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the WAL values of the measurement are read.
+	cpu := e.measurements["cpu"]
+	cpu.schema()
+	wal, err := e.readWAL(cpu)
+	if err != nil {
+		t.Fatal(err)
+	} else if _, ok := wal["mem,host=a"]; ok || len(wal) != 1 {
+		t.Fatalf("unexpected WAL series: %v", wal)
+	}
+
+	// A WAL value replaces the TSM value with the same timestamp.
+	rows := cmd.parquetRows(cpu, []byte("cpu,host=a"), e.seriesValues([]byte("cpu,host=a"), wal["cpu,host=a"]))
+	if exp := [][]interface{}{
+		{int64(1), "a", float64(1)},
+		{int64(2), "a", float64(20)},
+		{int64(3), "a", float64(30)},
+	}; !reflect.DeepEqual(rows, exp) {
+		t.Fatalf("unexpected rows: got %v, exp %v", rows, exp)
+	}
+}
+
 var sink interface{}
 
 func benchmarkExportTSM(c corpus, b *testing.B) {
@@ -0,0 +1,486 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/escape"
+	"github.com/influxdata/influxdb/pkg/parquet"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+// parquetMeasurement holds the series and columns of a measurement exported
+// to Parquet.
+type parquetMeasurement struct {
+	name   string
+	tags   []string
+	fields []string
+	types  map[string]byte // field name to block type
+
+	tagColumns   map[string]int
+	fieldColumns map[string]int
+
+	series   map[string]struct{} // series keys
+	walFiles map[int]struct{}    // indexes of the WAL files with values of the measurement
+
+	// Number of values dropped because their type differs from the type of
+	// the field's column, which happens when a field changes type between
+	// shards.
+	conflicts int
+}
+
+// addField adds a field to the measurement. The first type seen for a field
+// is used for its column.
+func (m *parquetMeasurement) addField(field string, typ byte) {
+	if _, ok := m.types[field]; !ok {
+		m.types[field] = typ
+		m.fields = append(m.fields, field)
+	}
+}
+
+// addTags adds the keys of tags to the measurement.
+func (m *parquetMeasurement) addTags(tags models.Tags) {
+	for _, t := range tags {
+		k := string(t.Key)
+		i := sort.SearchStrings(m.tags, k)
+		if i < len(m.tags) && m.tags[i] == k {
+			continue
+		}
+		m.tags = append(m.tags, "")
+		copy(m.tags[i+1:], m.tags[i:])
+		m.tags[i] = k
+	}
+}
+
+// schema returns the Parquet columns of the measurement: the time, followed
+// by the tags and the fields in lexical order. A field with the same name
+// as a tag is suffixed with "_1", as it is in query results.
+func (m *parquetMeasurement) schema() []parquet.Field {
+	sort.Strings(m.fields)
+
+	schema := make([]parquet.Field, 0, 1+len(m.tags)+len(m.fields))
+	schema = append(schema, parquet.Field{Name: "time", Type: parquet.Timestamp, Required: true})
+
+	m.tagColumns = make(map[string]int, len(m.tags))
+	for _, k := range m.tags {
+		m.tagColumns[k] = len(schema)
+		schema = append(schema, parquet.Field{Name: k, Type: parquet.String, Dictionary: true})
+	}
+
+	m.fieldColumns = make(map[string]int, len(m.fields))
+	for _, k := range m.fields {
+		name := k
+		if _, ok := m.tagColumns[k]; ok {
+			name = k + "_1"
+		}
+		m.fieldColumns[k] = len(schema)
+		schema = append(schema, parquet.Field{Name: name, Type: parquetType(m.types[k])})
+	}
+	return schema
+}
+
+// parquetType returns the Parquet column type for a TSM block type.
+func parquetType(typ byte) parquet.Type {
+	switch typ {
+	case tsm1.BlockInteger:
+		return parquet.Int64
+	case tsm1.BlockUnsigned:
+		return parquet.Uint64
+	case tsm1.BlockBoolean:
+		return parquet.Boolean
+	case tsm1.BlockString:
+		return parquet.String
+	default:
+		return parquet.Double
+	}
+}
+
+// blockType returns the TSM block type of a value.
+func blockType(v tsm1.Value) byte {
+	switch v.Value().(type) {
+	case int64:
+		return tsm1.BlockInteger
+	case uint64:
+		return tsm1.BlockUnsigned
+	case bool:
+		return tsm1.BlockBoolean
+	case string:
+		return tsm1.BlockString
+	default:
+		return tsm1.BlockFloat64
+	}
+}
+
+// parquetExporter exports the data of a single retention policy to one
+// Parquet file per measurement. Measurements are written one at a time, so
+// only one output file is open and only the WAL values of the measurement
+// being written are held in memory.
+type parquetExporter struct {
+	cmd          *Command
+	dir          string
+	measurements map[string]*parquetMeasurement
+
+	tsm      []*tsm1.TSMReader // in the order the files were written
+	walFiles []string
+}
+
+func (cmd *Command) writeParquet() error {
+	keys := make([]string, 0, len(cmd.manifest))
+	for key := range cmd.manifest {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		e := &parquetExporter{
+			cmd:          cmd,
+			dir:          filepath.Join(cmd.out, key),
+			measurements: make(map[string]*parquetMeasurement),
+		}
+
+		fmt.Fprintf(cmd.Stdout, "writing out parquet files for %s...", key)
+		err := e.export(cmd.tsmFiles[key], cmd.walFiles[key], key)
+		e.closeTSMFiles()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.Stdout, "complete.")
+	}
+	return nil
+}
+
+// export writes the TSM and WAL files of a retention policy. The files are
+// read twice: once to determine the series and columns of every measurement,
+// and once per measurement to write its rows.
+func (e *parquetExporter) export(tsmFiles, walFiles []string, key string) error {
+	sort.Strings(tsmFiles)
+	sort.Strings(walFiles)
+	e.walFiles = walFiles
+
+	if err := e.openTSMFiles(tsmFiles); err != nil {
+		return err
+	}
+	for _, r := range e.tsm {
+		e.scanTSMFile(r)
+	}
+	if err := e.scanWALFiles(key); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(e.dir, 0777); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(e.measurements))
+	for name := range e.measurements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := e.writeMeasurement(e.measurements[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// measurement returns the measurement of a series, creating it if needed.
+func (e *parquetExporter) measurement(seriesKey []byte) (*parquetMeasurement, models.Tags) {
+	name, tags := models.ParseKeyBytes(seriesKey)
+	name = escape.Unescape(name)
+	m := e.measurements[string(name)]
+	if m == nil {
+		m = &parquetMeasurement{
+			name:     string(name),
+			types:    make(map[string]byte),
+			series:   make(map[string]struct{}),
+			walFiles: make(map[int]struct{}),
+		}
+		e.measurements[m.name] = m
+	}
+	return m, tags
+}
+
+// addSeries adds a series and one of its fields to its measurement.
+func (e *parquetExporter) addSeries(seriesKey, field []byte, typ byte) *parquetMeasurement {
+	m, tags := e.measurement(seriesKey)
+	if _, ok := m.series[string(seriesKey)]; !ok {
+		m.series[string(seriesKey)] = struct{}{}
+		m.addTags(tags)
+	}
+	m.addField(string(field), typ)
+	return m
+}
+
+// openTSMFiles opens the TSM files that overlap the exported time range.
+// Missing and unreadable files are skipped.
+func (e *parquetExporter) openTSMFiles(files []string) error {
+	for _, path := range files {
+		f, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		r, err := tsm1.NewTSMReader(f)
+		if err != nil {
+			fmt.Fprintf(e.cmd.Stderr, "unable to read %s, skipping: %s\n", path, err.Error())
+			f.Close()
+			continue
+		}
+
+		if start, end := r.TimeRange(); start > e.cmd.endTime || end < e.cmd.startTime {
+			r.Close()
+			continue
+		}
+		e.tsm = append(e.tsm, r)
+	}
+	return nil
+}
+
+// closeTSMFiles closes the TSM files opened by openTSMFiles.
+func (e *parquetExporter) closeTSMFiles() {
+	for _, r := range e.tsm {
+		r.Close()
+	}
+	e.tsm = nil
+}
+
+// scanTSMFile adds the series and fields in a TSM file to the measurements.
+func (e *parquetExporter) scanTSMFile(r *tsm1.TSMReader) {
+	for i := 0; i < r.KeyCount(); i++ {
+		key, typ := r.KeyAt(i)
+		seriesKey, field := tsm1.SeriesAndFieldFromCompositeKey(key)
+		e.addSeries(seriesKey, field, typ)
+	}
+}
+
+// scanWALFiles adds the series and fields in the WAL files to the
+// measurements and records which files hold values of each measurement.
+// The values themselves are read by readWAL when their measurement is written.
+func (e *parquetExporter) scanWALFiles(key string) error {
+	var once sync.Once
+	warnDelete := func() {
+		once.Do(func() {
+			fmt.Fprintf(e.cmd.Stderr, "WARNING: detected deletes in wal file.\nSome series for %q may be brought back by exporting this data.\n", key)
+		})
+	}
+
+	for i := range e.walFiles {
+		err := e.readWALFile(i, func(entry tsm1.WALEntry) {
+			switch t := entry.(type) {
+			case *tsm1.DeleteWALEntry, *tsm1.DeleteRangeWALEntry:
+				warnDelete()
+			case *tsm1.WriteWALEntry:
+				for k, values := range t.Values {
+					if len(values) == 0 {
+						continue
+					}
+					seriesKey, field := tsm1.SeriesAndFieldFromCompositeKey([]byte(k))
+					m := e.addSeries(seriesKey, field, blockType(values[0]))
+					m.walFiles[i] = struct{}{}
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readWAL returns the values in the WAL of the series of a measurement, keyed
+// by series key and field, in the order they were written.
+func (e *parquetExporter) readWAL(m *parquetMeasurement) (map[string]map[string][]tsm1.Value, error) {
+	wal := make(map[string]map[string][]tsm1.Value)
+	for i := range e.walFiles {
+		if _, ok := m.walFiles[i]; !ok {
+			continue
+		}
+
+		err := e.readWALFile(i, func(entry tsm1.WALEntry) {
+			t, ok := entry.(*tsm1.WriteWALEntry)
+			if !ok {
+				return
+			}
+			for k, values := range t.Values {
+				seriesKey, field := tsm1.SeriesAndFieldFromCompositeKey([]byte(k))
+				if _, ok := m.series[string(seriesKey)]; !ok {
+					continue
+				}
+
+				fields := wal[string(seriesKey)]
+				if fields == nil {
+					fields = make(map[string][]tsm1.Value)
+					wal[string(seriesKey)] = fields
+				}
+				fields[string(field)] = append(fields[string(field)], values...)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return wal, nil
+}
+
+// readWALFile calls fn with every entry of the i-th WAL file. Reading stops
+// at the first corrupt entry.
+func (e *parquetExporter) readWALFile(i int, fn func(entry tsm1.WALEntry)) error {
+	path := e.walFiles[i]
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	r := tsm1.NewWALSegmentReader(f)
+	defer r.Close()
+
+	for r.Next() {
+		entry, err := r.Read()
+		if err != nil {
+			fmt.Fprintf(e.cmd.Stderr, "file %s corrupt at position %d", path, r.Count())
+			break
+		}
+		fn(entry)
+	}
+	return nil
+}
+
+// writeMeasurement writes the rows of every series of a measurement to the
+// measurement's file.
+func (e *parquetExporter) writeMeasurement(m *parquetMeasurement) error {
+	wal, err := e.readWAL(m)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join(e.dir, parquetFileName(m.name)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	b := bufio.NewWriterSize(f, 1024*1024)
+	w := parquet.NewWriter(b, m.schema())
+	if e.cmd.compress {
+		w.Codec = parquet.Snappy
+	}
+
+	keys := make([]string, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fields := e.seriesValues([]byte(k), wal[k])
+		for _, row := range e.cmd.parquetRows(m, []byte(k), fields) {
+			if err := w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	if m.conflicts > 0 {
+		fmt.Fprintf(e.cmd.Stderr, "skipped %d values of %q with conflicting field types\n", m.conflicts, m.name)
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	} else if err := b.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// seriesValues returns the values of every field of a series, from the TSM
+// files in the order they were written followed by the WAL values, so that
+// later values for the same timestamp come last.
+func (e *parquetExporter) seriesValues(seriesKey []byte, wal map[string][]tsm1.Value) map[string][]tsm1.Value {
+	fields := make(map[string][]tsm1.Value)
+	prefix := tsm1.SeriesFieldKeyBytes(string(seriesKey), "")
+	for _, r := range e.tsm {
+		for i := r.Seek(prefix); i < r.KeyCount(); i++ {
+			key, _ := r.KeyAt(i)
+			if !bytes.HasPrefix(key, prefix) {
+				break
+			}
+
+			values, err := r.ReadAll(key)
+			if err != nil {
+				fmt.Fprintf(e.cmd.Stderr, "unable to read key %q in %s, skipping: %s\n", string(key), r.Path(), err.Error())
+				continue
+			}
+			field := string(key[len(prefix):])
+			fields[field] = append(fields[field], values...)
+		}
+	}
+
+	for field, values := range wal {
+		fields[field] = append(fields[field], values...)
+	}
+	return fields
+}
+
+// parquetRows merges the values of the fields of a series into rows, one per
+// timestamp, in time order. A later value for the same field and timestamp
+// replaces an earlier one.
+func (cmd *Command) parquetRows(m *parquetMeasurement, seriesKey []byte, fields map[string][]tsm1.Value) [][]interface{} {
+	_, tags := models.ParseKeyBytes(seriesKey)
+	ncols := 1 + len(m.tags) + len(m.fields)
+
+	rows := make(map[int64][]interface{})
+	for field, values := range fields {
+		col := m.fieldColumns[field]
+		typ := m.types[field]
+		for _, v := range values {
+			ts := v.UnixNano()
+			if ts < cmd.startTime || ts > cmd.endTime {
+				continue
+			} else if blockType(v) != typ {
+				m.conflicts++
+				continue
+			}
+
+			row := rows[ts]
+			if row == nil {
+				row = make([]interface{}, ncols)
+				row[0] = ts
+				for _, t := range tags {
+					row[m.tagColumns[string(t.Key)]] = string(t.Value)
+				}
+				rows[ts] = row
+			}
+			row[col] = v.Value()
+		}
+	}
+
+	times := make([]int64, 0, len(rows))
+	for ts := range rows {
+		times = append(times, ts)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	a := make([][]interface{}, len(times))
+	for i, ts := range times {
+		a[i] = rows[ts]
+	}
+	return a
+}
+
+// parquetFileName returns the name of the Parquet file of a measurement.
+func parquetFileName(name string) string {
+	return url.PathEscape(name) + ".parquet"
+}
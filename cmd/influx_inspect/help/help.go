@@ -31,11 +31,13 @@ Usage: influx_inspect [[command] [arguments]]
 
 The commands are:
 
+    buildtsm             converts line protocol or CSV into TSM files
     dumptsi              dumps low-level details about tsi1 files.
     dumptsm              dumps low-level details about tsm1 files.
     export               exports raw data from a shard to line protocol
     inmem2tsi            generates a tsi1 index from an in-memory index shard
     help                 display this help message
+    replay-rejected      writes rejected points back out to line protocol
     report               displays a shard level report
     verify               verifies integrity of TSM files
 
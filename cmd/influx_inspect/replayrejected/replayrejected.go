@@ -0,0 +1,132 @@
+// Package replayrejected writes the points in a rejected points log back out
+// as InfluxDB line protocol.
+package replayrejected
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+// Command represents the program execution for "influx_inspect replay-rejected".
+type Command struct {
+	// Standard input/output, overridden for testing.
+	Stderr io.Writer
+	Stdout io.Writer
+
+	dir      string
+	out      string
+	database string
+	reason   string
+	verbose  bool
+}
+
+// NewCommand returns a new instance of Command.
+func NewCommand() *Command {
+	return &Command{
+		Stderr: os.Stderr,
+		Stdout: os.Stdout,
+	}
+}
+
+// Run executes the command.
+func (cmd *Command) Run(args ...string) error {
+	fs := flag.NewFlagSet("replay-rejected", flag.ExitOnError)
+	fs.StringVar(&cmd.dir, "dir", os.Getenv("HOME")+"/.influxdb/rejected", "Rejected points storage path")
+	fs.StringVar(&cmd.out, "out", "", "Optional: destination file to write to (defaults to stdout)")
+	fs.StringVar(&cmd.database, "database", "", "The database of the rejected points log")
+	fs.StringVar(&cmd.reason, "reason", "", "Optional: only replay points rejected for this reason")
+	fs.BoolVar(&cmd.verbose, "v", false, "Include the rejection reason of each point as a comment")
+
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = func() {
+		fmt.Fprintf(cmd.Stdout, "Writes rejected points back out as InfluxDB line protocol.\n\n")
+		fmt.Fprintf(cmd.Stdout, "Usage: %s replay-rejected [flags]\n\n", filepath.Base(os.Args[0]))
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if cmd.database == "" {
+		return fmt.Errorf("must specify a db")
+	}
+
+	return cmd.replay()
+}
+
+func (cmd *Command) replay() error {
+	entries, err := tsdb.ReadRejectedPointsDir(filepath.Join(cmd.dir, cmd.database))
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = cmd.Stdout
+	if cmd.out != "" {
+		f, err := os.Create(cmd.out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	bw := bufio.NewWriter(w)
+	defer bw.Flush()
+
+	// Use the same header as "influx_inspect export" so the output can be
+	// imported with "influx -import".
+	fmt.Fprintln(bw, "# DML")
+	fmt.Fprintf(bw, "# CONTEXT-DATABASE:%s\n", cmd.database)
+
+	// Points are written back to the retention policy they were written to.
+	var rp string
+	var n, unparsed, skipped int
+	for _, e := range entries {
+		if cmd.reason != "" && e.Reason != cmd.reason {
+			continue
+		}
+
+		// Points rejected by a service that doesn't accept line protocol
+		// have nothing to replay.
+		if len(e.Point) == 0 {
+			skipped++
+			continue
+		}
+
+		if e.RetentionPolicy != rp {
+			rp = e.RetentionPolicy
+			fmt.Fprintf(bw, "# CONTEXT-RETENTION-POLICY:%s\n", rp)
+		}
+		if cmd.verbose {
+			fmt.Fprintf(bw, "# %s: %s\n", e.Reason, e.Message)
+		}
+		bw.Write(e.Point)
+		bw.WriteByte('\n')
+		n++
+
+		if e.Reason == tsdb.RejectParseError {
+			unparsed++
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.Stderr, "replayed %d points", n)
+	if unparsed > 0 {
+		fmt.Fprintf(cmd.Stderr, ", %d of them lines that failed to parse", unparsed)
+	}
+	if skipped > 0 {
+		fmt.Fprintf(cmd.Stderr, ", skipped %d entries without line protocol", skipped)
+	}
+	fmt.Fprintln(cmd.Stderr)
+	return nil
+}
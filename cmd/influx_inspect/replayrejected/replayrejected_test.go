@@ -0,0 +1,132 @@
+package replayrejected_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/cmd/influx_inspect/replayrejected"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+func TestCommand_Replay(t *testing.T) {
+	dir := MustWriteRejectedPoints(t, "db0", []tsdb.RejectedPoint{
+		{Point: MustParsePoint("cpu value=1 10"), Reason: tsdb.RejectFieldTypeConflict, Message: "conflict"},
+		{Point: MustParsePoint("cpu value=2 20"), RetentionPolicy: "rp1", Reason: tsdb.RejectBeyondRetention, Message: "beyond"},
+		{Line: []byte("cpu value="), RetentionPolicy: "rp1", Reason: tsdb.RejectParseError, Message: "unable to parse"},
+		{RetentionPolicy: "rp1", Reason: tsdb.RejectParseError, Message: "unable to parse graphite line"},
+		{Point: MustParsePoint("cpu value=3 30"), Reason: tsdb.RejectFieldTypeConflict, Message: "conflict"},
+	})
+	defer os.RemoveAll(dir)
+
+	for _, tt := range []struct {
+		name   string
+		args   []string
+		stdout string
+		stderr string
+	}{
+		{
+			name: "all",
+			stdout: strings.Join([]string{
+				"# DML",
+				"# CONTEXT-DATABASE:db0",
+				"cpu value=1 10",
+				"# CONTEXT-RETENTION-POLICY:rp1",
+				"cpu value=2 20",
+				"cpu value=",
+				"# CONTEXT-RETENTION-POLICY:",
+				"cpu value=3 30",
+				"",
+			}, "\n"),
+			stderr: "replayed 4 points, 1 of them lines that failed to parse, skipped 1 entries without line protocol\n",
+		},
+		{
+			name: "reason",
+			args: []string{"-reason", tsdb.RejectBeyondRetention, "-v"},
+			stdout: strings.Join([]string{
+				"# DML",
+				"# CONTEXT-DATABASE:db0",
+				"# CONTEXT-RETENTION-POLICY:rp1",
+				"# beyond retention: beyond",
+				"cpu value=2 20",
+				"",
+			}, "\n"),
+			stderr: "replayed 1 points\n",
+		},
+	} {
+		var stdout, stderr bytes.Buffer
+		cmd := replayrejected.NewCommand()
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+		if err := cmd.Run(append([]string{"-dir", dir, "-database", "db0"}, tt.args...)...); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.name, err)
+		}
+
+		if got := stdout.String(); got != tt.stdout {
+			t.Fatalf("%s: unexpected output:\n got: %q\n exp: %q", tt.name, got, tt.stdout)
+		} else if got := stderr.String(); got != tt.stderr {
+			t.Fatalf("%s: unexpected summary:\n got: %q\n exp: %q", tt.name, got, tt.stderr)
+		}
+	}
+}
+
+func TestCommand_Replay_Out(t *testing.T) {
+	dir := MustWriteRejectedPoints(t, "db0", []tsdb.RejectedPoint{
+		{Point: MustParsePoint("cpu value=1 10"), Reason: tsdb.RejectFieldTypeConflict, Message: "conflict"},
+	})
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "out.lp")
+	cmd := replayrejected.NewCommand()
+	cmd.Stdout, cmd.Stderr = ioutil.Discard, ioutil.Discard
+	if err := cmd.Run("-dir", dir, "-database", "db0", "-out", out); err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := string(buf), "# DML\n# CONTEXT-DATABASE:db0\ncpu value=1 10\n"; got != exp {
+		t.Fatalf("unexpected output:\n got: %q\n exp: %q", got, exp)
+	}
+}
+
+func TestCommand_Replay_DatabaseRequired(t *testing.T) {
+	cmd := replayrejected.NewCommand()
+	cmd.Stdout, cmd.Stderr = ioutil.Discard, ioutil.Discard
+	if err := cmd.Run("-dir", os.TempDir()); err == nil || err.Error() != "must specify a db" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// MustWriteRejectedPoints writes the rejected points to the log of database
+// in a new temporary directory and returns the directory.
+func MustWriteRejectedPoints(t *testing.T, database string, rejected []tsdb.RejectedPoint) string {
+	dir, err := ioutil.TempDir("", "replayrejected-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := tsdb.NewRejectedPointsLog(filepath.Join(dir, database))
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	if err := l.WriteRejectedPoints(rejected); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func MustParsePoint(s string) models.Point {
+	points, err := models.ParsePointsWithPrecision([]byte(s), time.Time{}, "n")
+	if err != nil {
+		panic(err)
+	}
+	return points[0]
+}
@@ -0,0 +1,194 @@
+// Package importtsm is the import-tsm subcommand for the influxd command,
+// for attaching TSM files built offline to a running server.
+package importtsm
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/influxdata/influxdb/services/snapshotter"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+// Command represents the program execution for "influxd import-tsm".
+type Command struct {
+	// The logger passed to the ticker during execution.
+	StdoutLogger *log.Logger
+	StderrLogger *log.Logger
+
+	// Standard input/output, overridden for testing.
+	Stderr io.Writer
+	Stdout io.Writer
+
+	host      string
+	path      string
+	database  string
+	retention string
+	client    *snapshotter.Client
+}
+
+// NewCommand returns a new instance of Command with default settings.
+func NewCommand() *Command {
+	return &Command{
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	}
+}
+
+// Run executes the program.
+func (cmd *Command) Run(args ...string) error {
+	cmd.StdoutLogger = log.New(cmd.Stdout, "", log.LstdFlags)
+	cmd.StderrLogger = log.New(cmd.Stderr, "", log.LstdFlags)
+	if err := cmd.parseFlags(args); err != nil {
+		return err
+	}
+
+	dirs, err := shardDirs(cmd.path)
+	if err != nil {
+		return err
+	} else if len(dirs) == 0 {
+		return fmt.Errorf("no tsm files found in %s", cmd.path)
+	}
+
+	for _, dir := range dirs {
+		if err := cmd.importDir(dir); err != nil {
+			cmd.StderrLogger.Printf("error importing %s: %v", dir, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// parseFlags parses and validates the command line arguments.
+func (cmd *Command) parseFlags(args []string) error {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringVar(&cmd.host, "host", "localhost:8088", "")
+	fs.StringVar(&cmd.database, "database", "", "")
+	fs.StringVar(&cmd.retention, "retention", "", "")
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = cmd.printUsage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cmd.path = fs.Arg(0)
+	if cmd.path == "" {
+		return errors.New("path with tsm files required")
+	} else if fi, err := os.Stat(cmd.path); err != nil || !fi.IsDir() {
+		return fmt.Errorf("tsm path should be a valid directory: %s", cmd.path)
+	}
+
+	if cmd.database == "" {
+		return errors.New("-database is required")
+	}
+
+	cmd.client = snapshotter.NewClient(cmd.host)
+	return nil
+}
+
+// importDir attaches the TSM files in dir to the shard covering their time range.
+func (cmd *Command) importDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*."+tsm1.TSMFileExtension))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	min, max, err := timeRange(files)
+	if err != nil {
+		return err
+	}
+
+	req := &snapshotter.Request{
+		Type:                   snapshotter.RequestShardImport,
+		RestoreDatabase:        cmd.database,
+		RestoreRetentionPolicy: cmd.retention,
+		ImportStart:            time.Unix(0, min).UTC(),
+		ImportEnd:              time.Unix(0, max).UTC(),
+	}
+
+	shardID, err := cmd.client.ImportShard(req, files)
+	if err != nil {
+		return err
+	}
+	cmd.StdoutLogger.Printf("imported %d files from %s to shard %d", len(files), dir, shardID)
+	return nil
+}
+
+// shardDirs returns the directories at or beneath path that contain TSM
+// files, as written by "influx_inspect buildtsm".
+func shardDirs(path string) ([]string, error) {
+	var dirs []string
+	err := filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if !fi.IsDir() {
+			return nil
+		}
+
+		files, err := filepath.Glob(filepath.Join(p, "*."+tsm1.TSMFileExtension))
+		if err != nil {
+			return err
+		} else if len(files) > 0 {
+			dirs = append(dirs, p)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+// timeRange returns the minimum and maximum timestamps across files.
+func timeRange(files []string) (min, max int64, err error) {
+	for i, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		r, err := tsm1.NewTSMReader(f)
+		if err != nil {
+			f.Close()
+			return 0, 0, fmt.Errorf("%s: %s", path, err)
+		}
+		fmin, fmax := r.TimeRange()
+		r.Close()
+
+		if i == 0 || fmin < min {
+			min = fmin
+		}
+		if i == 0 || fmax > max {
+			max = fmax
+		}
+	}
+	return min, max, nil
+}
+
+// printUsage prints the usage message.
+func (cmd *Command) printUsage() {
+	fmt.Fprintf(cmd.Stdout, `Attaches TSM files built offline, e.g. by "influx_inspect buildtsm", to the
+shards of a running server. Each directory beneath PATH that contains TSM files
+is imported atomically into the shard covering its time range. Shard groups are
+created as needed, and the series in the files are indexed as they are attached.
+
+The files of a directory must fit within a single shard group of the retention
+policy; build them with a -shard-duration matching the retention policy.
+
+Usage: influxd import-tsm [flags] PATH
+
+Options:
+    -host <host:port>
+            The host to connect to. Defaults to 'localhost:8088'.
+    -database <name>
+            Required. The database to import into. It must already exist.
+    -retention <name>
+            Optional. The retention policy to import into. Defaults to the
+            database's default retention policy.
+`)
+}
@@ -0,0 +1,171 @@
+package importtsm_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/cmd/influx_inspect/buildtsm"
+	"github.com/influxdata/influxdb/cmd/influxd/importtsm"
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/services/snapshotter"
+	"github.com/influxdata/influxdb/tcp"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+// Ensure files built by buildtsm are attached to the shards covering their
+// time range and their series are indexed by the target shards.
+func TestCommand_RoundTrip(t *testing.T) {
+	for _, index := range tsdb.RegisteredIndexes() {
+		t.Run(index, func(t *testing.T) { testCommand_RoundTrip(t, index) })
+	}
+}
+
+func testCommand_RoundTrip(t *testing.T, index string) {
+	dir, err := ioutil.TempDir("", "importtsm-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Build a directory of TSM files for each of two days.
+	build := buildtsm.NewCommand()
+	build.Stdin = strings.NewReader(strings.Join([]string{
+		"cpu,host=a value=1 0",
+		"cpu,host=b value=2 1000000000",
+		"mem,host=a free=3 1000000000",
+		"cpu,host=a value=4 86400000000000",
+	}, "\n"))
+	build.Stdout, build.Stderr = ioutil.Discard, ioutil.Discard
+	if err := build.Run("-out", filepath.Join(dir, "out"), "-shard-duration", "24h"); err != nil {
+		t.Fatal(err)
+	}
+
+	store := tsdb.NewStore(filepath.Join(dir, "data"))
+	store.EngineOptions.Config.WALDir = filepath.Join(dir, "wal")
+	store.EngineOptions.IndexVersion = index
+	if err := store.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	s, l := MustOpenService(t, store)
+	defer l.Close()
+	defer s.Close()
+
+	cmd := importtsm.NewCommand()
+	cmd.Stdout, cmd.Stderr = ioutil.Discard, ioutil.Discard
+	if err := cmd.Run("-host", l.Addr().String(), "-database", "db0", "-retention", "rp0", filepath.Join(dir, "out")); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := store.ShardIDs()
+	if len(ids) != 2 {
+		t.Fatalf("unexpected shard count: %d", len(ids))
+	}
+
+	if names, err := store.MeasurementNames(query.OpenAuthorizer, "db0", nil); err != nil {
+		t.Fatal(err)
+	} else if got, exp := names, [][]byte{[]byte("cpu"), []byte("mem")}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected measurements: got %s, exp %s", got, exp)
+	}
+	if n, err := store.SeriesCardinality("db0"); err != nil {
+		t.Fatal(err)
+	} else if n != 3 {
+		t.Fatalf("unexpected series cardinality: %d", n)
+	}
+
+	if got, exp := ReadValues(t, store.Shards(ids), "cpu", "value"), []float64{1, 2, 4}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected values: got %v, exp %v", got, exp)
+	}
+}
+
+// MustOpenService opens a snapshotter service for store, with a database db0
+// and a retention policy rp0 with one day shard groups.
+func MustOpenService(t *testing.T, store *tsdb.Store) (*snapshotter.Service, net.Listener) {
+	data := meta.Data{}
+	if err := data.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	} else if err := data.CreateRetentionPolicy("db0", &meta.RetentionPolicyInfo{
+		Name:               "rp0",
+		ReplicaN:           1,
+		ShardGroupDuration: 24 * time.Hour,
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := tcp.NewMux()
+	go mux.Serve(l)
+
+	s := snapshotter.NewService()
+	s.Listener = mux.Listen(snapshotter.MuxHeader)
+	s.MetaClient = &MetaClient{Data: data}
+	s.TSDBStore = store
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	return s, l
+}
+
+// ReadValues returns the sorted float values of a field of a measurement
+// across shards.
+func ReadValues(t *testing.T, shards []*tsdb.Shard, name, field string) []float64 {
+	itr, err := tsdb.Shards(shards).CreateIterator(context.Background(), &influxql.Measurement{Name: name}, query.IteratorOptions{
+		Expr:      influxql.MustParseExpr(field),
+		StartTime: influxql.MinTime,
+		EndTime:   influxql.MaxTime,
+		Ascending: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer itr.Close()
+
+	var values []float64
+	fitr := itr.(query.FloatIterator)
+	for {
+		p, err := fitr.Next()
+		if err != nil {
+			t.Fatal(err)
+		} else if p == nil {
+			break
+		}
+		values = append(values, p.Value)
+	}
+	sort.Float64s(values)
+	return values
+}
+
+// MetaClient is a snapshotter meta client backed by meta data.
+type MetaClient struct {
+	Data meta.Data
+}
+
+func (m *MetaClient) MarshalBinary() ([]byte, error) {
+	return m.Data.MarshalBinary()
+}
+
+func (m *MetaClient) Database(name string) *meta.DatabaseInfo {
+	return m.Data.Database(name)
+}
+
+func (m *MetaClient) CreateShardGroup(database, policy string, timestamp time.Time) (*meta.ShardGroupInfo, error) {
+	if err := m.Data.CreateShardGroup(database, policy, timestamp); err != nil {
+		return nil, err
+	}
+	return m.Data.ShardGroupByTimestamp(database, policy, timestamp)
+}
+
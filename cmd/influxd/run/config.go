@@ -1,6 +1,7 @@
 package run
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -107,6 +108,7 @@ func NewDemoConfig() (*Config, error) {
 	c.Meta.Dir = filepath.Join(homeDir, ".influxdb/meta")
 	c.Data.Dir = filepath.Join(homeDir, ".influxdb/data")
 	c.Data.WALDir = filepath.Join(homeDir, ".influxdb/wal")
+	c.Data.RejectedPointsDir = filepath.Join(homeDir, ".influxdb/rejected")
 
 	return c, nil
 }
@@ -159,6 +161,15 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Coordinator.Validate(); err != nil {
+		return err
+	}
+
+	// Queries are only attributed to a user when authentication is enabled.
+	if len(c.Coordinator.BatchQueryUsers) > 0 && !c.HTTPD.AuthEnabled {
+		return errors.New("batch-query-users requires http auth-enabled")
+	}
+
 	if err := c.ContinuousQuery.Validate(); err != nil {
 		return err
 	}
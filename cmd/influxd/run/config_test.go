@@ -258,6 +258,28 @@ enabled = false
 	}
 }
 
+func TestConfig_ValidateBatchQueryUsers_AuthDisabled(t *testing.T) {
+	c := run.NewConfig()
+	c.Meta.Dir = "/tmp/meta"
+	c.Data.Dir = "/tmp/data"
+	c.Data.WALDir = "/tmp/wal"
+	if _, err := toml.Decode(`
+[coordinator]
+batch-query-users = ["export"]
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Validate(); err == nil {
+		t.Fatalf("got nil, expected error")
+	}
+
+	c.HTTPD.AuthEnabled = true
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestConfig_DeprecatedOptions(t *testing.T) {
 	// Parse configuration.
 	var c run.Config
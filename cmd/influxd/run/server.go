@@ -81,6 +81,9 @@ type Server struct {
 
 	Monitor *monitor.Monitor
 
+	// Records slow SELECT statements, if enabled.
+	SlowQueryLog *query.SlowQueryLog
+
 	// Server reporting and registration
 	reportingDisabled bool
 
@@ -176,6 +179,18 @@ func NewServer(c *Config, buildInfo *BuildInfo) (*Server, error) {
 	s.PointsWriter = coordinator.NewPointsWriter()
 	s.PointsWriter.WriteTimeout = time.Duration(c.Coordinator.WriteTimeout)
 	s.PointsWriter.TSDBStore = s.TSDBStore
+	s.PointsWriter.RejectedPoints = s.TSDBStore
+
+	// Initialize the slow query log.
+	var slowQueryDatabase string
+	if c.Coordinator.SlowQueryLogThreshold > 0 {
+		s.SlowQueryLog = query.NewSlowQueryLog(c.Coordinator.SlowQueryLogPath)
+		s.SlowQueryLog.Threshold = time.Duration(c.Coordinator.SlowQueryLogThreshold)
+		s.SlowQueryLog.MaxSize = int64(c.Coordinator.SlowQueryLogMaxSize)
+		if c.Coordinator.SlowQueryLogInternal && c.Monitor.StoreEnabled {
+			slowQueryDatabase = c.Monitor.StoreDatabase
+		}
+	}
 
 	// Initialize query executor.
 	s.QueryExecutor = query.NewQueryExecutor()
@@ -187,15 +202,29 @@ func NewServer(c *Config, buildInfo *BuildInfo) (*Server, error) {
 			MetaClient: s.MetaClient,
 			TSDBStore:  coordinator.LocalTSDBStore{Store: s.TSDBStore},
 		},
-		Monitor:           s.Monitor,
-		PointsWriter:      s.PointsWriter,
-		MaxSelectPointN:   c.Coordinator.MaxSelectPointN,
-		MaxSelectSeriesN:  c.Coordinator.MaxSelectSeriesN,
-		MaxSelectBucketsN: c.Coordinator.MaxSelectBucketsN,
+		Monitor:             s.Monitor,
+		PointsWriter:        s.PointsWriter,
+		MaxSelectPointN:     c.Coordinator.MaxSelectPointN,
+		MaxSelectSeriesN:    c.Coordinator.MaxSelectSeriesN,
+		MaxSelectBucketsN:   c.Coordinator.MaxSelectBucketsN,
+		MaxSelectBlocksN:    c.Coordinator.MaxSelectBlocksN,
+		MaxSelectBytesN:     int64(c.Coordinator.MaxSelectBytesN),
+		MaxSelectCursorTime: time.Duration(c.Coordinator.MaxSelectCursorTime),
+		SlowQueryLog:        s.SlowQueryLog,
+		SlowQueryDatabase:   slowQueryDatabase,
 	}
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
 	s.QueryExecutor.TaskManager.MaxConcurrentQueries = c.Coordinator.MaxConcurrentQueries
+	s.QueryExecutor.TaskManager.MaxQueuedQueries = c.Coordinator.MaxQueuedQueries
+	s.QueryExecutor.TaskManager.QueueTimeout = time.Duration(c.Coordinator.QueryQueueTimeout)
+	s.QueryExecutor.TaskManager.ReservedContinuousQueries = c.Coordinator.ReservedContinuousQueries
+	if len(c.Coordinator.BatchQueryUsers) > 0 {
+		s.QueryExecutor.TaskManager.UserPriorities = make(map[string]query.QueryPriority)
+		for _, name := range c.Coordinator.BatchQueryUsers {
+			s.QueryExecutor.TaskManager.UserPriorities[name] = query.BatchPriority
+		}
+	}
 
 	// Initialize the monitor
 	s.Monitor.Version = s.buildInfo.Version
@@ -260,6 +289,7 @@ func (s *Server) appendHTTPDService(c httpd.Config) {
 	srv.Handler.QueryExecutor = s.QueryExecutor
 	srv.Handler.Monitor = s.Monitor
 	srv.Handler.PointsWriter = s.PointsWriter
+	srv.Handler.RejectedPoints = s.TSDBStore
 	srv.Handler.Version = s.buildInfo.Version
 	srv.Handler.BuildType = "OSS"
 
@@ -273,6 +303,8 @@ func (s *Server) appendStorageService(c storage.Config) {
 	srv := storage.NewService(c)
 	srv.MetaClient = s.MetaClient
 	srv.TSDBStore = s.TSDBStore
+	srv.PointsWriter = s.PointsWriter
+	srv.AuthEnabled = s.config.HTTPD.AuthEnabled
 
 	s.Services = append(s.Services, srv)
 }
@@ -311,6 +343,7 @@ func (s *Server) appendGraphiteService(c graphite.Config) error {
 	}
 
 	srv.PointsWriter = s.PointsWriter
+	srv.RejectedPoints = s.TSDBStore
 	srv.MetaClient = s.MetaClient
 	srv.Monitor = s.Monitor
 	s.Services = append(s.Services, srv)
@@ -333,6 +366,7 @@ func (s *Server) appendUDPService(c udp.Config) {
 	}
 	srv := udp.NewService(c)
 	srv.PointsWriter = s.PointsWriter
+	srv.RejectedPoints = s.TSDBStore
 	srv.MetaClient = s.MetaClient
 	s.Services = append(s.Services, srv)
 }
@@ -414,6 +448,14 @@ func (s *Server) Open() error {
 	s.SnapshotterService.WithLogger(s.Logger)
 	s.Monitor.WithLogger(s.Logger)
 
+	// Open the slow query log.
+	if s.SlowQueryLog != nil {
+		s.SlowQueryLog.Logger = s.Logger.With(zap.String("service", "slow_query_log"))
+		if err := s.SlowQueryLog.Open(); err != nil {
+			return fmt.Errorf("open slow query log: %s", err)
+		}
+	}
+
 	// Open TSDB store.
 	if err := s.TSDBStore.Open(); err != nil {
 		return fmt.Errorf("open tsdb store: %s", err)
@@ -470,6 +512,10 @@ func (s *Server) Close() error {
 		s.QueryExecutor.Close()
 	}
 
+	if s.SlowQueryLog != nil {
+		s.SlowQueryLog.Close()
+	}
+
 	// Close the TSDBStore, no more reads or writes at this point
 	if s.TSDBStore != nil {
 		s.TSDBStore.Close()
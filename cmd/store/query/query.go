@@ -44,8 +44,9 @@ type Command struct {
 	agg             string
 	grouping        string
 	keys            []string
+	window          time.Duration
 
-	aggType storage.Aggregate_AggregateType
+	aggTypes []storage.Aggregate_AggregateType
 
 	// response
 	integerSum  int64
@@ -91,7 +92,8 @@ func (cmd *Command) Run(args ...string) error {
 	fs.BoolVar(&cmd.desc, "desc", false, "Optional: return results in descending order")
 	fs.BoolVar(&cmd.silent, "silent", false, "silence output")
 	fs.StringVar(&cmd.expr, "expr", "", "InfluxQL conditional expression")
-	fs.StringVar(&cmd.agg, "agg", "", "aggregate functions (sum, count)")
+	fs.StringVar(&cmd.agg, "agg", "", "comma-separated list of aggregate functions (sum, count, min, max, mean, first, last)")
+	fs.DurationVar(&cmd.window, "window", 0, "Optional: duration of the windows to aggregate (requires -agg)")
 	fs.StringVar(&cmd.grouping, "grouping", "", "comma-separated list of tags to specify series order")
 
 	fs.SetOutput(cmd.Stdout)
@@ -128,10 +130,12 @@ func (cmd *Command) Run(args ...string) error {
 
 	if cmd.agg != "" {
 		tm := proto.EnumValueMap("storage.Aggregate_AggregateType")
-		if agg, ok := tm[strings.ToUpper(cmd.agg)]; !ok {
-			return errors.New("invalid aggregate function: " + cmd.agg)
-		} else {
-			cmd.aggType = storage.Aggregate_AggregateType(agg)
+		for _, name := range strings.Split(cmd.agg, ",") {
+			if agg, ok := tm[strings.ToUpper(name)]; !ok {
+				return errors.New("invalid aggregate function: " + name)
+			} else {
+				cmd.aggTypes = append(cmd.aggTypes, storage.Aggregate_AggregateType(agg))
+			}
 		}
 	}
 
@@ -160,6 +164,9 @@ func (cmd *Command) validate() error {
 	if cmd.startTime != 0 && cmd.endTime != 0 && cmd.endTime < cmd.startTime {
 		return fmt.Errorf("end time before start time")
 	}
+	if cmd.window != 0 && len(cmd.aggTypes) == 0 {
+		return fmt.Errorf("window requires an aggregate")
+	}
 	return nil
 }
 
@@ -179,9 +186,10 @@ func (cmd *Command) query(c storage.StorageClient) error {
 	req.Descending = cmd.desc
 	req.Grouping = cmd.keys
 
-	if cmd.aggType != storage.AggregateTypeNone {
-		req.Aggregate = &storage.Aggregate{Type: cmd.aggType}
+	for _, t := range cmd.aggTypes {
+		req.Aggregates = append(req.Aggregates, &storage.Aggregate{Type: t})
 	}
+	req.Window = int64(cmd.window)
 
 	if cmd.expr != "" {
 		expr, err := influxql.ParseExpr(cmd.expr)
@@ -3,6 +3,7 @@
 package coordinator
 
 import (
+	"errors"
 	"time"
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
@@ -25,17 +26,48 @@ const (
 	// DefaultMaxSelectSeriesN is the maximum number of series a SELECT can run.
 	// A value of zero will make the maximum series count unlimited.
 	DefaultMaxSelectSeriesN = 0
+
+	// DefaultSlowQueryLogMaxSize is the size at which the slow query log is rotated.
+	DefaultSlowQueryLogMaxSize = 10 * 1024 * 1024 // 10MB
 )
 
 // Config represents the configuration for the coordinator service.
 type Config struct {
 	WriteTimeout         toml.Duration `toml:"write-timeout"`
 	MaxConcurrentQueries int           `toml:"max-concurrent-queries"`
+	MaxQueuedQueries     int           `toml:"max-queued-queries"`
+	QueryQueueTimeout    toml.Duration `toml:"query-queue-timeout"`
 	QueryTimeout         toml.Duration `toml:"query-timeout"`
 	LogQueriesAfter      toml.Duration `toml:"log-queries-after"`
 	MaxSelectPointN      int           `toml:"max-select-point"`
 	MaxSelectSeriesN     int           `toml:"max-select-series"`
 	MaxSelectBucketsN    int           `toml:"max-select-buckets"`
+	MaxSelectBlocksN     int           `toml:"max-select-blocks"`
+	MaxSelectBytesN      toml.Size     `toml:"max-select-bytes"`
+	MaxSelectCursorTime  toml.Duration `toml:"max-select-cursor-time"`
+
+	// SlowQueryLogThreshold enables the slow query log for SELECT statements
+	// that run for at least this long. A value of zero disables it.
+	SlowQueryLogThreshold toml.Duration `toml:"slow-query-log-threshold"`
+
+	// SlowQueryLogPath is the file the slow query log is written to as JSON.
+	// If empty, entries are not written to a file.
+	SlowQueryLogPath string `toml:"slow-query-log-path"`
+
+	// SlowQueryLogMaxSize is the size at which the slow query log file is rotated.
+	SlowQueryLogMaxSize toml.Size `toml:"slow-query-log-max-size"`
+
+	// SlowQueryLogInternal writes slow queries to the monitor's store database.
+	SlowQueryLogInternal bool `toml:"slow-query-log-internal"`
+
+	// ReservedContinuousQueries is the number of max-concurrent-queries
+	// slots that only continuous queries may use.
+	ReservedContinuousQueries int `toml:"reserved-continuous-queries"`
+
+	// BatchQueryUsers lists the users whose queries run with batch priority
+	// and wait behind interactive queries when queued. Queries are only
+	// attributed to a user when authentication is enabled.
+	BatchQueryUsers []string `toml:"batch-query-users"`
 }
 
 // NewConfig returns an instance of Config with defaults.
@@ -46,18 +78,46 @@ func NewConfig() Config {
 		MaxConcurrentQueries: DefaultMaxConcurrentQueries,
 		MaxSelectPointN:      DefaultMaxSelectPointN,
 		MaxSelectSeriesN:     DefaultMaxSelectSeriesN,
+		SlowQueryLogMaxSize:  toml.Size(DefaultSlowQueryLogMaxSize),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Config) Validate() error {
+	if c.MaxConcurrentQueries < 0 {
+		return errors.New("max-concurrent-queries must be non-negative")
+	}
+	if c.MaxQueuedQueries < 0 {
+		return errors.New("max-queued-queries must be non-negative")
+	}
+	if c.ReservedContinuousQueries < 0 {
+		return errors.New("reserved-continuous-queries must be non-negative")
+	}
+	if c.ReservedContinuousQueries > 0 && c.ReservedContinuousQueries >= c.MaxConcurrentQueries {
+		return errors.New("reserved-continuous-queries must be less than max-concurrent-queries")
 	}
+	return nil
 }
 
 // Diagnostics returns a diagnostics representation of a subset of the Config.
 func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	return diagnostics.RowFromMap(map[string]interface{}{
-		"write-timeout":          c.WriteTimeout,
-		"max-concurrent-queries": c.MaxConcurrentQueries,
-		"query-timeout":          c.QueryTimeout,
-		"log-queries-after":      c.LogQueriesAfter,
-		"max-select-point":       c.MaxSelectPointN,
-		"max-select-series":      c.MaxSelectSeriesN,
-		"max-select-buckets":     c.MaxSelectBucketsN,
+		"write-timeout":               c.WriteTimeout,
+		"max-concurrent-queries":      c.MaxConcurrentQueries,
+		"max-queued-queries":          c.MaxQueuedQueries,
+		"query-queue-timeout":         c.QueryQueueTimeout,
+		"reserved-continuous-queries": c.ReservedContinuousQueries,
+		"query-timeout":               c.QueryTimeout,
+		"log-queries-after":           c.LogQueriesAfter,
+		"max-select-point":            c.MaxSelectPointN,
+		"max-select-series":           c.MaxSelectSeriesN,
+		"max-select-buckets":          c.MaxSelectBucketsN,
+		"max-select-blocks":           c.MaxSelectBlocksN,
+		"max-select-bytes":            c.MaxSelectBytesN,
+		"max-select-cursor-time":      c.MaxSelectCursorTime,
+		"slow-query-log-threshold":    c.SlowQueryLogThreshold,
+		"slow-query-log-path":         c.SlowQueryLogPath,
+		"slow-query-log-max-size":     c.SlowQueryLogMaxSize,
+		"slow-query-log-internal":     c.SlowQueryLogInternal,
 	}), nil
 }
@@ -13,6 +13,12 @@ func TestConfig_Parse(t *testing.T) {
 	var c coordinator.Config
 	if _, err := toml.Decode(`
 write-timeout = "20s"
+max-select-blocks = 1000
+max-select-bytes = "10m"
+max-select-cursor-time = "5s"
+slow-query-log-threshold = "2s"
+slow-query-log-path = "/var/log/influxdb/slow.log"
+slow-query-log-internal = true
 `, &c); err != nil {
 		t.Fatal(err)
 	}
@@ -20,5 +26,37 @@ write-timeout = "20s"
 	// Validate configuration.
 	if time.Duration(c.WriteTimeout) != 20*time.Second {
 		t.Fatalf("unexpected write timeout s: %s", c.WriteTimeout)
+	} else if c.MaxSelectBlocksN != 1000 {
+		t.Fatalf("unexpected max select blocks: %d", c.MaxSelectBlocksN)
+	} else if c.MaxSelectBytesN != 10*1024*1024 {
+		t.Fatalf("unexpected max select bytes: %d", c.MaxSelectBytesN)
+	} else if time.Duration(c.MaxSelectCursorTime) != 5*time.Second {
+		t.Fatalf("unexpected max select cursor time: %s", c.MaxSelectCursorTime)
+	} else if time.Duration(c.SlowQueryLogThreshold) != 2*time.Second {
+		t.Fatalf("unexpected slow query log threshold: %s", c.SlowQueryLogThreshold)
+	} else if c.SlowQueryLogPath != "/var/log/influxdb/slow.log" {
+		t.Fatalf("unexpected slow query log path: %s", c.SlowQueryLogPath)
+	} else if !c.SlowQueryLogInternal {
+		t.Fatal("expected slow query log internal to be enabled")
+	}
+}
+
+func TestConfig_Validate_ReservedContinuousQueries(t *testing.T) {
+	c := coordinator.NewConfig()
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c.MaxConcurrentQueries = 2
+	c.ReservedContinuousQueries = 1
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, tt := range []struct{ max, reserved int }{{0, 1}, {2, 2}, {2, -1}} {
+		c.MaxConcurrentQueries, c.ReservedContinuousQueries = tt.max, tt.reserved
+		if err := c.Validate(); err == nil {
+			t.Fatalf("expected error with max-concurrent-queries = %d, reserved-continuous-queries = %d", tt.max, tt.reserved)
+		}
 	}
 }
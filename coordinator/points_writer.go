@@ -60,6 +60,12 @@ type PointsWriter struct {
 		WriteToShard(shardID uint64, points []models.Point) error
 	}
 
+	// RejectedPoints records points dropped for being beyond their
+	// retention policy. It may be nil.
+	RejectedPoints interface {
+		WriteRejectedPoints(database string, rejected []tsdb.RejectedPoint) error
+	}
+
 	subPoints []chan<- *WritePointsRequest
 
 	stats *WriteStatistics
@@ -336,9 +342,20 @@ func (w *PointsWriter) WritePointsPrivileged(database, retentionPolicy string, c
 		atomic.AddInt64(&w.stats.SubWriteDrop, dropped)
 	}
 
+	var partial *tsdb.PartialWriteError
 	if err == nil && len(shardMappings.Dropped) > 0 {
-		err = tsdb.PartialWriteError{Reason: "points beyond retention policy", Dropped: len(shardMappings.Dropped)}
+		const reason = "points beyond retention policy"
+		partial = &tsdb.PartialWriteError{Reason: reason, Dropped: len(shardMappings.Dropped)}
+		partial.Rejected = make([]tsdb.RejectedPoint, len(shardMappings.Dropped))
+		for i, p := range shardMappings.Dropped {
+			partial.Rejected[i] = tsdb.RejectedPoint{Point: p, Key: p.Key(), RetentionPolicy: retentionPolicy, Reason: tsdb.RejectBeyondRetention, Message: reason}
+		}
 
+		if w.RejectedPoints != nil {
+			if err := w.RejectedPoints.WriteRejectedPoints(database, partial.Rejected); err != nil {
+				w.Logger.Info(fmt.Sprintf("Failed to record rejected points: %s", err))
+			}
+		}
 	}
 	timeout := time.NewTimer(w.WriteTimeout)
 	defer timeout.Stop()
@@ -351,12 +368,22 @@ func (w *PointsWriter) WritePointsPrivileged(database, retentionPolicy string, c
 			// return timeout error to caller
 			return ErrTimeout
 		case err := <-ch:
-			if err != nil {
+			// Combine partial writes from every shard so that all of the
+			// dropped points are reported back to the caller.
+			if perr, ok := err.(tsdb.PartialWriteError); ok {
+				if partial == nil {
+					partial = &tsdb.PartialWriteError{}
+				}
+				partial.Merge(perr)
+			} else if err != nil {
 				return err
 			}
 		}
 	}
-	return err
+	if partial != nil {
+		return *partial
+	}
+	return nil
 }
 
 // writeToShards writes points to a shard.
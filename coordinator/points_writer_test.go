@@ -423,8 +423,17 @@ func TestPointsWriter_WritePoints_Dropped(t *testing.T) {
 	defer c.Close()
 
 	err := c.WritePointsPrivileged(pr.Database, pr.RetentionPolicy, models.ConsistencyLevelOne, pr.Points)
-	if _, ok := err.(tsdb.PartialWriteError); !ok {
-		t.Errorf("PointsWriter.WritePoints(): got %v, exp %v", err, tsdb.PartialWriteError{})
+	werr, ok := err.(tsdb.PartialWriteError)
+	if !ok {
+		t.Fatalf("PointsWriter.WritePoints(): got %v, exp %v", err, tsdb.PartialWriteError{})
+	}
+
+	if got, exp := len(werr.Rejected), 1; got != exp {
+		t.Fatalf("unexpected number of rejected points: got %d, exp %d", got, exp)
+	} else if got, exp := werr.Rejected[0].Reason, tsdb.RejectBeyondRetention; got != exp {
+		t.Fatalf("unexpected rejection reason: got %q, exp %q", got, exp)
+	} else if werr.Rejected[0].Point != pr.Points[0] {
+		t.Fatalf("unexpected rejected point: %v", werr.Rejected[0].Point)
 	}
 }
 
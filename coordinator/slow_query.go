@@ -0,0 +1,149 @@
+package coordinator
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/tracing"
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxql"
+	"go.uber.org/zap"
+)
+
+// slowQueryMeasurement is the measurement slow queries are written to when
+// they are recorded in a database.
+const slowQueryMeasurement = "slow_queries"
+
+// slowQuery tracks the execution of a statement that may be written to the
+// slow query log.
+type slowQuery struct {
+	start time.Time
+	trace *tracing.Trace
+	span  *tracing.Span
+	stats query.IteratorStats
+
+	// The auxiliary iterators of the statement.
+	aux query.Iterators
+}
+
+// newSlowQuery starts tracing a statement and returns the context to execute
+// it with.
+func newSlowQuery(ctx context.Context) (*slowQuery, context.Context) {
+	t, span := tracing.NewTrace("select")
+	ctx = tracing.NewContextWithTrace(ctx, t)
+	ctx = tracing.NewContextWithSpan(ctx, span)
+	sq := &slowQuery{start: time.Now(), trace: t, span: span}
+	ctx = query.NewContextWithIterators(ctx, &sq.aux)
+	ctx = query.NewContextWithCursorTime(ctx)
+	return sq, ctx
+}
+
+// collect records the stats of the statement's iterators. It must be called
+// before the iterators are closed.
+func (sq *slowQuery) collect(itrs []query.Iterator) {
+	sq.stats = withAuxIterators(itrs, sq.aux).Stats()
+}
+
+// logSlowQuery writes the statement to the slow query log if it ran for at
+// least the log's threshold.
+func (e *StatementExecutor) logSlowQuery(sq *slowQuery, stmt *influxql.SelectStatement, ectx *query.ExecutionContext, err error) {
+	d := time.Since(sq.start)
+	sq.span.Finish()
+	if d < e.SlowQueryLog.Threshold {
+		return
+	}
+
+	tree := sq.trace.Tree()
+	entry := &query.SlowQueryEntry{
+		Time:       time.Now().UTC(),
+		Query:      stmt.String(),
+		Database:   ectx.Database,
+		Duration:   d,
+		SeriesN:    sq.stats.SeriesN,
+		ShardsN:    countTracedShards(tree),
+		PointN:     sq.stats.PointN,
+		BlocksN:    sq.stats.BlocksN,
+		BlockBytes: sq.stats.BlockBytes,
+		CursorTime: sq.stats.CursorTime,
+		Plan:       strings.Split(strings.TrimSpace(tree.String()), "\n"),
+	}
+	if user, ok := ectx.Authorizer.(meta.User); ok {
+		entry.User = user.ID()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	log := e.SlowQueryLog.Logger
+	if err := e.SlowQueryLog.WriteEntry(entry); err != nil {
+		log.Info("Failed to write slow query log", zap.Error(err))
+	}
+
+	if e.SlowQueryDatabase != "" {
+		if err := e.writeSlowQueryPoint(entry); err != nil {
+			log.Info("Failed to write slow query point",
+				zap.String("db", e.SlowQueryDatabase), zap.Error(err))
+		}
+	}
+}
+
+// writeSlowQueryPoint records the entry as a point in the slow query database.
+func (e *StatementExecutor) writeSlowQueryPoint(entry *query.SlowQueryEntry) error {
+	tags := map[string]string{"database": entry.Database}
+	if entry.User != "" {
+		tags["user"] = entry.User
+	}
+
+	fields := map[string]interface{}{
+		"query":          entry.Query,
+		"duration_ns":    int64(entry.Duration),
+		"series":         int64(entry.SeriesN),
+		"shards":         int64(entry.ShardsN),
+		"points_scanned": int64(entry.PointN),
+		"blocks_decoded": int64(entry.BlocksN),
+		"block_bytes":    entry.BlockBytes,
+		"cursor_time_ns": int64(entry.CursorTime),
+		"plan":           strings.Join(entry.Plan, "\n"),
+	}
+	if entry.Error != "" {
+		fields["error"] = entry.Error
+	}
+
+	pt, err := models.NewPoint(slowQueryMeasurement, models.NewTags(tags), fields, entry.Time)
+	if err != nil {
+		return err
+	}
+
+	return e.PointsWriter.WritePointsInto(&IntoWriteRequest{
+		Database: e.SlowQueryDatabase,
+		Points:   []models.Point{pt},
+	})
+}
+
+// countTracedShards returns the number of distinct shards that created
+// iterators within the traced tree.
+func countTracedShards(tree *tracing.TreeNode) int {
+	v := shardCountVisitor{ids: make(map[uint64]struct{})}
+	tracing.Walk(v, tree)
+	return len(v.ids)
+}
+
+type shardCountVisitor struct {
+	ids map[uint64]struct{}
+}
+
+func (v shardCountVisitor) Visit(node *tracing.TreeNode) tracing.Visitor {
+	for _, l := range node.Raw.Labels {
+		if l.Key != "shard_id" {
+			continue
+		}
+		if id, err := strconv.ParseUint(l.Value, 10, 64); err == nil {
+			v.ids[id] = struct{}{}
+		}
+	}
+	return v
+}
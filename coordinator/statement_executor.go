@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -50,9 +51,18 @@ type StatementExecutor struct {
 	PointsWriter pointsWriter
 
 	// Select statement limits
-	MaxSelectPointN   int
-	MaxSelectSeriesN  int
-	MaxSelectBucketsN int
+	MaxSelectPointN     int
+	MaxSelectSeriesN    int
+	MaxSelectBucketsN   int
+	MaxSelectBlocksN    int
+	MaxSelectBytesN     int64
+	MaxSelectCursorTime time.Duration
+
+	// SlowQueryLog records SELECT statements that run for longer than its
+	// threshold. If SlowQueryDatabase is set, the entries are also written
+	// to that database. A nil log disables slow query logging.
+	SlowQueryLog      *query.SlowQueryLog
+	SlowQueryDatabase string
 }
 
 // ExecuteStatement executes the given statement with the given execution context.
@@ -91,6 +101,11 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx query.
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeCreateSubscriptionStatement(stmt)
+	case *query.CreateSubscriptionStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeCreateFilteredSubscriptionStatement(stmt)
 	case *influxql.CreateUserStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -176,6 +191,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx query.
 		return e.executeShowMeasurementsStatement(stmt, &ctx)
 	case *influxql.ShowMeasurementCardinalityStatement:
 		rows, err = e.executeShowMeasurementCardinalityStatement(stmt)
+	case *query.ShowRejectedPointsStatement:
+		rows, err = e.executeShowRejectedPointsStatement(stmt)
 	case *influxql.ShowRetentionPoliciesStatement:
 		rows, err = e.executeShowRetentionPoliciesStatement(stmt)
 	case *influxql.ShowSeriesCardinalityStatement:
@@ -317,6 +334,42 @@ func (e *StatementExecutor) executeCreateSubscriptionStatement(q *influxql.Creat
 	return e.MetaClient.CreateSubscription(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations)
 }
 
+func (e *StatementExecutor) executeCreateFilteredSubscriptionStatement(q *query.CreateSubscriptionStatement) error {
+	if err := e.validateSubscriptionFilter(q); err != nil {
+		return err
+	}
+	return e.MetaClient.CreateSubscriptionWithFilter(q.Database, q.RetentionPolicy, q.Name, q.Mode, q.Destinations, q.Condition.String())
+}
+
+// validateSubscriptionFilter returns an error if the filter of a subscription
+// references a field of the measurements in its retention policy. Points are
+// only filtered by their measurement and tags, so a field never matches.
+func (e *StatementExecutor) validateSubscriptionFilter(q *query.CreateSubscriptionStatement) error {
+	m := &influxql.Measurement{
+		Database:        q.Database,
+		RetentionPolicy: q.RetentionPolicy,
+		Regex:           &influxql.RegexLiteral{Val: regexp.MustCompile(`.`)},
+	}
+	sg, err := e.ShardMapper.MapShards(influxql.Sources{m}, influxql.TimeRange{}, query.SelectOptions{})
+	if err != nil {
+		return err
+	}
+	defer sg.Close()
+
+	fields, _, err := sg.FieldDimensions(m)
+	if err != nil {
+		return err
+	}
+	for _, ref := range influxql.ExprNames(q.Condition) {
+		if ref.Type == influxql.Tag || query.IsSubscriptionMeasurementRef(&ref) {
+			continue
+		} else if _, ok := fields[ref.Val]; ok {
+			return fmt.Errorf("subscription filter cannot reference field %q, only the measurement and tags", ref.Val)
+		}
+	}
+	return nil
+}
+
 func (e *StatementExecutor) executeCreateUserStatement(q *influxql.CreateUserStatement) error {
 	_, err := e.MetaClient.CreateUser(q.Name, q.Password, q.Admin)
 	return err
@@ -453,6 +506,7 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 	ctx = tracing.NewContextWithSpan(ctx, span)
 	var aux query.Iterators
 	ctx = query.NewContextWithIterators(ctx, &aux)
+	ctx = query.NewContextWithCursorTime(ctx)
 	start := time.Now()
 
 	itrs, columns, err := e.createIterators(ctx, stmt, ectx)
@@ -493,6 +547,7 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 	}
 
 CLEANUP:
+	stats := query.Iterators(itrs).Stats()
 	em.Close()
 	if err != nil {
 		return nil, err
@@ -506,6 +561,9 @@ CLEANUP:
 		fields.Duration("total_time", totalTime),
 		fields.Duration("planning_time", iterTime),
 		fields.Duration("execution_time", totalTime-iterTime),
+		fields.Int64("blocks_decoded", int64(stats.BlocksN)),
+		fields.Int64("block_bytes", stats.BlockBytes),
+		fields.Duration("cursor_time", stats.CursorTime),
 	)
 	span.Finish()
 
@@ -551,7 +609,13 @@ func (e *StatementExecutor) executeSetPasswordUserStatement(q *influxql.SetPassw
 	return e.MetaClient.UpdateUser(q.Name, q.Password)
 }
 
-func (e *StatementExecutor) executeSelectStatement(ctx context.Context, stmt *influxql.SelectStatement, ectx *query.ExecutionContext) error {
+func (e *StatementExecutor) executeSelectStatement(ctx context.Context, stmt *influxql.SelectStatement, ectx *query.ExecutionContext) (err error) {
+	var sq *slowQuery
+	if e.SlowQueryLog != nil {
+		sq, ctx = newSlowQuery(ctx)
+		defer func() { e.logSlowQuery(sq, stmt, ectx, err) }()
+	}
+
 	itrs, columns, err := e.createIterators(ctx, stmt, ectx)
 	if err != nil {
 		return err
@@ -566,6 +630,10 @@ func (e *StatementExecutor) executeSelectStatement(ctx context.Context, stmt *in
 	em.OmitTime = stmt.OmitTime
 	em.EmitName = stmt.EmitName
 	defer em.Close()
+	if sq != nil {
+		defer sq.collect(itrs)
+	}
+	columnTypes := em.ColumnTypes()
 
 	// Emit rows to the results channel.
 	var writeN int64
@@ -603,6 +671,7 @@ func (e *StatementExecutor) executeSelectStatement(ctx context.Context, stmt *in
 			StatementID: ectx.StatementID,
 			Series:      []*models.Row{row},
 			Partial:     partial,
+			ColumnTypes: columnTypes,
 		}
 
 		// Send results or exit if closing.
@@ -654,20 +723,63 @@ func (e *StatementExecutor) createIterators(ctx context.Context, stmt *influxql.
 		MaxBucketsN: e.MaxSelectBucketsN,
 		Authorizer:  ectx.Authorizer,
 	}
+	if e.MaxSelectCursorTime > 0 {
+		ctx = query.NewContextWithCursorTime(ctx)
+	}
+
+	// Capture the auxiliary iterators unless the caller does so they can be
+	// included in the cost of the query.
+	aux := query.IteratorsFromContext(ctx)
+	if aux == nil {
+		aux = new(query.Iterators)
+		ctx = query.NewContextWithIterators(ctx, aux)
+	}
 
 	// Create a set of iterators from a selection.
 	itrs, columns, err := query.Select(ctx, stmt, e.ShardMapper, opt)
 	if err != nil {
 		return nil, nil, err
 	}
+	inputs := withAuxIterators(itrs, *aux)
 
 	if e.MaxSelectPointN > 0 {
 		monitor := query.PointLimitMonitor(itrs, query.DefaultStatsInterval, e.MaxSelectPointN)
 		ectx.Query.Monitor(monitor)
 	}
+	if e.MaxSelectBlocksN > 0 || e.MaxSelectBytesN > 0 || e.MaxSelectCursorTime > 0 {
+		monitor := query.CostLimitMonitor(inputs, query.DefaultStatsInterval, query.CostLimits{
+			MaxBlocksN:    e.MaxSelectBlocksN,
+			MaxBytesN:     e.MaxSelectBytesN,
+			MaxCursorTime: e.MaxSelectCursorTime,
+		})
+		ectx.Query.Monitor(monitor)
+	}
+	if ectx.Query != nil {
+		ectx.Query.SetIterators(inputs)
+	}
 	return itrs, columns, nil
 }
 
+// withAuxIterators returns the iterators of a statement together with its
+// auxiliary iterators. The iterators of the fields of a raw query read from
+// the auxiliary iterators, so only those hold the stats of its inputs.
+func withAuxIterators(itrs, aux query.Iterators) query.Iterators {
+	all := append(query.Iterators{}, itrs...)
+
+	// A selector with auxiliary fields returns its auxiliary iterator as the
+	// iterator of the selector, so only add the others.
+AUX:
+	for _, a := range aux {
+		for _, itr := range itrs {
+			if itr == a {
+				continue AUX
+			}
+		}
+		all = append(all, a)
+	}
+	return all
+}
+
 func (e *StatementExecutor) executeShowContinuousQueriesStatement(stmt *influxql.ShowContinuousQueriesStatement) (models.Rows, error) {
 	dis := e.MetaClient.Databases()
 
@@ -742,7 +854,9 @@ func (e *StatementExecutor) executeShowMeasurementsStatement(q *influxql.ShowMea
 		return ErrDatabaseNameRequired
 	}
 
-	names, err := e.TSDBStore.MeasurementNames(ctx.Authorizer, q.Database, q.Condition)
+	// Resolve now() so the store can bound the measurements by time.
+	cond := influxql.Reduce(q.Condition, &influxql.NowValuer{Now: time.Now()})
+	names, err := e.TSDBStore.MeasurementNames(ctx.Authorizer, q.Database, cond)
 	if err != nil || len(names) == 0 {
 		return ctx.Send(&query.Result{
 			StatementID: ctx.StatementID,
@@ -852,6 +966,29 @@ func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShards
 	return rows, nil
 }
 
+func (e *StatementExecutor) executeShowRejectedPointsStatement(stmt *query.ShowRejectedPointsStatement) (models.Rows, error) {
+	if stmt.Database == "" {
+		return nil, ErrDatabaseNameRequired
+	}
+
+	entries, err := e.TSDBStore.RejectedPoints(stmt.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &models.Row{Name: "rejected_points", Columns: []string{"time", "retention_policy", "reason", "message", "point"}}
+	for _, entry := range entries {
+		row.Values = append(row.Values, []interface{}{
+			entry.Time.UTC().Format(time.RFC3339Nano),
+			entry.RetentionPolicy,
+			entry.Reason,
+			entry.Message,
+			string(entry.Point),
+		})
+	}
+	return []*models.Row{row}, nil
+}
+
 func (e *StatementExecutor) executeShowSeriesCardinalityStatement(stmt *influxql.ShowSeriesCardinalityStatement) (models.Rows, error) {
 	n, err := e.TSDBStore.SeriesCardinality(stmt.Database)
 	if err != nil {
@@ -921,10 +1058,10 @@ func (e *StatementExecutor) executeShowSubscriptionsStatement(stmt *influxql.Sho
 
 	rows := []*models.Row{}
 	for _, di := range dis {
-		row := &models.Row{Columns: []string{"retention_policy", "name", "mode", "destinations"}, Name: di.Name}
+		row := &models.Row{Columns: []string{"retention_policy", "name", "mode", "destinations", "filter"}, Name: di.Name}
 		for _, rpi := range di.RetentionPolicies {
 			for _, si := range rpi.Subscriptions {
-				row.Values = append(row.Values, []interface{}{rpi.Name, si.Name, si.Mode, si.Destinations})
+				row.Values = append(row.Values, []interface{}{rpi.Name, si.Name, si.Mode, si.Destinations, si.Filter})
 			}
 		}
 		if len(row.Values) > 0 {
@@ -949,11 +1086,15 @@ func (e *StatementExecutor) executeShowTagKeys(q *influxql.ShowTagKeysStatement,
 	// Determine appropriate time range. If one or fewer time boundaries provided
 	// then min/max possible time should be used instead.
 	valuer := &influxql.NowValuer{Now: time.Now()}
-	cond, timeRange, err := influxql.ConditionExpr(q.Condition, valuer)
+	_, timeRange, err := influxql.ConditionExpr(q.Condition, valuer)
 	if err != nil {
 		return err
 	}
 
+	// The store bounds the results by the time range of the condition within
+	// the shards, so it's kept with now() resolved.
+	cond := influxql.Reduce(q.Condition, valuer)
+
 	// Get all shards for all retention policies.
 	var allGroups []meta.ShardGroupInfo
 	for _, rpi := range di.RetentionPolicies {
@@ -1040,11 +1181,15 @@ func (e *StatementExecutor) executeShowTagValues(q *influxql.ShowTagValuesStatem
 	// Determine appropriate time range. If one or fewer time boundaries provided
 	// then min/max possible time should be used instead.
 	valuer := &influxql.NowValuer{Now: time.Now()}
-	cond, timeRange, err := influxql.ConditionExpr(q.Condition, valuer)
+	_, timeRange, err := influxql.ConditionExpr(q.Condition, valuer)
 	if err != nil {
 		return err
 	}
 
+	// The store bounds the results by the time range of the condition within
+	// the shards, so it's kept with now() resolved.
+	cond := influxql.Reduce(q.Condition, valuer)
+
 	// Get all shards for all retention policies.
 	var allGroups []meta.ShardGroupInfo
 	for _, rpi := range di.RetentionPolicies {
@@ -1287,6 +1432,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			return
 		}
 		switch node := node.(type) {
+		case *query.ShowRejectedPointsStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.ShowRetentionPoliciesStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
@@ -1384,6 +1533,8 @@ type TSDBStore interface {
 
 	SeriesCardinality(database string) (int64, error)
 	MeasurementsCardinality(database string) (int64, error)
+
+	RejectedPoints(database string) ([]*tsdb.RejectedPointsEntry, error)
 }
 
 var _ TSDBStore = LocalTSDBStore{}
@@ -5,9 +5,13 @@ import (
 	"context"
 	"errors"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -78,6 +82,7 @@ func TestQueryExecutor_ExecuteQuery_SelectStatement(t *testing.T) {
 					{time.Unix(1, 0).UTC(), float64(200)},
 				},
 			}},
+			ColumnTypes: []influxql.DataType{influxql.Time, influxql.Float},
 		},
 	}) {
 		t.Fatalf("unexpected results: %s", spew.Sdump(a))
@@ -129,6 +134,192 @@ func TestQueryExecutor_ExecuteQuery_MaxSelectBucketsN(t *testing.T) {
 	}
 }
 
+// Ensure query executor kills queries that exceed the limits on the cost of
+// reading blocks.
+func TestQueryExecutor_ExecuteQuery_CostLimits(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		limit func(e *coordinator.StatementExecutor)
+		err   string
+	}{
+		{
+			name:  "Blocks",
+			limit: func(e *coordinator.StatementExecutor) { e.MaxSelectBlocksN = 10 },
+			err:   "max-select-blocks limit exceeded",
+		},
+		{
+			name:  "Bytes",
+			limit: func(e *coordinator.StatementExecutor) { e.MaxSelectBytesN = 10 * 1024 },
+			err:   "max-select-bytes limit exceeded",
+		},
+		{
+			name:  "CursorTime",
+			limit: func(e *coordinator.StatementExecutor) { e.MaxSelectCursorTime = 10 * time.Millisecond },
+			err:   "max-select-cursor-time limit exceeded",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := DefaultQueryExecutor()
+			tt.limit(e.StatementExecutor)
+
+			e.MetaClient.ShardGroupsByTimeRangeFn = func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error) {
+				return []meta.ShardGroupInfo{
+					{ID: 1, Shards: []meta.ShardInfo{
+						{ID: 100, Owners: []meta.ShardOwner{{NodeID: 0}}},
+					}},
+				}, nil
+			}
+
+			var timed bool
+			e.TSDBStore.ShardGroupFn = func(ids []uint64) tsdb.ShardGroup {
+				var sh MockShard
+				sh.CreateIteratorFn = func(ctx context.Context, _ *influxql.Measurement, _ query.IteratorOptions) (query.Iterator, error) {
+					timed = query.CursorTimeFromContext(ctx)
+					return &CostIterator{}, nil
+				}
+				sh.FieldDimensionsFn = func(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
+					return map[string]influxql.DataType{"value": influxql.Float}, nil, nil
+				}
+				return &sh
+			}
+
+			a := ReadAllResults(e.ExecuteQuery(`SELECT value FROM cpu`, "db0", 1))
+			if len(a) == 0 {
+				t.Fatal("expected results")
+			} else if err := a[len(a)-1].Err; err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Block reads are only timed when the cursor time is limited.
+			if exp := e.StatementExecutor.MaxSelectCursorTime > 0; timed != exp {
+				t.Fatalf("unexpected cursor timing: got %v, exp %v", timed, exp)
+			}
+		})
+	}
+}
+
+// Ensure query executor records statements in the slow query log.
+func TestQueryExecutor_ExecuteQuery_SlowQueryLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "coordinator-slow-query-log-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	e := DefaultQueryExecutor()
+	e.StatementExecutor.SlowQueryLog = query.NewSlowQueryLog(filepath.Join(dir, "slow.log"))
+	if err := e.StatementExecutor.SlowQueryLog.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	e.MetaClient.ShardGroupsByTimeRangeFn = func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error) {
+		return []meta.ShardGroupInfo{
+			{ID: 1, Shards: []meta.ShardInfo{
+				{ID: 100, Owners: []meta.ShardOwner{{NodeID: 0}}},
+			}},
+		}, nil
+	}
+
+	e.TSDBStore.ShardGroupFn = func(ids []uint64) tsdb.ShardGroup {
+		var sh MockShard
+		sh.CreateIteratorFn = func(_ context.Context, _ *influxql.Measurement, _ query.IteratorOptions) (query.Iterator, error) {
+			return &FloatIterator{
+				Points: []query.FloatPoint{{Name: "cpu", Time: int64(0 * time.Second), Aux: []interface{}{float64(100)}}},
+				stats:  query.IteratorStats{SeriesN: 1, PointN: 1},
+			}, nil
+		}
+		sh.FieldDimensionsFn = func(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
+			return map[string]influxql.DataType{"value": influxql.Float}, nil, nil
+		}
+		return &sh
+	}
+
+	if a := ReadAllResults(e.ExecuteQuery(`SELECT value FROM cpu`, "db0", 0)); len(a) != 1 || a[0].Err != nil {
+		t.Fatalf("unexpected results: %s", spew.Sdump(a))
+	}
+
+	if err := e.StatementExecutor.SlowQueryLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(filepath.Join(dir, "slow.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	entries, err := query.ReadSlowQueryEntries(f)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 {
+		t.Fatalf("unexpected entry count: %d", len(entries))
+	}
+
+	entry := entries[0]
+	if got, exp := entry.Query, `SELECT value FROM db0.rp0.cpu`; got != exp {
+		t.Fatalf("unexpected query: got %q, exp %q", got, exp)
+	} else if got, exp := entry.Database, "db0"; got != exp {
+		t.Fatalf("unexpected database: got %q, exp %q", got, exp)
+	} else if entry.SeriesN != 1 || entry.PointN != 1 {
+		t.Fatalf("unexpected stats: series=%d points=%d", entry.SeriesN, entry.PointN)
+	} else if len(entry.Plan) == 0 {
+		t.Fatal("expected plan")
+	}
+}
+
+// Ensure a subscription filter cannot reference a field.
+func TestQueryExecutor_ExecuteQuery_CreateSubscriptionFilter(t *testing.T) {
+	e := DefaultQueryExecutor()
+
+	e.MetaClient.ShardGroupsByTimeRangeFn = func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error) {
+		return []meta.ShardGroupInfo{
+			{ID: 1, Shards: []meta.ShardInfo{
+				{ID: 100, Owners: []meta.ShardOwner{{NodeID: 0}}},
+			}},
+		}, nil
+	}
+	e.TSDBStore.ShardGroupFn = func(ids []uint64) tsdb.ShardGroup {
+		sh := MockShard{Measurements: []string{"cpu"}}
+		sh.FieldDimensionsFn = func(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
+			if !reflect.DeepEqual(measurements, []string{"cpu"}) {
+				t.Fatalf("unexpected measurements: %v", measurements)
+			}
+			return map[string]influxql.DataType{"value": influxql.Float}, map[string]struct{}{"host": {}}, nil
+		}
+		return &sh
+	}
+
+	var filters []string
+	e.MetaClient.CreateSubscriptionWithFilterFn = func(database, rp, name, mode string, destinations []string, filter string) error {
+		filters = append(filters, filter)
+		return nil
+	}
+
+	for _, tt := range []struct {
+		cond string
+		err  string
+	}{
+		{cond: `"measurement" = 'cpu' AND host = 'server01'`},
+		{cond: `value::tag = 'x'`},
+		{cond: `value > 1`, err: `subscription filter cannot reference field "value", only the measurement and tags`},
+	} {
+		q := `CREATE SUBSCRIPTION s0 ON db0.rp0 DESTINATIONS ALL 'udp://h0:9093' WHERE ` + tt.cond
+		a := ReadAllResults(e.ExecuteQuery(q, "db0", 0))
+		if len(a) != 1 {
+			t.Fatalf("%s: unexpected results: %s", tt.cond, spew.Sdump(a))
+		} else if tt.err == "" && a[0].Err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.cond, a[0].Err)
+		} else if tt.err != "" && (a[0].Err == nil || a[0].Err.Error() != tt.err) {
+			t.Fatalf("%s: unexpected error: %v", tt.cond, a[0].Err)
+		}
+	}
+	if exp := []string{`"measurement" = 'cpu' AND host = 'server01'`, `value::tag = 'x'`}; !reflect.DeepEqual(filters, exp) {
+		t.Fatalf("unexpected filters: %v", filters)
+	}
+}
+
 func TestStatementExecutor_NormalizeDropSeries(t *testing.T) {
 	q, err := influxql.ParseQuery("DROP SERIES FROM cpu")
 	if err != nil {
@@ -258,6 +449,43 @@ func TestQueryExecutor_ExecuteQuery_ShowDatabases(t *testing.T) {
 	}
 }
 
+// Ensure SHOW REJECTED POINTS lists the rejected points of the default database.
+func TestQueryExecutor_ExecuteQuery_ShowRejectedPoints(t *testing.T) {
+	e := DefaultQueryExecutor()
+	e.TSDBStore.RejectedPointsFn = func(database string) ([]*tsdb.RejectedPointsEntry, error) {
+		if database != "db0" {
+			t.Fatalf("unexpected database: %s", database)
+		}
+		return []*tsdb.RejectedPointsEntry{
+			{Time: time.Unix(10, 0), RetentionPolicy: "rp1", Reason: tsdb.RejectFieldTypeConflict, Message: "conflict", Point: []byte("cpu value=1 10")},
+			{Time: time.Unix(20, 0), Reason: tsdb.RejectParseError, Message: "unable to parse 'cpu value='", Point: []byte("cpu value=")},
+		}, nil
+	}
+
+	results := ReadAllResults(e.ExecuteQuery(`SHOW REJECTED POINTS`, "db0", 0))
+	exp := []*query.Result{
+		{
+			StatementID: 0,
+			Series: []*models.Row{{
+				Name:    "rejected_points",
+				Columns: []string{"time", "retention_policy", "reason", "message", "point"},
+				Values: [][]interface{}{
+					{"1970-01-01T00:00:10Z", "rp1", tsdb.RejectFieldTypeConflict, "conflict", "cpu value=1 10"},
+					{"1970-01-01T00:00:20Z", "", tsdb.RejectParseError, "unable to parse 'cpu value='", "cpu value="},
+				},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(results, exp) {
+		t.Fatalf("unexpected results: exp %s, got %s", spew.Sdump(exp), spew.Sdump(results))
+	}
+
+	results = ReadAllResults(e.ExecuteQuery(`SHOW REJECTED POINTS`, "", 0))
+	if len(results) != 1 || results[0].Err != coordinator.ErrDatabaseNameRequired {
+		t.Fatalf("unexpected results: %s", spew.Sdump(results))
+	}
+}
+
 // QueryExecutor is a test wrapper for coordinator.QueryExecutor.
 type QueryExecutor struct {
 	*query.QueryExecutor
@@ -407,3 +635,30 @@ func (itr *FloatIterator) Next() (*query.FloatPoint, error) {
 	itr.Points = itr.Points[1:]
 	return v, nil
 }
+
+// CostIterator is an iterator that returns points indefinitely, reading one
+// block of 1KB in a millisecond for each point.
+type CostIterator struct {
+	mu    sync.Mutex
+	stats query.IteratorStats
+}
+
+func (itr *CostIterator) Stats() query.IteratorStats {
+	itr.mu.Lock()
+	defer itr.mu.Unlock()
+	return itr.stats
+}
+
+func (itr *CostIterator) Close() error { return nil }
+
+// Next returns the next point after recording the cost of reading it.
+func (itr *CostIterator) Next() (*query.FloatPoint, error) {
+	time.Sleep(time.Millisecond)
+
+	itr.mu.Lock()
+	defer itr.mu.Unlock()
+	itr.stats.BlocksN++
+	itr.stats.BlockBytes += 1024
+	itr.stats.CursorTime += time.Millisecond
+	return &query.FloatPoint{Name: "cpu", Time: int64(itr.stats.BlocksN), Aux: []interface{}{float64(itr.stats.BlocksN)}}, nil
+}
@@ -178,9 +178,11 @@ func (i *Importer) processDML(scanner *bufio.Reader) error {
 			return nil
 		}
 		if strings.HasPrefix(line, "# CONTEXT-DATABASE:") {
+			i.flushBatch()
 			i.database = strings.TrimSpace(strings.Split(line, ":")[1])
 		}
 		if strings.HasPrefix(line, "# CONTEXT-RETENTION-POLICY:") {
+			i.flushBatch()
 			i.retentionPolicy = strings.TrimSpace(strings.Split(line, ":")[1])
 		}
 		if strings.HasPrefix(line, "#") {
@@ -225,6 +227,16 @@ func (i *Importer) batchAccumulator(line string, start time.Time) {
 	}
 }
 
+// flushBatch writes the lines accumulated so far, so that they are written to
+// the current database and retention policy before the context changes.
+func (i *Importer) flushBatch() {
+	if len(i.batch) == 0 {
+		return
+	}
+	i.batchWrite()
+	i.batch = i.batch[:0]
+}
+
 func (i *Importer) batchWrite() {
 	// Accumulate the batch size to see how many points we have written this second
 	i.throttlePointsWritten += len(i.batch)
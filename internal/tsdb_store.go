@@ -33,6 +33,7 @@ type TSDBStoreMock struct {
 	MeasurementNamesFn        func(auth query.Authorizer, database string, cond influxql.Expr) ([][]byte, error)
 	OpenFn                    func() error
 	PathFn                    func() string
+	RejectedPointsFn          func(database string) ([]*tsdb.RejectedPointsEntry, error)
 	RestoreShardFn            func(id uint64, r io.Reader) error
 	SeriesCardinalityFn       func(database string) (int64, error)
 	SetShardEnabledFn         func(shardID uint64, enabled bool) error
@@ -107,6 +108,9 @@ func (s *TSDBStoreMock) Open() error {
 func (s *TSDBStoreMock) Path() string {
 	return s.PathFn()
 }
+func (s *TSDBStoreMock) RejectedPoints(database string) ([]*tsdb.RejectedPointsEntry, error) {
+	return s.RejectedPointsFn(database)
+}
 func (s *TSDBStoreMock) RestoreShard(id uint64, r io.Reader) error {
 	return s.RestoreShardFn(id, r)
 }
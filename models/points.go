@@ -301,16 +301,39 @@ func ParseName(buf []byte) ([]byte, error) {
 // NOTE: to minimize heap allocations, the returned Points will refer to subslices of buf.
 // This can have the unintended effect preventing buf from being garbage collected.
 func ParsePointsWithPrecision(buf []byte, defaultTime time.Time, precision string) ([]Point, error) {
+	points, _, err := parsePoints(buf, defaultTime, precision, false)
+	return points, err
+}
+
+// ParsePointsWithLines is similar to ParsePointsWithPrecision, but also returns
+// the 1-based line number within buf that each returned point was parsed from.
+//
+// If any lines fail to parse, the returned error is a *ParseError.
+func ParsePointsWithLines(buf []byte, defaultTime time.Time, precision string) ([]Point, []int, error) {
+	return parsePoints(buf, defaultTime, precision, true)
+}
+
+func parsePoints(buf []byte, defaultTime time.Time, precision string, withLines bool) ([]Point, []int, error) {
 	points := make([]Point, 0, bytes.Count(buf, []byte{'\n'})+1)
 	var (
 		pos    int
 		block  []byte
-		failed []string
+		line   = 1
+		lines  []int
+		failed []LineError
 	)
+	if withLines {
+		lines = make([]int, 0, cap(points))
+	}
 	for pos < len(buf) {
 		pos, block = scanLine(buf, pos)
 		pos++
 
+		// Record the line this block starts on and advance past any newlines
+		// embedded within quoted string fields.
+		lineno := line
+		line += 1 + bytes.Count(block, []byte{'\n'})
+
 		if len(block) == 0 {
 			continue
 		}
@@ -334,17 +357,49 @@ func ParsePointsWithPrecision(buf []byte, defaultTime time.Time, precision strin
 
 		pt, err := parsePoint(block[start:], defaultTime, precision)
 		if err != nil {
-			failed = append(failed, fmt.Sprintf("unable to parse '%s': %v", string(block[start:]), err))
+			failed = append(failed, LineError{Line: lineno, Text: string(block[start:]), Err: err})
 		} else {
 			points = append(points, pt)
+			if withLines {
+				lines = append(lines, lineno)
+			}
 		}
 
 	}
 	if len(failed) > 0 {
-		return points, fmt.Errorf("%s", strings.Join(failed, "\n"))
+		return points, lines, &ParseError{Lines: failed}
 	}
-	return points, nil
+	return points, lines, nil
+
+}
 
+// LineError describes a single line of line protocol that failed to parse.
+type LineError struct {
+	// Line is the 1-based line number of the failed line within the input.
+	Line int
+
+	// Text is the contents of the line, without leading whitespace.
+	Text string
+
+	// Err is the underlying parse error.
+	Err error
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("unable to parse '%s': %v", e.Text, e.Err)
+}
+
+// ParseError is returned when one or more lines of line protocol fail to parse.
+type ParseError struct {
+	Lines []LineError
+}
+
+func (e *ParseError) Error() string {
+	a := make([]string, len(e.Lines))
+	for i := range e.Lines {
+		a[i] = e.Lines[i].Error()
+	}
+	return strings.Join(a, "\n")
 }
 
 func parsePoint(buf []byte, defaultTime time.Time, precision string) (Point, error) {
@@ -1105,6 +1160,27 @@ func scanLine(buf []byte, i int) (int, []byte) {
 	return i, buf[start:i]
 }
 
+// ScanLines is a split function for a bufio.Scanner that returns each line of
+// line protocol. Unlike bufio.ScanLines, newlines within quoted string field
+// values do not end a line.
+func ScanLines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	// A newline in the last byte may be escaped by the data that follows, as
+	// scanLine only skips an escaped character if there is another after it.
+	i, line := scanLine(data, 0)
+	if i < len(data)-1 || (atEOF && i < len(data)) {
+		return i + 1, line, nil
+	} else if atEOF {
+		return len(data), data, nil
+	}
+
+	// Request more data.
+	return 0, nil, nil
+}
+
 // scanTo returns the end position in buf and the next consecutive block
 // of bytes, starting from i and ending with stop byte, where stop byte
 // has not been escaped.
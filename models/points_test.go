@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
@@ -2088,6 +2090,64 @@ func TestRoundedString(t *testing.T) {
 	}
 }
 
+func TestParsePointsWithLines(t *testing.T) {
+	batch := `# comment
+cpu value=1 1000000000
+
+cpu value= 2000000000
+mem,host=a value="multi
+line" 3000000000
+disk value=1,value2 4000000000
+cpu value=5 5000000000`
+
+	pts, lines, err := models.ParsePointsWithLines([]byte(batch), time.Now().UTC(), "")
+	if got, exp := len(pts), 3; got != exp {
+		t.Fatalf("unexpected number of points: got %d, exp %d", got, exp)
+	}
+	if exp := []int{2, 5, 8}; !reflect.DeepEqual(lines, exp) {
+		t.Fatalf("unexpected lines: got %v, exp %v", lines, exp)
+	}
+
+	perr, ok := err.(*models.ParseError)
+	if !ok {
+		t.Fatalf("expected *models.ParseError, got %T", err)
+	}
+	if got, exp := len(perr.Lines), 2; got != exp {
+		t.Fatalf("unexpected number of failed lines: got %d, exp %d", got, exp)
+	}
+	if got, exp := perr.Lines[0].Line, 4; got != exp {
+		t.Fatalf("unexpected line for first failure: got %d, exp %d", got, exp)
+	}
+	if got, exp := perr.Lines[1].Line, 7; got != exp {
+		t.Fatalf("unexpected line for second failure: got %d, exp %d", got, exp)
+	}
+	if got, exp := perr.Lines[0].Text, "cpu value= 2000000000"; got != exp {
+		t.Fatalf("unexpected text: got %q, exp %q", got, exp)
+	}
+	if !strings.HasPrefix(err.Error(), "unable to parse 'cpu value= 2000000000': ") {
+		t.Fatalf("unexpected error message: %s", err)
+	}
+}
+
+func TestScanLines(t *testing.T) {
+	batch := "cpu value=1 1\nmem,host=a value=\"multi\nline\" 2\ndisk value=3 3"
+
+	// Read a byte at a time so that lines span the scanner's reads.
+	scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(batch)))
+	scanner.Split(models.ScanLines)
+
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"cpu value=1 1", "mem,host=a value=\"multi\nline\" 2", "disk value=3 3"}; !reflect.DeepEqual(lines, exp) {
+		t.Fatalf("unexpected lines: got %q, exp %q", lines, exp)
+	}
+}
+
 func TestParsePointsStringWithExtraBuffer(t *testing.T) {
 	b := make([]byte, 70*5000)
 	buf := bytes.NewBuffer(b)
@@ -0,0 +1,362 @@
+// Package arrow implements a writer for the Apache Arrow IPC streaming format.
+//
+// Only the column types needed to represent InfluxDB query results are
+// supported. The format is described at
+// https://arrow.apache.org/docs/format/Columnar.html.
+package arrow // import "github.com/influxdata/influxdb/pkg/arrow"
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ContentType is the media type of an Arrow IPC stream.
+const ContentType = "application/vnd.apache.arrow.stream"
+
+// Type is the data type of a column.
+type Type int
+
+const (
+	// Float64 is a column of 64-bit floating point numbers.
+	Float64 Type = iota
+	// Int64 is a column of signed 64-bit integers.
+	Int64
+	// Uint64 is a column of unsigned 64-bit integers.
+	Uint64
+	// String is a column of UTF-8 strings.
+	String
+	// Boolean is a column of booleans.
+	Boolean
+	// Timestamp is a column of UTC timestamps with nanosecond precision.
+	Timestamp
+)
+
+// Flatbuffer enum values from the Arrow format definition.
+const (
+	metadataVersionV5 = 4
+
+	messageHeaderSchema      = 1
+	messageHeaderRecordBatch = 3
+
+	typeInt           = 2
+	typeFloatingPoint = 3
+	typeUtf8          = 5
+	typeBool          = 6
+	typeTimestamp     = 10
+
+	precisionDouble = 2
+	timeUnitNano    = 3
+)
+
+var (
+	// ErrSchemaMismatch is returned when the columns of a record batch do not
+	// match the schema of the stream.
+	ErrSchemaMismatch = errors.New("arrow: columns do not match schema")
+
+	// ErrWriterClosed is returned when writing to a closed writer.
+	ErrWriterClosed = errors.New("arrow: writer closed")
+)
+
+// Field describes a single column of a schema.
+type Field struct {
+	Name string
+	Type Type
+}
+
+// Schema describes the columns of every record batch in a stream.
+type Schema []Field
+
+// Equal returns true if both schemas have the same fields.
+func (s Schema) Equal(other Schema) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if s[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Column accumulates the values of a single column of a record batch.
+type Column struct {
+	typ      Type
+	n        int
+	nulls    int
+	validity []byte
+	values   []byte
+	offsets  []byte
+}
+
+// NewColumn returns a new, empty column of the given type.
+func NewColumn(typ Type) *Column {
+	c := &Column{typ: typ}
+	if typ == String {
+		c.offsets = make([]byte, 4)
+	}
+	return c
+}
+
+// Type returns the data type of the column.
+func (c *Column) Type() Type { return c.typ }
+
+// Len returns the number of values in the column.
+func (c *Column) Len() int { return c.n }
+
+// AppendNull appends a null value.
+func (c *Column) AppendNull() {
+	c.grow(false)
+	c.nulls++
+	switch c.typ {
+	case String:
+		c.appendOffset()
+	case Boolean:
+	default:
+		c.values = append(c.values, make([]byte, 8)...)
+	}
+}
+
+// AppendFloat64 appends a value to a Float64 column.
+func (c *Column) AppendFloat64(v float64) {
+	c.appendUint64(math.Float64bits(v))
+}
+
+// AppendInt64 appends a value to an Int64 column or, as nanoseconds since
+// the epoch, to a Timestamp column.
+func (c *Column) AppendInt64(v int64) {
+	c.appendUint64(uint64(v))
+}
+
+// AppendUint64 appends a value to a Uint64 column.
+func (c *Column) AppendUint64(v uint64) {
+	c.appendUint64(v)
+}
+
+// AppendString appends a value to a String column.
+func (c *Column) AppendString(v string) {
+	c.grow(true)
+	c.values = append(c.values, v...)
+	c.appendOffset()
+}
+
+// AppendBool appends a value to a Boolean column.
+func (c *Column) AppendBool(v bool) {
+	c.grow(true)
+	if v {
+		setBit(c.values, c.n-1)
+	}
+}
+
+func (c *Column) appendUint64(v uint64) {
+	c.grow(true)
+	c.values = append(c.values, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint64(c.values[len(c.values)-8:], v)
+}
+
+func (c *Column) appendOffset() {
+	c.offsets = append(c.offsets, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(c.offsets[len(c.offsets)-4:], uint32(len(c.values)))
+}
+
+// grow extends the validity bitmap, and the value bitmap of a Boolean column,
+// for one more value.
+func (c *Column) grow(valid bool) {
+	if c.n%8 == 0 {
+		c.validity = append(c.validity, 0)
+		if c.typ == Boolean {
+			c.values = append(c.values, 0)
+		}
+	}
+	if valid {
+		setBit(c.validity, c.n)
+	}
+	c.n++
+}
+
+// buffers returns the Arrow buffers of the column in format order. The
+// validity bitmap is omitted when the column has no nulls.
+func (c *Column) buffers() [][]byte {
+	var validity []byte
+	if c.nulls > 0 {
+		validity = c.validity
+	}
+	if c.typ == String {
+		return [][]byte{validity, c.offsets, c.values}
+	}
+	return [][]byte{validity, c.values}
+}
+
+func setBit(b []byte, i int) {
+	b[i/8] |= 1 << uint(i%8)
+}
+
+// Writer writes record batches to an Arrow IPC stream.
+type Writer struct {
+	w      io.Writer
+	schema Schema
+	header bool
+	closed bool
+}
+
+// NewWriter returns a new Writer that writes a stream with the given schema
+// to w. The schema is written along with the first record batch.
+func NewWriter(w io.Writer, schema Schema) *Writer {
+	return &Writer{w: w, schema: schema}
+}
+
+// Schema returns the schema of the stream.
+func (w *Writer) Schema() Schema { return w.schema }
+
+// WriteRecordBatch writes a record batch holding the given columns. The
+// columns must match the schema and have the same length.
+func (w *Writer) WriteRecordBatch(columns []*Column) error {
+	if w.closed {
+		return ErrWriterClosed
+	} else if len(columns) != len(w.schema) {
+		return ErrSchemaMismatch
+	}
+	for i, c := range columns {
+		if c.typ != w.schema[i].Type || c.n != columns[0].n {
+			return ErrSchemaMismatch
+		}
+	}
+
+	if !w.header {
+		if err := w.writeSchema(); err != nil {
+			return err
+		}
+		w.header = true
+	}
+
+	var nodes, buffers []byte
+	var body [][]byte
+	var bodyLen int64
+	var length int64
+	if len(columns) > 0 {
+		length = int64(columns[0].n)
+	}
+	for _, c := range columns {
+		nodes = appendInt64s(nodes, int64(c.n), int64(c.nulls))
+		for _, b := range c.buffers() {
+			buffers = appendInt64s(buffers, bodyLen, int64(len(b)))
+			body = append(body, b)
+			bodyLen += align8(len(b))
+		}
+	}
+
+	batch := &fbTable{}
+	batch.setInt64(0, length)
+	batch.setRef(1, fbStructVector{n: len(nodes) / 16, data: nodes})
+	batch.setRef(2, fbStructVector{n: len(buffers) / 16, data: buffers})
+
+	if err := w.writeMessage(messageHeaderRecordBatch, batch, bodyLen); err != nil {
+		return err
+	}
+	for _, b := range body {
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+		if _, err := w.w.Write(make([]byte, align8(len(b))-int64(len(b)))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close writes the end-of-stream marker. The schema is written first if no
+// record batches have been written, so the stream is always readable.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if !w.header {
+		if err := w.writeSchema(); err != nil {
+			return err
+		}
+		w.header = true
+	}
+	_, err := w.w.Write([]byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+	return err
+}
+
+func (w *Writer) writeSchema() error {
+	fields := make(fbTableVector, len(w.schema))
+	for i, f := range w.schema {
+		typeID, typ := fieldType(f.Type)
+
+		field := &fbTable{}
+		field.setRef(0, fbString(f.Name))
+		field.setBool(1, true)
+		field.setUint8(2, typeID)
+		field.setRef(3, typ)
+		field.setRef(5, fbTableVector{})
+		fields[i] = field
+	}
+
+	schema := &fbTable{}
+	schema.setInt16(0, 0) // little endian
+	schema.setRef(1, fields)
+	return w.writeMessage(messageHeaderSchema, schema, 0)
+}
+
+// writeMessage writes the encapsulated message metadata. The body, if any,
+// must be written by the caller.
+func (w *Writer) writeMessage(headerType uint8, header *fbTable, bodyLen int64) error {
+	msg := &fbTable{}
+	msg.setInt16(0, metadataVersionV5)
+	msg.setUint8(1, headerType)
+	msg.setRef(2, header)
+	msg.setInt64(3, bodyLen)
+	meta := encodeFlatbuffer(msg)
+
+	prefix := make([]byte, 8)
+	binary.LittleEndian.PutUint32(prefix, 0xffffffff)
+	binary.LittleEndian.PutUint32(prefix[4:], uint32(len(meta)))
+	if _, err := w.w.Write(prefix); err != nil {
+		return err
+	}
+	_, err := w.w.Write(meta)
+	return err
+}
+
+// fieldType returns the flatbuffer union type and table for a column type.
+func fieldType(typ Type) (uint8, *fbTable) {
+	t := &fbTable{}
+	switch typ {
+	case Float64:
+		t.setInt16(0, precisionDouble)
+		return typeFloatingPoint, t
+	case Int64, Uint64:
+		t.setInt32(0, 64)
+		t.setBool(1, typ == Int64)
+		return typeInt, t
+	case String:
+		return typeUtf8, t
+	case Boolean:
+		return typeBool, t
+	case Timestamp:
+		t.setInt16(0, timeUnitNano)
+		t.setRef(1, fbString("UTC"))
+		return typeTimestamp, t
+	default:
+		panic("arrow: unsupported type")
+	}
+}
+
+// align8 returns n rounded up to a multiple of 8.
+func align8(n int) int64 {
+	return int64((n + 7) &^ 7)
+}
+
+func appendInt64s(b []byte, values ...int64) []byte {
+	for _, v := range values {
+		b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(b[len(b)-8:], uint64(v))
+	}
+	return b
+}
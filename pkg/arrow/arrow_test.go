@@ -0,0 +1,212 @@
+package arrow_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/influxdata/influxdb/pkg/arrow"
+)
+
+func TestWriter_WriteRecordBatch(t *testing.T) {
+	var buf bytes.Buffer
+	w := arrow.NewWriter(&buf, arrow.Schema{
+		{Name: "time", Type: arrow.Timestamp},
+		{Name: "host", Type: arrow.String},
+		{Name: "value", Type: arrow.Float64},
+		{Name: "ok", Type: arrow.Boolean},
+	})
+
+	ts, host, value, ok := arrow.NewColumn(arrow.Timestamp), arrow.NewColumn(arrow.String), arrow.NewColumn(arrow.Float64), arrow.NewColumn(arrow.Boolean)
+	ts.AppendInt64(10)
+	ts.AppendInt64(20)
+	ts.AppendInt64(30)
+	host.AppendString("serverA")
+	host.AppendNull()
+	host.AppendString("b")
+	value.AppendFloat64(1.5)
+	value.AppendFloat64(2.5)
+	value.AppendNull()
+	ok.AppendBool(true)
+	ok.AppendBool(false)
+	ok.AppendBool(true)
+
+	if err := w.WriteRecordBatch([]*arrow.Column{ts, host, value, ok}); err != nil {
+		t.Fatal(err)
+	} else if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	b := buf.Bytes()
+
+	// Read the schema message.
+	msg, body, b := readMessage(t, b)
+	if got, exp := msg.int16(0), int16(4); got != exp {
+		t.Fatalf("unexpected version: got %d, exp %d", got, exp)
+	} else if got, exp := msg.uint8(1), uint8(1); got != exp {
+		t.Fatalf("unexpected header type: got %d, exp %d", got, exp)
+	} else if len(body) != 0 {
+		t.Fatalf("unexpected schema body length: %d", len(body))
+	}
+
+	fields := msg.table(2).vector(1)
+	if len(fields) != 4 {
+		t.Fatalf("unexpected field count: %d", len(fields))
+	}
+	for i, exp := range []struct {
+		name string
+		typ  uint8
+	}{{"time", 10}, {"host", 5}, {"value", 3}, {"ok", 6}} {
+		f := msg.at(fields[i])
+		if got := f.string(0); got != exp.name {
+			t.Fatalf("unexpected field name: got %q, exp %q", got, exp.name)
+		} else if got := f.uint8(2); got != exp.typ {
+			t.Fatalf("unexpected field type: got %d, exp %d", got, exp.typ)
+		}
+	}
+
+	// Read the record batch message.
+	msg, body, b = readMessage(t, b)
+	if got, exp := msg.uint8(1), uint8(3); got != exp {
+		t.Fatalf("unexpected header type: got %d, exp %d", got, exp)
+	} else if got, exp := msg.int64(3), int64(len(body)); got != exp {
+		t.Fatalf("unexpected body length: got %d, exp %d", got, exp)
+	}
+
+	batch := msg.table(2)
+	if got, exp := batch.int64(0), int64(3); got != exp {
+		t.Fatalf("unexpected length: got %d, exp %d", got, exp)
+	}
+
+	nodes := batch.structs(1, 16)
+	if len(nodes) != 4 {
+		t.Fatalf("unexpected node count: %d", len(nodes))
+	} else if got, exp := le64(nodes[1][8:]), uint64(1); got != exp {
+		t.Fatalf("unexpected null count: got %d, exp %d", got, exp)
+	}
+
+	buffers := batch.structs(2, 16)
+	if len(buffers) != 9 {
+		t.Fatalf("unexpected buffer count: %d", len(buffers))
+	}
+	buffer := func(i int) []byte {
+		off, n := le64(buffers[i]), le64(buffers[i][8:])
+		if off%8 != 0 {
+			t.Fatalf("unaligned buffer %d at %d", i, off)
+		}
+		return body[off : off+n]
+	}
+
+	if got := buffer(0); len(got) != 0 {
+		t.Fatalf("unexpected validity bitmap: %v", got)
+	} else if got := le64(buffer(1)[16:]); got != 30 {
+		t.Fatalf("unexpected timestamp: %d", got)
+	} else if got, exp := buffer(2), []byte{0x5}; !bytes.Equal(got, exp) {
+		t.Fatalf("unexpected validity bitmap: got %v, exp %v", got, exp)
+	} else if got, exp := buffer(3), []byte{0, 0, 0, 0, 7, 0, 0, 0, 7, 0, 0, 0, 8, 0, 0, 0}; !bytes.Equal(got, exp) {
+		t.Fatalf("unexpected offsets: got %v, exp %v", got, exp)
+	} else if got, exp := string(buffer(4)), "serverAb"; got != exp {
+		t.Fatalf("unexpected string data: got %q, exp %q", got, exp)
+	} else if got, exp := buffer(5), []byte{0x3}; !bytes.Equal(got, exp) {
+		t.Fatalf("unexpected validity bitmap: got %v, exp %v", got, exp)
+	} else if got := math.Float64frombits(le64(buffer(6)[8:])); got != 2.5 {
+		t.Fatalf("unexpected float: %v", got)
+	} else if got, exp := buffer(8), []byte{0x5}; !bytes.Equal(got, exp) {
+		t.Fatalf("unexpected boolean bitmap: got %v, exp %v", got, exp)
+	}
+
+	// The stream should end with the end-of-stream marker.
+	if exp := []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0}; !bytes.Equal(b, exp) {
+		t.Fatalf("unexpected end of stream: %v", b)
+	}
+}
+
+func TestWriter_WriteRecordBatch_SchemaMismatch(t *testing.T) {
+	w := arrow.NewWriter(&bytes.Buffer{}, arrow.Schema{{Name: "value", Type: arrow.Float64}})
+	if err := w.WriteRecordBatch([]*arrow.Column{arrow.NewColumn(arrow.Int64)}); err != arrow.ErrSchemaMismatch {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// readMessage reads an encapsulated message and returns its metadata, its
+// body and the remaining bytes.
+func readMessage(t *testing.T, b []byte) (fbTable, []byte, []byte) {
+	t.Helper()
+	if len(b) < 8 || binary.LittleEndian.Uint32(b) != 0xffffffff {
+		t.Fatalf("missing continuation marker")
+	}
+	n := int(binary.LittleEndian.Uint32(b[4:]))
+	if n%8 != 0 {
+		t.Fatalf("unaligned metadata length: %d", n)
+	}
+	meta := b[8 : 8+n]
+	msg := fbTable{buf: meta, pos: int(binary.LittleEndian.Uint32(meta))}
+	bodyLen := int(msg.int64(3))
+	return msg, b[8+n : 8+n+bodyLen], b[8+n+bodyLen:]
+}
+
+// fbTable is a minimal flatbuffer table reader.
+type fbTable struct {
+	buf []byte
+	pos int
+}
+
+func (t fbTable) at(pos int) fbTable { return fbTable{buf: t.buf, pos: pos} }
+
+// field returns the position of a field, or 0 if it is not set.
+func (t fbTable) field(id int) int {
+	vtable := t.pos - int(int32(binary.LittleEndian.Uint32(t.buf[t.pos:])))
+	if 4+2*id >= int(binary.LittleEndian.Uint16(t.buf[vtable:])) {
+		return 0
+	}
+	off := int(binary.LittleEndian.Uint16(t.buf[vtable+4+2*id:]))
+	if off == 0 {
+		return 0
+	}
+	return t.pos + off
+}
+
+func (t fbTable) uint8(id int) uint8 { return t.buf[t.field(id)] }
+func (t fbTable) int16(id int) int16 {
+	return int16(binary.LittleEndian.Uint16(t.buf[t.field(id):]))
+}
+func (t fbTable) int64(id int) int64 { return int64(le64(t.buf[t.field(id):])) }
+
+func (t fbTable) deref(id int) int {
+	pos := t.field(id)
+	return pos + int(binary.LittleEndian.Uint32(t.buf[pos:]))
+}
+
+func (t fbTable) table(id int) fbTable { return t.at(t.deref(id)) }
+
+func (t fbTable) string(id int) string {
+	pos := t.deref(id)
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	return string(t.buf[pos+4 : pos+4+n])
+}
+
+// vector returns the positions of the tables in a vector of tables.
+func (t fbTable) vector(id int) []int {
+	pos := t.deref(id)
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	a := make([]int, n)
+	for i := range a {
+		slot := pos + 4 + 4*i
+		a[i] = slot + int(binary.LittleEndian.Uint32(t.buf[slot:]))
+	}
+	return a
+}
+
+// structs returns the elements of a vector of structs.
+func (t fbTable) structs(id, size int) [][]byte {
+	pos := t.deref(id)
+	n := int(binary.LittleEndian.Uint32(t.buf[pos:]))
+	a := make([][]byte, n)
+	for i := range a {
+		a[i] = t.buf[pos+4+size*i : pos+4+size*(i+1)]
+	}
+	return a
+}
+
+func le64(b []byte) uint64 { return binary.LittleEndian.Uint64(b) }
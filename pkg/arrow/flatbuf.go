@@ -0,0 +1,191 @@
+package arrow
+
+import "encoding/binary"
+
+// The Arrow IPC metadata is encoded as flatbuffers. Only the small subset of
+// the format needed to describe schemas and record batches is implemented
+// here: tables with scalar and offset fields, strings, vectors of tables and
+// vectors of structs.
+
+// fbTable is a flatbuffer table under construction. Fields are indexed by
+// their id in the flatbuffer schema.
+type fbTable struct {
+	fields []fbField
+}
+
+// fbField is a single field of a table. A field either holds an inline scalar
+// or an offset to another object.
+type fbField struct {
+	scalar []byte
+	ref    interface{}
+}
+
+// fbString is a flatbuffer string.
+type fbString string
+
+// fbTableVector is a flatbuffer vector of tables.
+type fbTableVector []*fbTable
+
+// fbStructVector is a flatbuffer vector of structs. Data holds the encoded
+// structs, which must all be 8-byte aligned.
+type fbStructVector struct {
+	n    int
+	data []byte
+}
+
+func (t *fbTable) set(id int, f fbField) {
+	for len(t.fields) <= id {
+		t.fields = append(t.fields, fbField{})
+	}
+	t.fields[id] = f
+}
+
+func (t *fbTable) setBool(id int, v bool) {
+	if v {
+		t.setUint8(id, 1)
+	} else {
+		t.setUint8(id, 0)
+	}
+}
+
+func (t *fbTable) setUint8(id int, v uint8) { t.set(id, fbField{scalar: []byte{v}}) }
+
+func (t *fbTable) setInt16(id int, v int16) {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, uint16(v))
+	t.set(id, fbField{scalar: b})
+}
+
+func (t *fbTable) setInt32(id int, v int32) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	t.set(id, fbField{scalar: b})
+}
+
+func (t *fbTable) setInt64(id int, v int64) {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	t.set(id, fbField{scalar: b})
+}
+
+func (t *fbTable) setRef(id int, v interface{}) { t.set(id, fbField{ref: v}) }
+
+// fbEncoder serializes flatbuffer objects front to back. Objects are always
+// written after the offsets that refer to them, as offsets are unsigned.
+type fbEncoder struct {
+	buf []byte
+}
+
+// encodeFlatbuffer returns the encoded buffer with root as its root table. The
+// returned buffer is padded to a multiple of 8 bytes.
+func encodeFlatbuffer(root *fbTable) []byte {
+	e := &fbEncoder{buf: make([]byte, 4)}
+	pos := e.write(root)
+	binary.LittleEndian.PutUint32(e.buf, uint32(pos))
+	e.align(8, 0)
+	return e.buf
+}
+
+// align pads the buffer until its length modulo n equals rem.
+func (e *fbEncoder) align(n, rem int) {
+	for len(e.buf)%n != rem {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *fbEncoder) appendUint16(v uint16) {
+	e.buf = append(e.buf, byte(v), byte(v>>8))
+}
+
+func (e *fbEncoder) appendUint32(v uint32) {
+	e.buf = append(e.buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+// patch sets the offset at pos to point to target.
+func (e *fbEncoder) patch(pos, target int) {
+	binary.LittleEndian.PutUint32(e.buf[pos:], uint32(target-pos))
+}
+
+// write encodes obj and returns its position in the buffer.
+func (e *fbEncoder) write(obj interface{}) int {
+	switch obj := obj.(type) {
+	case *fbTable:
+		return e.writeTable(obj)
+	case fbString:
+		e.align(4, 0)
+		pos := len(e.buf)
+		e.appendUint32(uint32(len(obj)))
+		e.buf = append(e.buf, obj...)
+		e.buf = append(e.buf, 0)
+		return pos
+	case fbTableVector:
+		e.align(4, 0)
+		pos := len(e.buf)
+		e.appendUint32(uint32(len(obj)))
+		slots := len(e.buf)
+		e.buf = append(e.buf, make([]byte, 4*len(obj))...)
+		for i, t := range obj {
+			e.patch(slots+4*i, e.writeTable(t))
+		}
+		return pos
+	case fbStructVector:
+		// Align the elements, which follow the length, to 8 bytes.
+		e.align(8, 4)
+		pos := len(e.buf)
+		e.appendUint32(uint32(obj.n))
+		e.buf = append(e.buf, obj.data...)
+		return pos
+	default:
+		panic("arrow: unsupported flatbuffer object")
+	}
+}
+
+// writeTable encodes the vtable of t followed by the table itself. The table
+// is placed so that its 8-byte fields are aligned.
+func (e *fbEncoder) writeTable(t *fbTable) int {
+	// Lay out the inline fields by decreasing size so that every field is
+	// aligned to its size, starting after the 4-byte vtable offset.
+	offsets := make([]int, len(t.fields))
+	size := 4
+	for _, sz := range []int{8, 4, 2, 1} {
+		for i, f := range t.fields {
+			if fieldSize(f) == sz {
+				offsets[i] = size
+				size += sz
+			}
+		}
+	}
+
+	e.align(2, 0)
+	vtable := len(e.buf)
+	e.appendUint16(uint16(4 + 2*len(t.fields)))
+	e.appendUint16(uint16(size))
+	for _, off := range offsets {
+		e.appendUint16(uint16(off))
+	}
+
+	e.align(8, 4)
+	pos := len(e.buf)
+	e.buf = append(e.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(e.buf[pos:], uint32(pos-vtable))
+
+	for i, f := range t.fields {
+		if f.scalar != nil {
+			copy(e.buf[pos+offsets[i]:], f.scalar)
+		}
+	}
+	for i, f := range t.fields {
+		if f.ref != nil {
+			e.patch(pos+offsets[i], e.write(f.ref))
+		}
+	}
+	return pos
+}
+
+// fieldSize returns the inline size of a field, or 0 if it is not set.
+func fieldSize(f fbField) int {
+	if f.ref != nil {
+		return 4
+	}
+	return len(f.scalar)
+}
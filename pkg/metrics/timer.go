@@ -20,6 +20,9 @@ func (t *Timer) Value() time.Duration { return time.Duration(atomic.LoadInt64(&t
 // Update sets the timer value to d.
 func (t *Timer) Update(d time.Duration) { atomic.StoreInt64(&t.val, int64(d)) }
 
+// Add atomically adds d to the timer so it accumulates time across calls.
+func (t *Timer) Add(d time.Duration) { atomic.AddInt64(&t.val, int64(d)) }
+
 // UpdateSince sets the timer value to the difference between since and the current time.
 func (t *Timer) UpdateSince(since time.Time) { t.Update(time.Since(since)) }
 
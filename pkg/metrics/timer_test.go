@@ -12,3 +12,10 @@ func TestTimer_Update(t *testing.T) {
 	c.Update(100 * time.Millisecond)
 	assert.Equal(t, c.Value(), 100*time.Millisecond, "unexpected value")
 }
+
+func TestTimer_Add(t *testing.T) {
+	var c Timer
+	c.Add(100 * time.Millisecond)
+	c.Add(50 * time.Millisecond)
+	assert.Equal(t, c.Value(), 150*time.Millisecond, "unexpected value")
+}
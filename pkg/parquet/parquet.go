@@ -0,0 +1,569 @@
+// Package parquet implements a writer for the Apache Parquet file format.
+//
+// Only flat schemas of optional or required columns are supported. The
+// format is described at https://github.com/apache/parquet-format.
+package parquet // import "github.com/influxdata/influxdb/pkg/parquet"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+)
+
+// Magic is the 4-byte magic number at the start and end of a Parquet file.
+const Magic = "PAR1"
+
+// DefaultRowGroupSize is the default number of rows in a row group.
+const DefaultRowGroupSize = 65536
+
+// Type is the data type of a column.
+type Type int
+
+const (
+	// Boolean is a column of booleans.
+	Boolean Type = iota
+	// Int64 is a column of signed 64-bit integers.
+	Int64
+	// Uint64 is a column of unsigned 64-bit integers.
+	Uint64
+	// Double is a column of 64-bit floating point numbers.
+	Double
+	// String is a column of UTF-8 strings.
+	String
+	// Timestamp is a column of UTC timestamps with nanosecond precision.
+	Timestamp
+)
+
+// Codec is the compression codec used for the pages of a file.
+type Codec int
+
+const (
+	// Uncompressed disables compression.
+	Uncompressed Codec = 0
+	// Snappy compresses pages with snappy.
+	Snappy Codec = 1
+)
+
+// Values of the enums in the Parquet format definition.
+const (
+	physicalBoolean   = 0
+	physicalInt64     = 2
+	physicalDouble    = 5
+	physicalByteArray = 6
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	convertedUTF8   = 0
+	convertedUint64 = 14
+
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+
+	pageData       = 0
+	pageDictionary = 2
+)
+
+var (
+	// ErrTypeMismatch is returned when a value does not match its column type.
+	ErrTypeMismatch = errors.New("parquet: value does not match column type")
+
+	// ErrRequiredValue is returned when a required column is given a null value.
+	ErrRequiredValue = errors.New("parquet: null value in required column")
+
+	// ErrColumnCount is returned when a row does not have a value for each column.
+	ErrColumnCount = errors.New("parquet: row does not match column count")
+
+	// ErrWriterClosed is returned when writing to a closed writer.
+	ErrWriterClosed = errors.New("parquet: writer closed")
+)
+
+// Field describes a single column of a file.
+type Field struct {
+	Name string
+	Type Type
+
+	// Required columns may not hold null values.
+	Required bool
+
+	// Dictionary enables dictionary encoding of a String column. This is
+	// efficient for columns with few distinct values, such as tags.
+	Dictionary bool
+}
+
+// Writer writes rows to a Parquet file. Rows are buffered in memory and
+// written as a row group once RowGroupSize rows have been written.
+type Writer struct {
+	w       io.Writer
+	fields  []Field
+	columns []*column
+
+	offset    int64
+	rows      int
+	numRows   int64
+	rowGroups []rowGroup
+	closed    bool
+
+	// RowGroupSize is the maximum number of rows in a row group.
+	RowGroupSize int
+
+	// Codec is the compression codec applied to every page.
+	Codec Codec
+}
+
+// NewWriter returns a new Writer that writes a file with the given columns
+// to w.
+func NewWriter(w io.Writer, fields []Field) *Writer {
+	columns := make([]*column, len(fields))
+	for i, f := range fields {
+		columns[i] = newColumn(f)
+	}
+	return &Writer{
+		w:            w,
+		fields:       fields,
+		columns:      columns,
+		RowGroupSize: DefaultRowGroupSize,
+	}
+}
+
+// WriteRow writes a single row. Values must be bool, int64, uint64, float64
+// or string depending on the column type, or nil for a null value.
+// Timestamps are given as int64 nanoseconds since the epoch.
+func (w *Writer) WriteRow(values []interface{}) error {
+	if w.closed {
+		return ErrWriterClosed
+	} else if len(values) != len(w.columns) {
+		return ErrColumnCount
+	}
+
+	// Validate the whole row first so that a bad value does not leave the
+	// columns with different lengths.
+	for i, v := range values {
+		if err := w.columns[i].check(v); err != nil {
+			return fmt.Errorf("%s: column %q", err, w.fields[i].Name)
+		}
+	}
+	for i, v := range values {
+		w.columns[i].append(v)
+	}
+
+	w.rows++
+	if w.rows >= w.RowGroupSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered rows as a row group.
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrWriterClosed
+	} else if w.rows == 0 {
+		return nil
+	}
+
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+
+	rg := rowGroup{numRows: int64(w.rows)}
+	for i, c := range w.columns {
+		cc, err := w.writeColumnChunk(c)
+		if err != nil {
+			return err
+		}
+		rg.columns = append(rg.columns, cc)
+		rg.totalByteSize += cc.uncompressedSize
+		w.columns[i] = newColumn(c.field)
+	}
+
+	w.rowGroups = append(w.rowGroups, rg)
+	w.numRows += int64(w.rows)
+	w.rows = 0
+	return nil
+}
+
+// Close flushes any buffered rows and writes the file footer. It does not
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	} else if err := w.writeMagic(); err != nil {
+		return err
+	}
+	w.closed = true
+
+	meta := w.fileMetaData()
+	footer := make([]byte, 4, 4+len(Magic))
+	binary.LittleEndian.PutUint32(footer, uint32(len(meta)))
+	footer = append(footer, Magic...)
+
+	if err := w.write(meta); err != nil {
+		return err
+	}
+	return w.write(footer)
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	return err
+}
+
+// writeMagic writes the magic number at the start of the file, if it has
+// not been written yet.
+func (w *Writer) writeMagic() error {
+	if w.offset > 0 {
+		return nil
+	}
+	return w.write([]byte(Magic))
+}
+
+// writeColumnChunk writes the pages of a column for the current row group.
+func (w *Writer) writeColumnChunk(c *column) (columnChunk, error) {
+	cc := columnChunk{
+		field:     c.field,
+		numValues: int64(len(c.levels)),
+		offset:    w.offset,
+	}
+
+	// Definition levels are only written for optional columns.
+	var levels []byte
+	if !c.field.Required {
+		levels = encodeLevels(c.levels)
+	}
+
+	if c.field.Dictionary {
+		cc.encodings = []int32{encodingPlainDictionary, encodingRLE}
+		cc.dictionaryPageOffset = w.offset
+		if err := w.writePage(&cc, pageDictionary, len(c.dict), encodingPlainDictionary, c.dictValues); err != nil {
+			return cc, err
+		}
+
+		cc.dataPageOffset = w.offset
+		data := append(levels, encodeIndices(c.indices, len(c.dict))...)
+		return cc, w.writePage(&cc, pageData, len(c.levels), encodingPlainDictionary, data)
+	}
+
+	cc.encodings = []int32{encodingPlain, encodingRLE}
+	cc.dataPageOffset = w.offset
+	data := append(levels, c.values...)
+	return cc, w.writePage(&cc, pageData, len(c.levels), encodingPlain, data)
+}
+
+// writePage writes a page header followed by the possibly compressed page
+// data.
+func (w *Writer) writePage(cc *columnChunk, pageType int32, numValues int, encoding int32, data []byte) error {
+	compressed := data
+	if w.Codec == Snappy {
+		compressed = snappy.Encode(nil, data)
+	}
+
+	t := &thriftWriter{}
+	t.begin()
+	t.i32Field(1, pageType)
+	t.i32Field(2, int32(len(data)))
+	t.i32Field(3, int32(len(compressed)))
+	if pageType == pageDictionary {
+		t.structField(7)
+		t.i32Field(1, int32(numValues))
+		t.i32Field(2, encoding)
+		t.end()
+	} else {
+		t.structField(5)
+		t.i32Field(1, int32(numValues))
+		t.i32Field(2, encoding)
+		t.i32Field(3, encodingRLE) // definition levels
+		t.i32Field(4, encodingRLE) // repetition levels
+		t.end()
+	}
+	t.end()
+
+	cc.uncompressedSize += int64(len(t.buf) + len(data))
+	cc.compressedSize += int64(len(t.buf) + len(compressed))
+
+	if err := w.write(t.buf); err != nil {
+		return err
+	}
+	return w.write(compressed)
+}
+
+// fileMetaData returns the encoded file footer.
+func (w *Writer) fileMetaData() []byte {
+	t := &thriftWriter{}
+	t.begin()
+	t.i32Field(1, 1)
+
+	t.listField(2, thriftStruct, 1+len(w.fields))
+	t.begin()
+	t.stringField(4, "schema")
+	t.i32Field(5, int32(len(w.fields)))
+	t.end()
+	for _, f := range w.fields {
+		t.begin()
+		writeSchemaElement(t, f)
+		t.end()
+	}
+
+	t.i64Field(3, w.numRows)
+
+	t.listField(4, thriftStruct, len(w.rowGroups))
+	for _, rg := range w.rowGroups {
+		t.begin()
+		t.listField(1, thriftStruct, len(rg.columns))
+		for _, cc := range rg.columns {
+			t.begin()
+			writeColumnChunk(t, cc, w.Codec)
+			t.end()
+		}
+		t.i64Field(2, rg.totalByteSize)
+		t.i64Field(3, rg.numRows)
+		t.end()
+	}
+
+	t.stringField(6, "influxdb")
+	t.end()
+	return t.buf
+}
+
+// writeSchemaElement writes the fields of the schema element for f.
+func writeSchemaElement(t *thriftWriter, f Field) {
+	t.i32Field(1, physicalType(f.Type))
+	if f.Required {
+		t.i32Field(3, repetitionRequired)
+	} else {
+		t.i32Field(3, repetitionOptional)
+	}
+	t.stringField(4, f.Name)
+
+	switch f.Type {
+	case String:
+		t.i32Field(6, convertedUTF8)
+		t.structField(10)
+		t.structField(1) // STRING
+		t.end()
+		t.end()
+	case Uint64:
+		t.i32Field(6, convertedUint64)
+		t.structField(10)
+		t.structField(10) // INTEGER
+		t.i8Field(1, 64)
+		t.boolField(2, false)
+		t.end()
+		t.end()
+	case Timestamp:
+		t.structField(10)
+		t.structField(8) // TIMESTAMP
+		t.boolField(1, true)
+		t.structField(2)
+		t.structField(3) // NANOS
+		t.end()
+		t.end()
+		t.end()
+		t.end()
+	}
+}
+
+// writeColumnChunk writes the fields of the column chunk metadata for cc.
+func writeColumnChunk(t *thriftWriter, cc columnChunk, codec Codec) {
+	t.i64Field(2, cc.offset)
+	t.structField(3)
+	t.i32Field(1, physicalType(cc.field.Type))
+	t.listField(2, thriftI32, len(cc.encodings))
+	for _, e := range cc.encodings {
+		t.i32Elem(e)
+	}
+	t.listField(3, thriftBinary, 1)
+	t.stringElem(cc.field.Name)
+	t.i32Field(4, int32(codec))
+	t.i64Field(5, cc.numValues)
+	t.i64Field(6, cc.uncompressedSize)
+	t.i64Field(7, cc.compressedSize)
+	t.i64Field(9, cc.dataPageOffset)
+	if cc.field.Dictionary {
+		t.i64Field(11, cc.dictionaryPageOffset)
+	}
+	t.end()
+}
+
+func physicalType(typ Type) int32 {
+	switch typ {
+	case Boolean:
+		return physicalBoolean
+	case Double:
+		return physicalDouble
+	case String:
+		return physicalByteArray
+	default:
+		return physicalInt64
+	}
+}
+
+// rowGroup holds the metadata of a written row group.
+type rowGroup struct {
+	columns       []columnChunk
+	totalByteSize int64
+	numRows       int64
+}
+
+// columnChunk holds the metadata of a written column chunk.
+type columnChunk struct {
+	field                Field
+	encodings            []int32
+	numValues            int64
+	offset               int64
+	dataPageOffset       int64
+	dictionaryPageOffset int64
+	uncompressedSize     int64
+	compressedSize       int64
+}
+
+// column buffers the values of a column for the current row group. Values
+// are stored PLAIN encoded, or as dictionary indices if the column is
+// dictionary encoded.
+type column struct {
+	field  Field
+	levels []byte
+	values []byte
+	n      int // number of non-null values
+
+	dict       map[string]int32
+	dictValues []byte
+	indices    []int32
+}
+
+func newColumn(f Field) *column {
+	c := &column{field: f}
+	if f.Dictionary {
+		c.dict = make(map[string]int32)
+	}
+	return c
+}
+
+// check returns an error if v cannot be appended to the column.
+func (c *column) check(v interface{}) error {
+	if v == nil {
+		if c.field.Required {
+			return ErrRequiredValue
+		}
+		return nil
+	}
+
+	var ok bool
+	switch c.field.Type {
+	case Boolean:
+		_, ok = v.(bool)
+	case Int64, Timestamp:
+		_, ok = v.(int64)
+	case Uint64:
+		_, ok = v.(uint64)
+	case Double:
+		_, ok = v.(float64)
+	case String:
+		_, ok = v.(string)
+	}
+	if !ok {
+		return ErrTypeMismatch
+	}
+	return nil
+}
+
+// append adds v to the column. The value must have been checked.
+func (c *column) append(v interface{}) {
+	if v == nil {
+		c.levels = append(c.levels, 0)
+		return
+	}
+	c.levels = append(c.levels, 1)
+
+	switch v := v.(type) {
+	case bool:
+		if c.n%8 == 0 {
+			c.values = append(c.values, 0)
+		}
+		if v {
+			c.values[c.n/8] |= 1 << uint(c.n%8)
+		}
+	case int64:
+		c.values = appendUint64(c.values, uint64(v))
+	case uint64:
+		c.values = appendUint64(c.values, v)
+	case float64:
+		c.values = appendUint64(c.values, math.Float64bits(v))
+	case string:
+		if c.field.Dictionary {
+			idx, ok := c.dict[v]
+			if !ok {
+				idx = int32(len(c.dict))
+				c.dict[v] = idx
+				c.dictValues = appendByteArray(c.dictValues, v)
+			}
+			c.indices = append(c.indices, idx)
+		} else {
+			c.values = appendByteArray(c.values, v)
+		}
+	}
+	c.n++
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint64(b[len(b)-8:], v)
+	return b
+}
+
+func appendByteArray(b []byte, v string) []byte {
+	b = append(b, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(b[len(b)-4:], uint32(len(v)))
+	return append(b, v...)
+}
+
+// encodeLevels encodes definition levels with the RLE/bit-packing hybrid
+// encoding, prefixed by their length.
+func encodeLevels(levels []byte) []byte {
+	values := make([]int32, len(levels))
+	for i, l := range levels {
+		values[i] = int32(l)
+	}
+	b := appendRLE(make([]byte, 4), values, 1)
+	binary.LittleEndian.PutUint32(b, uint32(len(b)-4))
+	return b
+}
+
+// encodeIndices encodes dictionary indices, prefixed by their bit width.
+func encodeIndices(indices []int32, n int) []byte {
+	width := 1
+	for n > 1<<uint(width) {
+		width++
+	}
+	return appendRLE([]byte{byte(width)}, indices, width)
+}
+
+// appendRLE appends values to b as RLE runs of the RLE/bit-packing hybrid
+// encoding.
+func appendRLE(b []byte, values []int32, width int) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	size := (width + 7) / 8
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+
+		n := binary.PutUvarint(tmp[:], uint64(j-i)<<1)
+		b = append(b, tmp[:n]...)
+		for k := 0; k < size; k++ {
+			b = append(b, byte(values[i]>>uint(8*k)))
+		}
+		i = j
+	}
+	return b
+}
@@ -0,0 +1,176 @@
+package parquet_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/influxdb/pkg/parquet"
+)
+
+func TestWriter(t *testing.T) {
+	for _, codec := range []parquet.Codec{parquet.Uncompressed, parquet.Snappy} {
+		var buf bytes.Buffer
+		w := parquet.NewWriter(&buf, []parquet.Field{
+			{Name: "time", Type: parquet.Timestamp, Required: true},
+			{Name: "host", Type: parquet.String, Dictionary: true},
+			{Name: "value", Type: parquet.Double},
+		})
+		w.Codec = codec
+		w.RowGroupSize = 3
+
+		for _, row := range [][]interface{}{
+			{int64(1), "a", 1.5},
+			{int64(2), "a", nil},
+			{int64(3), "b", 3.5},
+			{int64(4), nil, 4.5},
+		} {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		b := buf.Bytes()
+		if !bytes.HasPrefix(b, []byte("PAR1")) || !bytes.HasSuffix(b, []byte("PAR1")) {
+			t.Fatal("missing magic number")
+		}
+		n := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+		meta, rest := readStruct(b[len(b)-8-n : len(b)-8])
+		if len(rest) != 0 {
+			t.Fatalf("unexpected trailing metadata: %d bytes", len(rest))
+		}
+
+		if got, exp := meta[3], int64(4); got != exp {
+			t.Fatalf("unexpected row count: got %v, exp %v", got, exp)
+		}
+
+		var names []string
+		for _, el := range meta[2].([]interface{}) {
+			names = append(names, el.(map[int16]interface{})[4].(string))
+		}
+		if exp := []string{"schema", "time", "host", "value"}; !reflect.DeepEqual(names, exp) {
+			t.Fatalf("unexpected schema: got %v, exp %v", names, exp)
+		}
+
+		rowGroups := meta[4].([]interface{})
+		if len(rowGroups) != 2 {
+			t.Fatalf("unexpected row group count: %d", len(rowGroups))
+		}
+		columns := rowGroups[0].(map[int16]interface{})[1].([]interface{})
+
+		// The value column has an optional definition level per row and
+		// PLAIN encoded doubles.
+		data := readPage(t, b, columns[2].(map[int16]interface{})[3].(map[int16]interface{}), codec)
+		levels := int(binary.LittleEndian.Uint32(data))
+		if exp := []byte{1 << 1, 1, 1 << 1, 0, 1 << 1, 1}; !bytes.Equal(data[4:4+levels], exp) {
+			t.Fatalf("unexpected definition levels: %v", data[4:4+levels])
+		} else if got := math.Float64frombits(binary.LittleEndian.Uint64(data[4+levels+8:])); got != 3.5 {
+			t.Fatalf("unexpected value: %v", got)
+		}
+
+		// The host column has a dictionary page followed by the indices.
+		host := columns[1].(map[int16]interface{})[3].(map[int16]interface{})
+		if off, ok := host[11].(int64); !ok || off >= host[9].(int64) {
+			t.Fatalf("unexpected dictionary page offset: %v", host[11])
+		}
+		data = readPage(t, b, host, codec)
+		levels = int(binary.LittleEndian.Uint32(data))
+		if got, exp := data[4+levels:], []byte{1, 2 << 1, 0, 1 << 1, 1}; !bytes.Equal(got, exp) {
+			t.Fatalf("unexpected indices: got %v, exp %v", got, exp)
+		}
+	}
+}
+
+func TestWriter_WriteRow_TypeMismatch(t *testing.T) {
+	w := parquet.NewWriter(&bytes.Buffer{}, []parquet.Field{{Name: "value", Type: parquet.Double, Required: true}})
+	if err := w.WriteRow([]interface{}{int64(1)}); err == nil {
+		t.Fatal("expected error")
+	} else if err := w.WriteRow([]interface{}{nil}); err == nil {
+		t.Fatal("expected error")
+	} else if err := w.WriteRow(nil); err != parquet.ErrColumnCount {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// readPage returns the data of the data page of a column chunk.
+func readPage(t *testing.T, b []byte, meta map[int16]interface{}, codec parquet.Codec) []byte {
+	off := meta[9].(int64)
+	hdr, rest := readStruct(b[off:])
+	data := rest[:hdr[3].(int64)]
+	if codec == parquet.Snappy {
+		var err error
+		if data, err = snappy.Decode(nil, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, exp := int64(len(data)), hdr[2].(int64); got != exp {
+		t.Fatalf("unexpected page size: got %d, exp %d", got, exp)
+	}
+	return data
+}
+
+// readStruct decodes a Thrift compact protocol struct into a map of field
+// ids to values. Integers are returned as int64, binaries as strings, lists
+// as slices and structs as maps.
+func readStruct(b []byte) (map[int16]interface{}, []byte) {
+	m := make(map[int16]interface{})
+	var id int16
+	for {
+		hdr := b[0]
+		b = b[1:]
+		if hdr == 0 {
+			return m, b
+		}
+
+		typ := hdr & 0xf
+		if delta := int16(hdr >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, n := binary.Varint(b)
+			id, b = int16(v), b[n:]
+		}
+
+		switch typ {
+		case 1, 2:
+			m[id] = typ == 1
+		default:
+			m[id], b = readValue(b, typ)
+		}
+	}
+}
+
+func readValue(b []byte, typ byte) (interface{}, []byte) {
+	switch typ {
+	case 3:
+		return int64(int8(b[0])), b[1:]
+	case 4, 5, 6:
+		v, n := binary.Varint(b)
+		return v, b[n:]
+	case 8:
+		n, sz := binary.Uvarint(b)
+		return string(b[sz : sz+int(n)]), b[sz+int(n):]
+	case 9:
+		hdr := b[0]
+		b = b[1:]
+		n := int(hdr >> 4)
+		if n == 15 {
+			v, sz := binary.Uvarint(b)
+			n, b = int(v), b[sz:]
+		}
+		a := make([]interface{}, n)
+		for i := range a {
+			a[i], b = readValue(b, hdr&0xf)
+		}
+		return a, b
+	case 12:
+		return readStruct(b)
+	default:
+		panic("unsupported thrift type")
+	}
+}
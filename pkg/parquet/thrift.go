@@ -0,0 +1,108 @@
+package parquet
+
+import "encoding/binary"
+
+// Parquet metadata is encoded with the Thrift compact protocol. Only the
+// parts of the protocol needed to write file metadata are implemented.
+
+// Thrift compact protocol type ids.
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftI8        = 3
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftStruct    = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol.
+type thriftWriter struct {
+	buf  []byte
+	last []int16 // id of the last field written, per nested struct
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.zigzag(int64(id))
+	}
+	*last = id
+}
+
+// begin starts a struct. The struct's field header must already be written.
+func (w *thriftWriter) begin() { w.last = append(w.last, 0) }
+
+// end ends a struct.
+func (w *thriftWriter) end() {
+	w.buf = append(w.buf, 0)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.begin()
+}
+
+func (w *thriftWriter) boolField(id int16, v bool) {
+	if v {
+		w.field(id, thriftBoolTrue)
+	} else {
+		w.field(id, thriftBoolFalse)
+	}
+}
+
+func (w *thriftWriter) i8Field(id int16, v int8) {
+	w.field(id, thriftI8)
+	w.buf = append(w.buf, byte(v))
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) stringField(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.varint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// listField writes the header of a list with n elements of type typ. The
+// elements must be written by the caller: structs with begin and end, and
+// scalars with the element functions below.
+func (w *thriftWriter) listField(id int16, typ byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|typ)
+	} else {
+		w.buf = append(w.buf, 0xf0|typ)
+		w.varint(uint64(n))
+	}
+}
+
+func (w *thriftWriter) i32Elem(v int32) { w.zigzag(int64(v)) }
+
+func (w *thriftWriter) stringElem(v string) {
+	w.varint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -52,6 +53,8 @@ func NewCallIterator(input Iterator, opt IteratorOptions) (Iterator, error) {
 		return newLastIterator(input, opt)
 	case "mean":
 		return newMeanIterator(input, opt)
+	case "histogram":
+		return newHistogramIterator(input, opt)
 	default:
 		return nil, fmt.Errorf("unsupported function call: %s", name)
 	}
@@ -1131,6 +1134,263 @@ func NewUnsignedPercentileReduceSliceFunc(percentile float64) UnsignedReduceSlic
 	}
 }
 
+// newHistogramIterator returns an iterator for operating on a histogram()
+// call. For each window it emits the count of every bucket, each as a point
+// with the index of its bucket as the only auxiliary value. The partial
+// histograms of several shards are combined by newHistogramMergeIterator.
+func newHistogramIterator(input Iterator, opt IteratorOptions) (Iterator, error) {
+	bounds, err := histogramBounds(opt.Expr.(*influxql.Call).Args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, IntegerPointEmitter) {
+			fn := NewFloatHistogramReducer(bounds)
+			return fn, fn
+		}
+		return newFloatReduceIntegerIterator(input, opt, createFn), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, IntegerPointEmitter) {
+			fn := NewIntegerHistogramReducer(bounds)
+			return fn, fn
+		}
+		return newIntegerReduceIntegerIterator(input, opt, createFn), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, IntegerPointEmitter) {
+			fn := NewUnsignedHistogramReducer(bounds)
+			return fn, fn
+		}
+		return newUnsignedReduceIntegerIterator(input, opt, createFn), nil
+	default:
+		return nil, fmt.Errorf("unsupported histogram iterator type: %T", input)
+	}
+}
+
+// newHistogramMergeIterator returns an iterator that merges the partial
+// histograms emitted by newHistogramIterator, summing the counts of each
+// bucket in each window.
+func newHistogramMergeIterator(input Iterator, opt IteratorOptions) (Iterator, error) {
+	bounds, err := histogramBounds(opt.Expr.(*influxql.Call).Args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input := input.(type) {
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, IntegerPointEmitter) {
+			fn := NewHistogramMergeReducer(len(bounds) - 1)
+			return fn, fn
+		}
+		return newIntegerReduceIntegerIterator(input, opt, createFn), nil
+	case *nilFloatIterator:
+		return input, nil
+	default:
+		return nil, fmt.Errorf("unsupported histogram merge iterator type: %T", input)
+	}
+}
+
+// histogramReducer counts the values within each bucket of a histogram.
+type histogramReducer struct {
+	bounds []float64
+	counts []int64
+}
+
+func newHistogramReducer(bounds []float64) histogramReducer {
+	return histogramReducer{bounds: bounds, counts: make([]int64, len(bounds)-1)}
+}
+
+// add counts v in the bucket [bounds[i], bounds[i+1]) that contains it, if any.
+func (r *histogramReducer) add(v float64) {
+	i := sort.Search(len(r.bounds), func(i int) bool { return r.bounds[i] > v }) - 1
+	if i >= 0 && i < len(r.counts) {
+		r.counts[i]++
+	}
+}
+
+// Emit emits the count of each bucket, with the index of the bucket as the
+// auxiliary value of its point.
+func (r *histogramReducer) Emit() []IntegerPoint {
+	points := make([]IntegerPoint, len(r.counts))
+	for i, n := range r.counts {
+		points[i] = IntegerPoint{Time: ZeroTime, Value: n, Aux: []interface{}{int64(i)}}
+	}
+	return points
+}
+
+// FloatHistogramReducer counts the aggregated points within each bucket of a histogram.
+type FloatHistogramReducer struct {
+	histogramReducer
+}
+
+// NewFloatHistogramReducer creates a new FloatHistogramReducer for the
+// buckets between each of the increasing bounds.
+func NewFloatHistogramReducer(bounds []float64) *FloatHistogramReducer {
+	return &FloatHistogramReducer{newHistogramReducer(bounds)}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatHistogramReducer) AggregateFloat(p *FloatPoint) {
+	r.add(p.Value)
+}
+
+// IntegerHistogramReducer counts the aggregated points within each bucket of a histogram.
+type IntegerHistogramReducer struct {
+	histogramReducer
+}
+
+// NewIntegerHistogramReducer creates a new IntegerHistogramReducer for the
+// buckets between each of the increasing bounds.
+func NewIntegerHistogramReducer(bounds []float64) *IntegerHistogramReducer {
+	return &IntegerHistogramReducer{newHistogramReducer(bounds)}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerHistogramReducer) AggregateInteger(p *IntegerPoint) {
+	r.add(float64(p.Value))
+}
+
+// UnsignedHistogramReducer counts the aggregated points within each bucket of a histogram.
+type UnsignedHistogramReducer struct {
+	histogramReducer
+}
+
+// NewUnsignedHistogramReducer creates a new UnsignedHistogramReducer for the
+// buckets between each of the increasing bounds.
+func NewUnsignedHistogramReducer(bounds []float64) *UnsignedHistogramReducer {
+	return &UnsignedHistogramReducer{newHistogramReducer(bounds)}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedHistogramReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.add(float64(p.Value))
+}
+
+// HistogramMergeReducer sums the bucket counts of partial histograms.
+type HistogramMergeReducer struct {
+	counts []int64
+}
+
+// NewHistogramMergeReducer creates a new HistogramMergeReducer for a
+// histogram with n buckets.
+func NewHistogramMergeReducer(n int) *HistogramMergeReducer {
+	return &HistogramMergeReducer{counts: make([]int64, n)}
+}
+
+// AggregateInteger adds the count of a bucket of a partial histogram.
+func (r *HistogramMergeReducer) AggregateInteger(p *IntegerPoint) {
+	if len(p.Aux) == 0 {
+		return
+	}
+	if i, ok := p.Aux[0].(int64); ok && i >= 0 && int(i) < len(r.counts) {
+		r.counts[i] += p.Value
+	}
+}
+
+// Emit emits the count of each bucket, with the index of the bucket as the
+// auxiliary value of its point.
+func (r *HistogramMergeReducer) Emit() []IntegerPoint {
+	points := make([]IntegerPoint, len(r.counts))
+	for i, n := range r.counts {
+		points[i] = IntegerPoint{Time: ZeroTime, Value: n, Aux: []interface{}{int64(i)}}
+	}
+	return points
+}
+
+// histogramBuckets splits the counts emitted for a histogram into an
+// iterator for each bucket, so the buckets are returned as columns while the
+// points are only counted once.
+type histogramBuckets struct {
+	input   IntegerIterator
+	buffers [][]*IntegerPoint
+	open    int
+}
+
+func newHistogramBuckets(input Iterator, n int) *histogramBuckets {
+	b := &histogramBuckets{buffers: make([][]*IntegerPoint, n)}
+	b.input, _ = input.(IntegerIterator)
+	if b.input == nil && input != nil {
+		input.Close()
+	}
+	return b
+}
+
+// Iterator returns the iterator of the counts of bucket i.
+func (b *histogramBuckets) Iterator(i int) Iterator {
+	b.open++
+	return &histogramBucketIterator{buckets: b, index: i}
+}
+
+// next returns the next count of bucket i, buffering the counts of other
+// buckets read before it.
+func (b *histogramBuckets) next(i int) (*IntegerPoint, error) {
+	if buf := b.buffers[i]; len(buf) > 0 {
+		p := buf[0]
+		b.buffers[i] = buf[1:]
+		return p, nil
+	} else if b.input == nil {
+		return nil, nil
+	}
+
+	for {
+		p, err := b.input.Next()
+		if err != nil || p == nil {
+			return nil, err
+		}
+
+		j, ok := p.Aux[0].(int64)
+		if !ok || j < 0 || int(j) >= len(b.buffers) {
+			continue
+		}
+		p.Aux = nil
+		if int(j) == i {
+			return p, nil
+		}
+		b.buffers[j] = append(b.buffers[j], p)
+	}
+}
+
+// close closes the input once the iterators of the buckets are all closed.
+func (b *histogramBuckets) close() error {
+	b.open--
+	if b.open == 0 && b.input != nil {
+		return b.input.Close()
+	}
+	return nil
+}
+
+// histogramBucketIterator iterates over the counts of a single bucket of a
+// histogram.
+type histogramBucketIterator struct {
+	buckets *histogramBuckets
+	index   int
+	closed  bool
+}
+
+// Stats returns stats from the input iterator. They are only reported by the
+// first bucket so they are not counted once per bucket.
+func (itr *histogramBucketIterator) Stats() IteratorStats {
+	if itr.index != 0 || itr.buckets.input == nil {
+		return IteratorStats{}
+	}
+	return itr.buckets.input.Stats()
+}
+
+// Close closes the iterator, and the input once all buckets are closed.
+func (itr *histogramBucketIterator) Close() error {
+	if itr.closed {
+		return nil
+	}
+	itr.closed = true
+	return itr.buckets.close()
+}
+
+// Next returns the next count of the bucket.
+func (itr *histogramBucketIterator) Next() (*IntegerPoint, error) {
+	return itr.buckets.next(itr.index)
+}
+
 // newDerivativeIterator returns an iterator for operating on a derivative() call.
 func newDerivativeIterator(input Iterator, opt IteratorOptions, interval Interval, isNonNegative bool) (Iterator, error) {
 	switch input := input.(type) {
@@ -1157,6 +1417,61 @@ func newDerivativeIterator(input Iterator, opt IteratorOptions, interval Interva
 	}
 }
 
+// newRateIterator returns an iterator for operating on a rate() or increase()
+// call between successive points. If interval is zero, the increase is returned.
+func newRateIterator(input Iterator, opt IteratorOptions, interval Interval) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatRateReducer(interval, opt.Ascending)
+			return fn, fn
+		}
+		return newFloatStreamFloatIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerRateReducer(interval, opt.Ascending)
+			return fn, fn
+		}
+		return newIntegerStreamFloatIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedRateReducer(interval, opt.Ascending)
+			return fn, fn
+		}
+		return newUnsignedStreamFloatIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported rate iterator type: %T", input)
+	}
+}
+
+// newWindowRateIterator returns an iterator for operating on a rate() or
+// increase() call over each GROUP BY time() window. If interval is zero, the
+// increase is returned.
+func newWindowRateIterator(input Iterator, opt IteratorOptions, interval Interval) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatWindowRateReducer(interval, opt)
+			return fn, fn
+		}
+		return newFloatReduceFloatIterator(input, opt, createFn), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerWindowRateReducer(interval, opt)
+			return fn, fn
+		}
+		return newIntegerReduceFloatIterator(input, opt, createFn), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedWindowRateReducer(interval, opt)
+			return fn, fn
+		}
+		return newUnsignedReduceFloatIterator(input, opt, createFn), nil
+	default:
+		return nil, fmt.Errorf("unsupported rate iterator type: %T", input)
+	}
+}
+
 // newDifferenceIterator returns an iterator for operating on a difference() call.
 func newDifferenceIterator(input Iterator, opt IteratorOptions, isNonNegative bool) (Iterator, error) {
 	switch input := input.(type) {
@@ -1293,6 +1608,111 @@ func newHoltWintersIterator(input Iterator, opt IteratorOptions, h, m int, inclu
 	}
 }
 
+// newZScoreIterator returns an iterator for operating on a zscore() call.
+func newZScoreIterator(input Iterator, n int, opt IteratorOptions) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatZScoreReducer(n)
+			return fn, fn
+		}
+		return newFloatStreamFloatIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerZScoreReducer(n)
+			return fn, fn
+		}
+		return newIntegerStreamFloatIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedZScoreReducer(n)
+			return fn, fn
+		}
+		return newUnsignedStreamFloatIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported zscore iterator type: %T", input)
+	}
+}
+
+// newMADOutliersIterator returns an iterator for operating on a mad_outliers() call.
+func newMADOutliersIterator(input Iterator, n int, k float64, opt IteratorOptions) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatMADOutliersReducer(n, k)
+			return fn, fn
+		}
+		return newFloatStreamFloatIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, IntegerPointEmitter) {
+			fn := NewIntegerMADOutliersReducer(n, k)
+			return fn, fn
+		}
+		return newIntegerStreamIntegerIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, UnsignedPointEmitter) {
+			fn := NewUnsignedMADOutliersReducer(n, k)
+			return fn, fn
+		}
+		return newUnsignedStreamUnsignedIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported mad_outliers iterator type: %T", input)
+	}
+}
+
+// newSeasonalDecompositionIterator returns an iterator for operating on a
+// decompose_trend() or decompose_residual() call.
+func newSeasonalDecompositionIterator(input Iterator, opt IteratorOptions, period int, interval Interval, residual bool) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatSeasonalDecompositionReducer(period, interval, residual)
+			return fn, fn
+		}
+		return newFloatReduceFloatIterator(input, opt, createFn), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerSeasonalDecompositionReducer(period, interval, residual)
+			return fn, fn
+		}
+		return newIntegerReduceFloatIterator(input, opt, createFn), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedSeasonalDecompositionReducer(period, interval, residual)
+			return fn, fn
+		}
+		return newUnsignedReduceFloatIterator(input, opt, createFn), nil
+	default:
+		return nil, fmt.Errorf("unsupported seasonal decomposition iterator type: %T", input)
+	}
+}
+
+// newLinearForecastIterator returns an iterator for operating on a forecast_linear() call.
+func newLinearForecastIterator(input Iterator, opt IteratorOptions, n int, interval time.Duration) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatLinearForecastReducer(n, interval)
+			return fn, fn
+		}
+		return newFloatReduceFloatIterator(input, opt, createFn), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerLinearForecastReducer(n, interval)
+			return fn, fn
+		}
+		return newIntegerReduceFloatIterator(input, opt, createFn), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedLinearForecastReducer(n, interval)
+			return fn, fn
+		}
+		return newUnsignedReduceFloatIterator(input, opt, createFn), nil
+	default:
+		return nil, fmt.Errorf("unsupported forecast_linear iterator type: %T", input)
+	}
+}
+
 // NewSampleIterator returns an iterator for operating on a sample() call (exported for use in test).
 func NewSampleIterator(input Iterator, opt IteratorOptions, size int) (Iterator, error) {
 	return newSampleIterator(input, opt, size)
@@ -1361,3 +1781,160 @@ func newIntegralIterator(input Iterator, opt IteratorOptions, interval Interval)
 		return nil, fmt.Errorf("unsupported integral iterator type: %T", input)
 	}
 }
+
+// newInterpolateIterator returns an iterator for operating on an interpolate() call.
+func newInterpolateIterator(input Iterator, opt IteratorOptions, linear bool, step, maxGap time.Duration) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatInterpolateReducer(linear, step, maxGap, opt.Ascending)
+			return fn, fn
+		}
+		return newFloatStreamFloatIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, IntegerPointEmitter) {
+			fn := NewIntegerInterpolateReducer(linear, step, maxGap, opt.Ascending)
+			return fn, fn
+		}
+		return newIntegerStreamIntegerIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, UnsignedPointEmitter) {
+			fn := NewUnsignedInterpolateReducer(linear, step, maxGap, opt.Ascending)
+			return fn, fn
+		}
+		return newUnsignedStreamUnsignedIterator(input, createFn, opt), nil
+	case StringIterator:
+		if linear {
+			return nil, errors.New("linear interpolation is not supported for string fields")
+		}
+		createFn := func() (StringPointAggregator, StringPointEmitter) {
+			fn := NewStringInterpolateReducer(step, maxGap, opt.Ascending)
+			return fn, fn
+		}
+		return newStringStreamStringIterator(input, createFn, opt), nil
+	case BooleanIterator:
+		if linear {
+			return nil, errors.New("linear interpolation is not supported for boolean fields")
+		}
+		createFn := func() (BooleanPointAggregator, BooleanPointEmitter) {
+			fn := NewBooleanInterpolateReducer(step, maxGap, opt.Ascending)
+			return fn, fn
+		}
+		return newBooleanStreamBooleanIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported interpolate iterator type: %T", input)
+	}
+}
+
+// newTimeWeightedAvgIterator returns an iterator for operating on a time_weighted_avg() call.
+func newTimeWeightedAvgIterator(input Iterator, opt IteratorOptions, locf bool) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		createFn := func() (FloatPointAggregator, FloatPointEmitter) {
+			fn := NewFloatTimeWeightedAvgReducer(locf, opt)
+			return fn, fn
+		}
+		return newFloatStreamFloatIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		createFn := func() (IntegerPointAggregator, FloatPointEmitter) {
+			fn := NewIntegerTimeWeightedAvgReducer(locf, opt)
+			return fn, fn
+		}
+		return newIntegerStreamFloatIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		createFn := func() (UnsignedPointAggregator, FloatPointEmitter) {
+			fn := NewUnsignedTimeWeightedAvgReducer(locf, opt)
+			return fn, fn
+		}
+		return newUnsignedStreamFloatIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported time_weighted_avg iterator type: %T", input)
+	}
+}
+
+// newStateIterator returns an iterator for operating on a state_duration() or
+// state_count() call. The state literal must be comparable to the input type.
+func newStateIterator(input Iterator, opt IteratorOptions, state influxql.Literal, count bool, unit time.Duration) (Iterator, error) {
+	name := "state_duration"
+	if count {
+		name = "state_count"
+	}
+
+	switch input := input.(type) {
+	case FloatIterator:
+		var v float64
+		switch state := state.(type) {
+		case *influxql.NumberLiteral:
+			v = state.Val
+		case *influxql.IntegerLiteral:
+			v = float64(state.Val)
+		case *influxql.UnsignedLiteral:
+			v = float64(state.Val)
+		default:
+			return nil, fmt.Errorf("%s: cannot compare float field to %s", name, state)
+		}
+		createFn := func() (FloatPointAggregator, IntegerPointEmitter) {
+			fn := NewFloatStateReducer(v, count, unit, opt)
+			return fn, fn
+		}
+		return newFloatStreamIntegerIterator(input, createFn, opt), nil
+	case IntegerIterator:
+		var v int64
+		switch state := state.(type) {
+		case *influxql.IntegerLiteral:
+			v = state.Val
+		case *influxql.NumberLiteral:
+			if state.Val != math.Trunc(state.Val) {
+				return nil, fmt.Errorf("%s: cannot compare integer field to %s", name, state)
+			}
+			v = int64(state.Val)
+		default:
+			return nil, fmt.Errorf("%s: cannot compare integer field to %s", name, state)
+		}
+		createFn := func() (IntegerPointAggregator, IntegerPointEmitter) {
+			fn := NewIntegerStateReducer(v, count, unit, opt)
+			return fn, fn
+		}
+		return newIntegerStreamIntegerIterator(input, createFn, opt), nil
+	case UnsignedIterator:
+		var v uint64
+		switch state := state.(type) {
+		case *influxql.UnsignedLiteral:
+			v = state.Val
+		case *influxql.IntegerLiteral:
+			if state.Val < 0 {
+				return nil, fmt.Errorf("%s: cannot compare unsigned field to %s", name, state)
+			}
+			v = uint64(state.Val)
+		default:
+			return nil, fmt.Errorf("%s: cannot compare unsigned field to %s", name, state)
+		}
+		createFn := func() (UnsignedPointAggregator, IntegerPointEmitter) {
+			fn := NewUnsignedStateReducer(v, count, unit, opt)
+			return fn, fn
+		}
+		return newUnsignedStreamIntegerIterator(input, createFn, opt), nil
+	case StringIterator:
+		s, ok := state.(*influxql.StringLiteral)
+		if !ok {
+			return nil, fmt.Errorf("%s: cannot compare string field to %s", name, state)
+		}
+		createFn := func() (StringPointAggregator, IntegerPointEmitter) {
+			fn := NewStringStateReducer(s.Val, count, unit, opt)
+			return fn, fn
+		}
+		return newStringStreamIntegerIterator(input, createFn, opt), nil
+	case BooleanIterator:
+		b, ok := state.(*influxql.BooleanLiteral)
+		if !ok {
+			return nil, fmt.Errorf("%s: cannot compare boolean field to %s", name, state)
+		}
+		createFn := func() (BooleanPointAggregator, IntegerPointEmitter) {
+			fn := NewBooleanStateReducer(b.Val, count, unit, opt)
+			return fn, fn
+		}
+		return newBooleanStreamIntegerIterator(input, createFn, opt), nil
+	default:
+		return nil, fmt.Errorf("unsupported %s iterator type: %T", name, input)
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +35,10 @@ type compiledStatement struct {
 	// Interval holds the time grouping interval.
 	Interval Interval
 
+	// SampleInterval is the smallest sample interval of the interpolate()
+	// calls of the statement, if any.
+	SampleInterval time.Duration
+
 	// InheritedInterval marks if the interval was inherited by a parent.
 	// If this is set, then an interval that was inherited will not cause
 	// a query that shouldn't have an interval to fail.
@@ -108,6 +113,9 @@ func Compile(stmt *influxql.SelectStatement, opt CompileOptions) (Statement, err
 	// Convert DISTINCT into a call.
 	c.stmt.RewriteDistinct()
 
+	// Expand histograms into a field for each bucket.
+	rewriteHistograms(c.stmt)
+
 	// Remove "time" from fields list.
 	c.stmt.RewriteTimeFields()
 
@@ -241,6 +249,12 @@ func (c *compiledField) compileExpr(expr influxql.Expr) error {
 		c.global.HasAuxiliaryFields = true
 		return nil
 	case *influxql.Call:
+		// Scalar functions are applied to the result of their argument
+		// and are not aggregates or selectors themselves.
+		if isScalarFunction(expr.Name) {
+			return c.compileScalarFunction(expr)
+		}
+
 		// Register the function call in the list of function calls.
 		c.global.FunctionCalls = append(c.global.FunctionCalls, expr)
 
@@ -265,11 +279,27 @@ func (c *compiledField) compileExpr(expr influxql.Expr) error {
 			return c.compileMovingAverage(expr.Args)
 		case "elapsed":
 			return c.compileElapsed(expr.Args)
+		case "rate", "increase":
+			return c.compileRate(expr.Name, expr.Args)
 		case "integral":
 			return c.compileIntegral(expr.Args)
+		case "histogram":
+			return c.compileHistogram(expr)
+		case "interpolate":
+			return c.compileInterpolate(expr.Args)
+		case "time_weighted_avg":
+			return c.compileTimeWeightedAvg(expr.Args)
+		case "state_duration", "state_count":
+			return c.compileState(expr.Name, expr.Args)
 		case "holt_winters", "holt_winters_with_fit":
 			withFit := expr.Name == "holt_winters_with_fit"
 			return c.compileHoltWinters(expr.Args, withFit)
+		case "zscore", "mad_outliers":
+			return c.compileOutliers(expr.Name, expr.Args)
+		case "decompose_trend", "decompose_residual":
+			return c.compileSeasonalDecomposition(expr.Name, expr.Args)
+		case "forecast_linear":
+			return c.compileForecastLinear(expr.Args)
 		default:
 			return c.compileFunction(expr)
 		}
@@ -358,6 +388,27 @@ func (c *compiledField) compileFunction(expr *influxql.Call) error {
 	return c.compileSymbol(expr.Name, expr.Args[0])
 }
 
+// compileScalarFunction validates the literal arguments of a scalar function
+// and compiles the expression it is applied to.
+func (c *compiledField) compileScalarFunction(expr *influxql.Call) error {
+	if _, err := validateScalarFunction(expr); err != nil {
+		return err
+	}
+
+	// Wildcards cannot be expanded within the argument of a scalar function.
+	c.AllowWildcard = false
+
+	switch expr.Args[0].(type) {
+	case *influxql.Wildcard:
+		return fmt.Errorf("unsupported expression with wildcard: %s()", expr.Name)
+	case *influxql.RegexLiteral:
+		return fmt.Errorf("unsupported expression with regex field: %s()", expr.Name)
+	case influxql.Literal:
+		return fmt.Errorf("expected field argument in %s()", expr.Name)
+	}
+	return c.compileExpr(expr.Args[0])
+}
+
 func (c *compiledField) compilePercentile(args []influxql.Expr) error {
 	if exp, got := 2, len(args); got != exp {
 		return fmt.Errorf("invalid number of arguments for percentile, expected %d, got %d", exp, got)
@@ -426,6 +477,47 @@ func (c *compiledField) compileDerivative(args []influxql.Expr, isNonNegative bo
 	}
 }
 
+func (c *compiledField) compileRate(name string, args []influxql.Expr) error {
+	if name == "increase" {
+		if exp, got := 1, len(args); got != exp {
+			return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, exp, got)
+		}
+	} else if min, max, got := 1, 2, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", name, min, max, got)
+	}
+
+	// Retrieve the duration from the rate() call, if specified.
+	if len(args) == 2 {
+		switch arg1 := args[1].(type) {
+		case *influxql.DurationLiteral:
+			if arg1.Val <= 0 {
+				return fmt.Errorf("duration argument must be positive, got %s", influxql.FormatDuration(arg1.Val))
+			}
+		default:
+			return fmt.Errorf("second argument to %s must be a duration, got %T", name, args[1])
+		}
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, function, wildcard, or regexp. Over a
+	// field, this is a transformation of successive points, or an aggregate
+	// of each window if there is a GROUP BY interval.
+	switch arg0 := args[0].(type) {
+	case *influxql.Call:
+		if c.global.Interval.IsZero() {
+			return fmt.Errorf("%s aggregate requires a GROUP BY interval", name)
+		}
+		return c.compileExpr(arg0)
+	case *influxql.VarRef:
+		if c.global.Interval.Sliding() {
+			return fmt.Errorf("%s does not support a GROUP BY step", name)
+		}
+		return c.compileSymbol(name, arg0)
+	default:
+		return c.compileSymbol(name, arg0)
+	}
+}
+
 func (c *compiledField) compileElapsed(args []influxql.Expr) error {
 	if min, max, got := 1, 2, len(args); got > max || got < min {
 		return fmt.Errorf("invalid number of arguments for elapsed, expected at least %d but no more than %d, got %d", min, max, got)
@@ -536,6 +628,56 @@ func (c *compiledField) compileMovingAverage(args []influxql.Expr) error {
 	}
 }
 
+func (c *compiledField) compileOutliers(name string, args []influxql.Expr) error {
+	exp := 2
+	if name == "mad_outliers" {
+		exp = 3
+	}
+	if got := len(args); got != exp {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, exp, got)
+	}
+
+	switch arg1 := args[1].(type) {
+	case *influxql.IntegerLiteral:
+		if arg1.Val <= 1 {
+			return fmt.Errorf("%s window must be greater than 1, got %d", name, arg1.Val)
+		}
+	default:
+		return fmt.Errorf("second argument for %s must be an integer, got %T", name, args[1])
+	}
+
+	// The number of deviations from the median that makes a point an outlier.
+	if len(args) == 3 {
+		var k float64
+		switch arg2 := args[2].(type) {
+		case *influxql.NumberLiteral:
+			k = arg2.Val
+		case *influxql.IntegerLiteral:
+			k = float64(arg2.Val)
+		default:
+			return fmt.Errorf("third argument for %s must be a number, got %T", name, args[2])
+		}
+		if k <= 0 {
+			return fmt.Errorf("third argument for %s must be positive, got %v", name, k)
+		}
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, function, wildcard, or regexp.
+	switch arg0 := args[0].(type) {
+	case *influxql.Call:
+		if c.global.Interval.IsZero() {
+			return fmt.Errorf("%s aggregate requires a GROUP BY interval", name)
+		}
+		return c.compileExpr(arg0)
+	default:
+		if !c.global.Interval.IsZero() {
+			return fmt.Errorf("aggregate function required inside the call to %s", name)
+		}
+		return c.compileSymbol(name, arg0)
+	}
+}
+
 func (c *compiledField) compileIntegral(args []influxql.Expr) error {
 	if min, max, got := 1, 2, len(args); got > max || got < min {
 		return fmt.Errorf("invalid number of arguments for integral, expected at least %d but no more than %d, got %d", min, max, got)
@@ -551,12 +693,269 @@ func (c *compiledField) compileIntegral(args []influxql.Expr) error {
 			return errors.New("second argument must be a duration")
 		}
 	}
+	if c.global.Interval.Sliding() {
+		return errors.New("integral does not support a GROUP BY step")
+	}
 	c.global.OnlySelectors = false
 
 	// Must be a variable reference, wildcard, or regexp.
 	return c.compileSymbol("integral", args[0])
 }
 
+func (c *compiledField) compileInterpolate(args []influxql.Expr) error {
+	if len(args) == 2 {
+		return errors.New("interpolate requires a sample interval, set with SAMPLE EVERY")
+	} else if min, max, got := 3, 4, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for interpolate, expected at least %d but no more than %d, got %d", min, max, got)
+	}
+
+	switch arg1 := args[1].(type) {
+	case *influxql.StringLiteral:
+		if arg1.Val != "linear" && arg1.Val != "previous" {
+			return fmt.Errorf("interpolate method must be 'linear' or 'previous', got '%s'", arg1.Val)
+		}
+	default:
+		return fmt.Errorf("second argument to interpolate must be a string, got %T", args[1])
+	}
+
+	// The sample interval and the optional maximum gap between points.
+	for i, name := range []string{"third", "fourth"} {
+		if len(args) <= i+2 {
+			break
+		}
+		switch arg := args[i+2].(type) {
+		case *influxql.DurationLiteral:
+			if arg.Val <= 0 {
+				return fmt.Errorf("duration argument must be positive, got %s", influxql.FormatDuration(arg.Val))
+			}
+		default:
+			return fmt.Errorf("%s argument to interpolate must be a duration, got %T", name, args[i+2])
+		}
+	}
+
+	if !c.global.Interval.IsZero() {
+		return errors.New("interpolate does not support a GROUP BY interval")
+	}
+	if step := args[2].(*influxql.DurationLiteral).Val; c.global.SampleInterval == 0 || step < c.global.SampleInterval {
+		c.global.SampleInterval = step
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol("interpolate", args[0])
+}
+
+func (c *compiledField) compileTimeWeightedAvg(args []influxql.Expr) error {
+	if min, max, got := 1, 2, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for time_weighted_avg, expected at least %d but no more than %d, got %d", min, max, got)
+	}
+
+	if len(args) == 2 {
+		switch arg1 := args[1].(type) {
+		case *influxql.StringLiteral:
+			if arg1.Val != "linear" && arg1.Val != "locf" {
+				return fmt.Errorf("time_weighted_avg method must be 'linear' or 'locf', got '%s'", arg1.Val)
+			}
+		default:
+			return fmt.Errorf("second argument to time_weighted_avg must be a string, got %T", args[1])
+		}
+	}
+	if c.global.Interval.Sliding() {
+		return errors.New("time_weighted_avg does not support a GROUP BY step")
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol("time_weighted_avg", args[0])
+}
+
+func (c *compiledField) compileState(name string, args []influxql.Expr) error {
+	if name == "state_count" {
+		if exp, got := 2, len(args); got != exp {
+			return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, exp, got)
+		}
+	} else if min, max, got := 2, 3, len(args); got > max || got < min {
+		return fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", name, min, max, got)
+	}
+
+	switch args[1].(type) {
+	case *influxql.NumberLiteral, *influxql.IntegerLiteral, *influxql.UnsignedLiteral,
+		*influxql.StringLiteral, *influxql.BooleanLiteral:
+	default:
+		return fmt.Errorf("second argument to %s must be a literal, got %T", name, args[1])
+	}
+
+	if len(args) == 3 {
+		switch arg2 := args[2].(type) {
+		case *influxql.DurationLiteral:
+			if arg2.Val <= 0 {
+				return fmt.Errorf("duration argument must be positive, got %s", influxql.FormatDuration(arg2.Val))
+			}
+		default:
+			return fmt.Errorf("third argument to %s must be a duration, got %T", name, args[2])
+		}
+	}
+	if c.global.Interval.Sliding() {
+		return fmt.Errorf("%s does not support a GROUP BY step", name)
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, wildcard, or regexp.
+	return c.compileSymbol(name, args[0])
+}
+
+func (c *compiledField) compileHistogram(call *influxql.Call) error {
+	// Each bucket of a histogram is returned as a separate column, so
+	// it must be a field of its own rather than part of an expression.
+	if c.Field.Expr != call {
+		return errors.New("histogram() cannot be used within an expression")
+	}
+
+	if _, err := histogramBounds(call.Args); err != nil {
+		return err
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference.
+	c.AllowWildcard = false
+	return c.compileSymbol("histogram", call.Args[0])
+}
+
+// histogramBounds returns the bucket boundaries of a histogram() call. The
+// buckets are either given as count buckets of a width from a start value,
+// histogram(field, start, width, count), or as a list of boundaries,
+// histogram(field, bounds(0, 10, 100)).
+func histogramBounds(args []influxql.Expr) ([]float64, error) {
+	number := func(expr influxql.Expr) (float64, bool) {
+		switch expr := expr.(type) {
+		case *influxql.NumberLiteral:
+			return expr.Val, true
+		case *influxql.IntegerLiteral:
+			return float64(expr.Val), true
+		}
+		return 0, false
+	}
+
+	var bounds []float64
+	switch len(args) {
+	case 2:
+		list, ok := args[1].(*influxql.Call)
+		if !ok || list.Name != "bounds" {
+			return nil, fmt.Errorf("expected bounds() list of bucket boundaries as second argument in histogram(), got %s", args[1])
+		}
+		for _, arg := range list.Args {
+			bound, ok := number(arg)
+			if !ok {
+				return nil, fmt.Errorf("expected number argument as bucket boundary in histogram(), got %s", arg)
+			} else if len(bounds) > 0 && bound <= bounds[len(bounds)-1] {
+				return nil, errors.New("bucket boundaries in histogram() must be increasing")
+			}
+			bounds = append(bounds, bound)
+		}
+		if len(bounds) < 2 {
+			return nil, errors.New("histogram() requires at least 2 bucket boundaries")
+		}
+	case 4:
+		start, ok := number(args[1])
+		if !ok {
+			return nil, fmt.Errorf("expected number argument as start in histogram(), got %T", args[1])
+		}
+		width, ok := number(args[2])
+		if !ok {
+			return nil, fmt.Errorf("expected number argument as width in histogram(), got %T", args[2])
+		} else if width <= 0 {
+			return nil, fmt.Errorf("width in histogram() must be positive, got %s", args[2])
+		}
+		count, ok := args[3].(*influxql.IntegerLiteral)
+		if !ok {
+			return nil, fmt.Errorf("expected integer argument as count in histogram(), got %T", args[3])
+		} else if count.Val <= 0 {
+			return nil, fmt.Errorf("count in histogram() must be at least 1, got %d", count.Val)
+		}
+		for i := int64(0); i <= count.Val; i++ {
+			bounds = append(bounds, start+float64(i)*width)
+		}
+	default:
+		return nil, fmt.Errorf("invalid number of arguments for histogram, expected 2 or 4, got %d", len(args))
+	}
+	return bounds, nil
+}
+
+// histogramCall returns the call counting every bucket of a histogram with
+// the bounds, histogram(field, bounds(b0, ..., bN)). It is the call that is
+// pushed down to the shards.
+func histogramCall(arg influxql.Expr, bounds []float64) *influxql.Call {
+	list := &influxql.Call{Name: "bounds", Args: make([]influxql.Expr, len(bounds))}
+	for i, bound := range bounds {
+		list.Args[i] = &influxql.NumberLiteral{Val: bound}
+	}
+	return &influxql.Call{Name: "histogram", Args: []influxql.Expr{arg, list}}
+}
+
+// histogramBucket returns the histogram call and the bucket index of a
+// field rewritten by rewriteHistogramFields.
+func histogramBucket(expr influxql.Expr) (*influxql.Call, int, bool) {
+	call, ok := expr.(*influxql.Call)
+	if !ok || call.Name != "histogram" || len(call.Args) != 3 {
+		return nil, 0, false
+	}
+	index, ok := call.Args[2].(*influxql.IntegerLiteral)
+	if !ok {
+		return nil, 0, false
+	}
+	return &influxql.Call{Name: call.Name, Args: call.Args[:2]}, int(index.Val), true
+}
+
+// rewriteHistograms replaces each histogram() field of the statement and its
+// subqueries with one field per bucket.
+func rewriteHistograms(stmt *influxql.SelectStatement) {
+	stmt.Fields = rewriteHistogramFields(stmt.Fields)
+	for _, source := range stmt.Sources {
+		if source, ok := source.(*influxql.SubQuery); ok {
+			rewriteHistograms(source.Statement)
+		}
+	}
+}
+
+// rewriteHistogramFields replaces each histogram() field with one field per
+// bucket, named after the lower boundary of the bucket. Each bucket field is
+// an internal histogram(field, bounds(b0, ..., bN), index) call. The buckets
+// of a histogram share a single iterator counting all of them, so the points
+// are only read once.
+func rewriteHistogramFields(fields influxql.Fields) influxql.Fields {
+	other := make(influxql.Fields, 0, len(fields))
+	for _, f := range fields {
+		call, ok := f.Expr.(*influxql.Call)
+		if !ok || call.Name != "histogram" {
+			other = append(other, f)
+			continue
+		}
+
+		// Invalid calls have already been rejected when compiling the statement.
+		bounds, err := histogramBounds(call.Args)
+		if err != nil {
+			other = append(other, f)
+			continue
+		}
+
+		name := f.Alias
+		if name == "" {
+			name = call.Name
+		}
+		hist := histogramCall(call.Args[0], bounds)
+		for i := 0; i < len(bounds)-1; i++ {
+			other = append(other, &influxql.Field{
+				Expr: &influxql.Call{
+					Name: hist.Name,
+					Args: append(hist.Args[:2:2], &influxql.IntegerLiteral{Val: int64(i)}),
+				},
+				Alias: name + "_" + strconv.FormatFloat(bounds[i], 'f', -1, 64),
+			})
+		}
+	}
+	return other
+}
+
 func (c *compiledField) compileHoltWinters(args []influxql.Expr, withFit bool) error {
 	name := "holt_winters"
 	if withFit {
@@ -591,6 +990,57 @@ func (c *compiledField) compileHoltWinters(args []influxql.Expr, withFit bool) e
 	return c.compileExpr(call)
 }
 
+func (c *compiledField) compileSeasonalDecomposition(name string, args []influxql.Expr) error {
+	if exp, got := 2, len(args); got != exp {
+		return fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", name, exp, got)
+	}
+
+	period, ok := args[1].(*influxql.IntegerLiteral)
+	if !ok {
+		return fmt.Errorf("expected integer argument as second arg in %s", name)
+	} else if period.Val <= 1 {
+		return fmt.Errorf("second arg to %s must be greater than 1, got %d", name, period.Val)
+	}
+	c.global.OnlySelectors = false
+
+	// The decomposition requires evenly spaced points.
+	call, ok := args[0].(*influxql.Call)
+	if !ok {
+		return fmt.Errorf("must use aggregate function with %s", name)
+	} else if c.global.Interval.IsZero() {
+		return fmt.Errorf("%s aggregate requires a GROUP BY interval", name)
+	}
+	return c.compileExpr(call)
+}
+
+func (c *compiledField) compileForecastLinear(args []influxql.Expr) error {
+	if exp, got := 2, len(args); got != exp {
+		return fmt.Errorf("invalid number of arguments for forecast_linear, expected %d, got %d", exp, got)
+	}
+
+	n, ok := args[1].(*influxql.IntegerLiteral)
+	if !ok {
+		return errors.New("expected integer argument as second arg in forecast_linear")
+	} else if n.Val <= 0 {
+		return fmt.Errorf("second arg to forecast_linear must be greater than 0, got %d", n.Val)
+	}
+	c.global.OnlySelectors = false
+
+	// Must be a variable reference, function, wildcard, or regexp.
+	switch arg0 := args[0].(type) {
+	case *influxql.Call:
+		if c.global.Interval.IsZero() {
+			return errors.New("forecast_linear aggregate requires a GROUP BY interval")
+		}
+		return c.compileExpr(arg0)
+	default:
+		if !c.global.Interval.IsZero() {
+			return errors.New("aggregate function required inside the call to forecast_linear")
+		}
+		return c.compileSymbol("forecast_linear", arg0)
+	}
+}
+
 func (c *compiledField) compileDistinct(args []influxql.Expr, nested bool) error {
 	if len(args) == 0 {
 		return errors.New("distinct function requires at least one argument")
@@ -662,19 +1112,36 @@ func (c *compiledStatement) compileDimensions(stmt *influxql.SelectStatement) er
 				return errors.New("time() is a function and expects at least one argument")
 			}
 		case *influxql.Call:
-			// Ensure the call is time() and it has one or two duration arguments.
+			// Ensure the call is time() and it has between one and three arguments.
 			// If we already have a duration
 			if expr.Name != "time" {
 				return errors.New("only time() calls allowed in dimensions")
-			} else if got := len(expr.Args); got < 1 || got > 2 {
-				return errors.New("time dimension expected 1 or 2 arguments")
+			} else if got := len(expr.Args); got < 1 || got > 3 {
+				return errors.New("time dimension expected 1 to 3 arguments")
 			} else if lit, ok := expr.Args[0].(*influxql.DurationLiteral); !ok {
 				return errors.New("time dimension must have duration argument")
 			} else if c.Interval.Duration != 0 {
 				return errors.New("multiple time dimensions not allowed")
 			} else {
 				c.Interval.Duration = lit.Val
-				if len(expr.Args) == 2 {
+				if len(expr.Args) == 3 {
+					// A step makes consecutive windows start a step apart instead
+					// of a full interval apart so the windows overlap.
+					offset, ok := expr.Args[1].(*influxql.DurationLiteral)
+					if !ok {
+						return errors.New("time dimension offset must be a duration when a step is used")
+					}
+					step, ok := expr.Args[2].(*influxql.DurationLiteral)
+					if !ok {
+						return errors.New("time dimension step must be a duration")
+					} else if step.Val <= 0 || step.Val > c.Interval.Duration {
+						return fmt.Errorf("time dimension step must be positive and no greater than the interval, got %s", influxql.FormatDuration(step.Val))
+					} else if c.Interval.Duration%step.Val != 0 {
+						return errors.New("time dimension interval must be a multiple of the step")
+					}
+					c.Interval.Offset = offset.Val % step.Val
+					c.Interval.Step = step.Val
+				} else if len(expr.Args) == 2 {
 					switch lit := expr.Args[1].(type) {
 					case *influxql.DurationLiteral:
 						c.Interval.Offset = lit.Val % c.Interval.Duration
@@ -795,37 +1262,41 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 	// the select statement. Determine the shard time range here.
 	timeRange := c.TimeRange
 	if sopt.MaxBucketsN > 0 && !c.stmt.IsRawQuery && timeRange.MinTimeNano() == influxql.MinTime {
-		interval, err := c.stmt.GroupByInterval()
-		if err != nil {
-			return nil, err
-		}
-
-		offset, err := c.stmt.GroupByOffset()
+		interval, err := GroupByInterval(c.stmt)
 		if err != nil {
 			return nil, err
 		}
 
-		if interval > 0 {
+		if interval.Duration > 0 {
 			// Determine the last bucket using the end time.
-			opt := IteratorOptions{
-				Interval: Interval{
-					Duration: interval,
-					Offset:   offset,
-				},
-			}
+			opt := IteratorOptions{Interval: interval}
 			last, _ := opt.Window(c.TimeRange.MaxTimeNano() - 1)
+			stride := int64(opt.Interval.Stride())
 
 			// Determine the time difference using the number of buckets.
 			// Determine the maximum difference between the buckets based on the end time.
 			maxDiff := last - models.MinNanoTime
-			if maxDiff/int64(interval) > int64(sopt.MaxBucketsN) {
+			if maxDiff/stride > int64(sopt.MaxBucketsN) {
 				timeRange.Min = time.Unix(0, models.MinNanoTime)
 			} else {
-				timeRange.Min = time.Unix(0, last-int64(interval)*int64(sopt.MaxBucketsN-1))
+				timeRange.Min = time.Unix(0, last-stride*int64(sopt.MaxBucketsN-1))
 			}
 		}
 	}
 
+	// An interpolation emits a sample at every step of the time range, so the
+	// number of samples is limited like the number of buckets.
+	if sopt.MaxBucketsN > 0 && c.SampleInterval > 0 {
+		if c.TimeRange.MinTimeNano() == influxql.MinTime || c.TimeRange.MaxTimeNano() == influxql.MaxTime {
+			return nil, errors.New("interpolate requires a time range in the WHERE clause when max-select-buckets is set")
+		}
+		step := int64(c.SampleInterval)
+		samples := (c.TimeRange.MaxTimeNano()-c.TimeRange.MinTimeNano())/step + 1
+		if samples > int64(sopt.MaxBucketsN) {
+			return nil, fmt.Errorf("max-select-buckets limit exceeded: (%d/%d)", samples, sopt.MaxBucketsN)
+		}
+	}
+
 	// Create an iterator creator based on the shards in the cluster.
 	shards, err := shardMapper.MapShards(c.stmt.Sources, timeRange, sopt)
 	if err != nil {
@@ -849,19 +1320,20 @@ func (c *compiledStatement) Prepare(shardMapper ShardMapper, sopt SelectOptions)
 	opt.Ascending = c.Ascending
 
 	if sopt.MaxBucketsN > 0 && !stmt.IsRawQuery && c.TimeRange.MinTimeNano() > influxql.MinTime {
-		interval, err := stmt.GroupByInterval()
+		interval, err := GroupByInterval(stmt)
 		if err != nil {
 			shards.Close()
 			return nil, err
 		}
 
-		if interval > 0 {
+		if interval.Duration > 0 {
 			// Determine the start and end time matched to the interval (may not match the actual times).
 			first, _ := opt.Window(opt.StartTime)
 			last, _ := opt.Window(opt.EndTime - 1)
 
-			// Determine the number of buckets by finding the time span and dividing by the interval.
-			buckets := (last - first + int64(interval)) / int64(interval)
+			// Determine the number of buckets by finding the time span and dividing by the stride.
+			stride := int64(opt.Interval.Stride())
+			buckets := (last - first + stride) / stride
 			if int(buckets) > sopt.MaxBucketsN {
 				shards.Close()
 				return nil, fmt.Errorf("max-select-buckets limit exceeded: (%d/%d)", buckets, sopt.MaxBucketsN)
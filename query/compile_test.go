@@ -72,7 +72,26 @@ func TestCompile_Success(t *testing.T) {
 		`SELECT elapsed(value, 10s) FROM cpu`,
 		`SELECT integral(value) FROM cpu`,
 		`SELECT integral(value, 10s) FROM cpu`,
+		`SELECT time_weighted_avg(value) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
+		`SELECT time_weighted_avg(value, 'locf') FROM cpu`,
+		`SELECT state_duration(status, 'down', 1s) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
+		`SELECT state_count(value, 1) FROM cpu`,
+		`SELECT histogram(value, 0, 10, 5) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
+		`SELECT histogram(value, bounds(0.5, 1, 5, 10)) AS latency FROM cpu`,
+		`SELECT interpolate(value, 'linear', 10s) FROM cpu`,
+		`SELECT interpolate(value, 'previous', 10s, 1m) FROM cpu GROUP BY host`,
+		`SELECT interpolate(value, 'previous', 1m) FROM cpu WHERE time > now() - 1h SAMPLE EVERY 10s`,
+		`SELECT rate(value) FROM cpu`,
+		`SELECT rate(value, 1m) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
+		`SELECT rate(max(value), 1s) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
+		`SELECT increase(value) FROM cpu WHERE time >= now() - 1h GROUP BY time(10m)`,
 		`SELECT max(value) FROM cpu WHERE time >= now() - 1m GROUP BY time(10s, 5s)`,
+		`SELECT max(value) FROM cpu WHERE time >= now() - 1m GROUP BY time(10s, 0s, 5s)`,
+		`SELECT zscore(value, 10) FROM cpu`,
+		`SELECT mad_outliers(mean(value), 10, 3.5) FROM cpu WHERE time >= now() - 1h GROUP BY time(1m)`,
+		`SELECT decompose_trend(mean(value), 24) FROM cpu WHERE time >= now() - 7d GROUP BY time(1h)`,
+		`SELECT forecast_linear(value, 5) FROM cpu`,
+		`SELECT forecast_linear(max(value), 5) FROM cpu WHERE time >= now() - 1h GROUP BY time(1m)`,
 		`SELECT max(value) FROM cpu WHERE time >= now() - 1m GROUP BY time(10s, '2000-01-01T00:00:05Z')`,
 		`SELECT max(value) FROM cpu WHERE time >= now() - 1m GROUP BY time(10s, now())`,
 		`SELECT max(mean) FROM (SELECT mean(value) FROM cpu GROUP BY host)`,
@@ -81,6 +100,12 @@ func TestCompile_Success(t *testing.T) {
 		`SELECT value FROM cpu WHERE time >= '2000-01-01T00:00:00Z' AND time <= '2000-01-01T01:00:00Z'`,
 		`SELECT value FROM (SELECT value FROM cpu) ORDER BY time DESC`,
 		`SELECT count(distinct(value)), max(value) FROM cpu`,
+		`SELECT abs(value), round(value, 2), lower(host) FROM cpu`,
+		`SELECT round(mean(value), 2) FROM cpu WHERE time >= now() - 1m GROUP BY time(10s)`,
+		`SELECT sqrt(max(value)), host FROM cpu`,
+		`SELECT pow(value, 2) + ln(total) FROM cpu`,
+		`SELECT log(value, 2), substr(host, 1, 3), strlen(host) FROM cpu`,
+		`SELECT floor(value) FROM (SELECT value FROM cpu)`,
 	} {
 		t.Run(tt, func(t *testing.T) {
 			stmt, err := influxql.ParseStatement(tt)
@@ -126,8 +151,12 @@ func TestCompile_Failures(t *testing.T) {
 		{s: `SELECT count(distinct(value, host)) FROM cpu`, err: `distinct function can only have one argument`},
 		{s: `SELECT count(distinct(2)) FROM cpu`, err: `expected field argument in distinct()`},
 		{s: `SELECT value FROM cpu GROUP BY now()`, err: `only time() calls allowed in dimensions`},
-		{s: `SELECT value FROM cpu GROUP BY time()`, err: `time dimension expected 1 or 2 arguments`},
-		{s: `SELECT value FROM cpu GROUP BY time(5m, 30s, 1ms)`, err: `time dimension expected 1 or 2 arguments`},
+		{s: `SELECT value FROM cpu GROUP BY time()`, err: `time dimension expected 1 to 3 arguments`},
+		{s: `SELECT value FROM cpu GROUP BY time(5m, 30s, 1ms, 1ms)`, err: `time dimension expected 1 to 3 arguments`},
+		{s: `SELECT mean(value) FROM cpu GROUP BY time(5m, now(), 30s)`, err: `time dimension offset must be a duration when a step is used`},
+		{s: `SELECT mean(value) FROM cpu GROUP BY time(5m, 0s, 10m)`, err: `time dimension step must be positive and no greater than the interval, got 10m`},
+		{s: `SELECT mean(value) FROM cpu GROUP BY time(5m, 0s, 2m)`, err: `time dimension interval must be a multiple of the step`},
+		{s: `SELECT integral(value) FROM cpu GROUP BY time(5m, 0s, 30s)`, err: `integral does not support a GROUP BY step`},
 		{s: `SELECT value FROM cpu GROUP BY time('unexpected')`, err: `time dimension must have duration argument`},
 		{s: `SELECT value FROM cpu GROUP BY time(5m), time(1m)`, err: `multiple time dimensions not allowed`},
 		{s: `SELECT value FROM cpu GROUP BY time(5m, unexpected())`, err: `time dimension offset function must be now()`},
@@ -154,6 +183,14 @@ func TestCompile_Failures(t *testing.T) {
 		{s: `SELECT count(value), /ho/ FROM cpu`, err: `mixing aggregate and non-aggregate queries is not supported`},
 		{s: `SELECT max(/val/), * FROM cpu`, err: `mixing aggregate and non-aggregate queries is not supported`},
 		{s: `SELECT a(value) FROM cpu`, err: `undefined function a()`},
+		{s: `SELECT abs() FROM cpu`, err: `invalid number of arguments for abs, expected 1, got 0`},
+		{s: `SELECT round(value, 1, 2) FROM cpu`, err: `invalid number of arguments for round, expected at least 1 but no more than 2, got 3`},
+		{s: `SELECT round(value, 1.5) FROM cpu`, err: `expected integer argument as argument 2 in round()`},
+		{s: `SELECT pow(value, 'a') FROM cpu`, err: `expected number argument as argument 2 in pow()`},
+		{s: `SELECT abs(*) FROM cpu`, err: `unsupported expression with wildcard: abs()`},
+		{s: `SELECT abs(2) FROM cpu`, err: `expected field argument in abs()`},
+		{s: `SELECT abs(value), mean(value) FROM cpu`, err: `mixing aggregate and non-aggregate queries is not supported`},
+		{s: `SELECT round(value) FROM cpu GROUP BY time(1m)`, err: `GROUP BY requires at least one aggregate function`},
 		{s: `SELECT count(max(value)) FROM myseries`, err: `expected field argument in count()`},
 		{s: `SELECT count(distinct('value')) FROM myseries`, err: `expected field argument in distinct()`},
 		{s: `SELECT distinct('value') FROM myseries`, err: `expected field argument in distinct()`},
@@ -200,7 +237,7 @@ func TestCompile_Failures(t *testing.T) {
 		{s: `SELECT count(value), value FROM foo`, err: `mixing aggregate and non-aggregate queries is not supported`},
 		{s: `SELECT count(value) FROM foo group by time`, err: `time() is a function and expects at least one argument`},
 		{s: `SELECT count(value) FROM foo group by 'time'`, err: `only time and tag dimensions allowed`},
-		{s: `SELECT count(value) FROM foo where time > now() and time < now() group by time()`, err: `time dimension expected 1 or 2 arguments`},
+		{s: `SELECT count(value) FROM foo where time > now() and time < now() group by time()`, err: `time dimension expected 1 to 3 arguments`},
 		{s: `SELECT count(value) FROM foo where time > now() and time < now() group by time(b)`, err: `time dimension must have duration argument`},
 		{s: `SELECT count(value) FROM foo where time > now() and time < now() group by time(1s), time(2s)`, err: `multiple time dimensions not allowed`},
 		{s: `SELECT count(value) FROM foo where time > now() and time < now() group by time(1s, b)`, err: `time dimension offset must be duration or now()`},
@@ -288,6 +325,35 @@ func TestCompile_Failures(t *testing.T) {
 		{s: `SELECT integral(value, 10s, host) FROM myseries`, err: `invalid number of arguments for integral, expected at least 1 but no more than 2, got 3`},
 		{s: `SELECT integral(value, -10s) FROM myseries`, err: `duration argument must be positive, got -10s`},
 		{s: `SELECT integral(value, 10) FROM myseries`, err: `second argument must be a duration`},
+		{s: `SELECT histogram(value, 0, 10) FROM myseries`, err: `invalid number of arguments for histogram, expected 2 or 4, got 3`},
+		{s: `SELECT histogram(value, 0, 0, 5) FROM myseries`, err: `width in histogram() must be positive, got 0`},
+		{s: `SELECT histogram(value, 0, 10, 0) FROM myseries`, err: `count in histogram() must be at least 1, got 0`},
+		{s: `SELECT histogram(value, 0, 10, 1.5) FROM myseries`, err: `expected integer argument as count in histogram(), got *influxql.NumberLiteral`},
+		{s: `SELECT histogram(value, bounds(10)) FROM myseries`, err: `histogram() requires at least 2 bucket boundaries`},
+		{s: `SELECT histogram(value, bounds(10, 5)) FROM myseries`, err: `bucket boundaries in histogram() must be increasing`},
+		{s: `SELECT histogram(value, bounds(10, 'x')) FROM myseries`, err: `expected number argument as bucket boundary in histogram(), got 'x'`},
+		{s: `SELECT histogram(value, '0,10') FROM myseries`, err: `expected bounds() list of bucket boundaries as second argument in histogram(), got '0,10'`},
+		{s: `SELECT histogram(*, 0, 10, 5) FROM myseries`, err: `unsupported expression with wildcard: histogram()`},
+		{s: `SELECT histogram(value, 0, 10, 5) + 1 FROM myseries`, err: `histogram() cannot be used within an expression`},
+		{s: `SELECT interpolate(value, 'linear') FROM myseries`, err: `interpolate requires a sample interval, set with SAMPLE EVERY`},
+		{s: `SELECT interpolate(value, 'linear', 1m, 1m) FROM myseries SAMPLE EVERY 10s`, err: `invalid number of arguments for interpolate, expected at least 3 but no more than 4, got 5`},
+		{s: `SELECT interpolate(value, 'spline', 10s) FROM myseries`, err: `interpolate method must be 'linear' or 'previous', got 'spline'`},
+		{s: `SELECT interpolate(value, 'linear', 10) FROM myseries`, err: `third argument to interpolate must be a duration, got *influxql.IntegerLiteral`},
+		{s: `SELECT interpolate(value, 'linear', 10s, 0s) FROM myseries`, err: `duration argument must be positive, got 0s`},
+		{s: `SELECT interpolate(value, 'linear', 10s) FROM myseries WHERE time > now() - 1h GROUP BY time(1m)`, err: `interpolate does not support a GROUP BY interval`},
+		{s: `SELECT rate() FROM myseries`, err: `invalid number of arguments for rate, expected at least 1 but no more than 2, got 0`},
+		{s: `SELECT rate(value, 10) FROM myseries`, err: `second argument to rate must be a duration, got *influxql.IntegerLiteral`},
+		{s: `SELECT rate(value, -1s) FROM myseries`, err: `duration argument must be positive, got -1s`},
+		{s: `SELECT rate(max(value)) FROM myseries`, err: `rate aggregate requires a GROUP BY interval`},
+		{s: `SELECT increase(value, 1s) FROM myseries`, err: `invalid number of arguments for increase, expected 1, got 2`},
+		{s: `SELECT time_weighted_avg() FROM myseries`, err: `invalid number of arguments for time_weighted_avg, expected at least 1 but no more than 2, got 0`},
+		{s: `SELECT time_weighted_avg(value, 'step') FROM myseries`, err: `time_weighted_avg method must be 'linear' or 'locf', got 'step'`},
+		{s: `SELECT time_weighted_avg(value, 10s) FROM myseries`, err: `second argument to time_weighted_avg must be a string, got *influxql.DurationLiteral`},
+		{s: `SELECT state_duration(value) FROM myseries`, err: `invalid number of arguments for state_duration, expected at least 2 but no more than 3, got 1`},
+		{s: `SELECT state_duration(value, 1, 0s) FROM myseries`, err: `duration argument must be positive, got 0s`},
+		{s: `SELECT state_duration(value, 1, 10) FROM myseries`, err: `third argument to state_duration must be a duration, got *influxql.IntegerLiteral`},
+		{s: `SELECT state_count(value, 1, 1s) FROM myseries`, err: `invalid number of arguments for state_count, expected 2, got 3`},
+		{s: `SELECT state_count(value, host) FROM myseries`, err: `second argument to state_count must be a literal, got *influxql.VarRef`},
 		{s: `SELECT holt_winters(value) FROM myseries where time < now() and time > now() - 1d`, err: `invalid number of arguments for holt_winters, expected 3, got 1`},
 		{s: `SELECT holt_winters(value, 10, 2) FROM myseries where time < now() and time > now() - 1d`, err: `must use aggregate function with holt_winters`},
 		{s: `SELECT holt_winters(min(value), 10, 2) FROM myseries where time < now() and time > now() - 1d`, err: `holt_winters aggregate requires a GROUP BY interval`},
@@ -298,6 +364,16 @@ func TestCompile_Failures(t *testing.T) {
 		{s: `SELECT holt_winters_with_fit(value) FROM myseries where time < now() and time > now() - 1d`, err: `invalid number of arguments for holt_winters_with_fit, expected 3, got 1`},
 		{s: `SELECT holt_winters_with_fit(value, 10, 2) FROM myseries where time < now() and time > now() - 1d`, err: `must use aggregate function with holt_winters_with_fit`},
 		{s: `SELECT holt_winters_with_fit(min(value), 10, 2) FROM myseries where time < now() and time > now() - 1d`, err: `holt_winters_with_fit aggregate requires a GROUP BY interval`},
+		{s: `SELECT zscore(value) FROM cpu`, err: `invalid number of arguments for zscore, expected 2, got 1`},
+		{s: `SELECT zscore(value, 1) FROM cpu`, err: `zscore window must be greater than 1, got 1`},
+		{s: `SELECT zscore(value, 10) FROM cpu WHERE time >= now() - 1h GROUP BY time(1m)`, err: `aggregate function required inside the call to zscore`},
+		{s: `SELECT mad_outliers(value, 10) FROM cpu`, err: `invalid number of arguments for mad_outliers, expected 3, got 2`},
+		{s: `SELECT mad_outliers(value, 10, 0) FROM cpu`, err: `third argument for mad_outliers must be positive, got 0`},
+		{s: `SELECT mad_outliers(mean(value), 10, 3) FROM cpu`, err: `mad_outliers aggregate requires a GROUP BY interval`},
+		{s: `SELECT decompose_trend(value, 24) FROM cpu`, err: `must use aggregate function with decompose_trend`},
+		{s: `SELECT decompose_residual(mean(value), 1) FROM cpu GROUP BY time(1h)`, err: `second arg to decompose_residual must be greater than 1, got 1`},
+		{s: `SELECT forecast_linear(value, 0) FROM cpu`, err: `second arg to forecast_linear must be greater than 0, got 0`},
+		{s: `SELECT forecast_linear(mean(value), 5) FROM cpu`, err: `forecast_linear aggregate requires a GROUP BY interval`},
 		{s: `SELECT holt_winters_with_fit(min(value), 0, 2) FROM myseries where time < now() and time > now() - 1d GROUP BY time(1d)`, err: `second arg to holt_winters_with_fit must be greater than 0, got 0`},
 		{s: `SELECT holt_winters_with_fit(min(value), false, 2) FROM myseries where time < now() and time > now() - 1d GROUP BY time(1d)`, err: `expected integer argument as second arg in holt_winters_with_fit`},
 		{s: `SELECT holt_winters_with_fit(min(value), 10, 'string') FROM myseries where time < now() and time > now() - 1d GROUP BY time(1d)`, err: `expected integer argument as third arg in holt_winters_with_fit`},
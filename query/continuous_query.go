@@ -0,0 +1,150 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influxdata/influxql"
+)
+
+func init() {
+	// influxql validates the source of CREATE CONTINUOUS QUERY with a time
+	// dimension of at most 2 arguments, so the statement is parsed here to
+	// accept sliding windows, time(interval, offset, step).
+	influxql.Language.Group(influxql.CREATE, influxql.CONTINUOUS).Handlers[influxql.QUERY] = parseCreateContinuousQueryStatement
+}
+
+// parseCreateContinuousQueryStatement parses a CREATE CONTINUOUS QUERY
+// statement following the CREATE CONTINUOUS QUERY tokens:
+//
+//	CREATE CONTINUOUS QUERY name ON db [RESAMPLE [EVERY d] [FOR d]] BEGIN SELECT ... INTO ... END
+func parseCreateContinuousQueryStatement(p *influxql.Parser) (influxql.Statement, error) {
+	stmt := &influxql.CreateContinuousQueryStatement{}
+
+	// Read the id of the query to create.
+	ident, err := p.ParseIdent()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Name = ident
+
+	// Expect an "ON" keyword.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != influxql.ON {
+		return nil, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{"ON"}, Pos: pos}
+	}
+
+	// Read the name of the database to create the query on.
+	if ident, err = p.ParseIdent(); err != nil {
+		return nil, err
+	}
+	stmt.Database = ident
+
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == influxql.RESAMPLE {
+		stmt.ResampleEvery, stmt.ResampleFor, err = parseResample(p)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	// Expect a "BEGIN SELECT" tokens.
+	for _, exp := range []influxql.Token{influxql.BEGIN, influxql.SELECT} {
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != exp {
+			return nil, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{exp.String()}, Pos: pos}
+		}
+	}
+
+	// Read the select statement to be used as the source.
+	_, pos, _ := p.ScanIgnoreWhitespace()
+	p.Unscan()
+	source, err := influxql.Language.Handlers[influxql.SELECT](p)
+	if err != nil {
+		return nil, err
+	}
+	stmt.Source = source.(*influxql.SelectStatement)
+	if stmt.Source.Target == nil {
+		return nil, &influxql.ParseError{Message: "continuous query requires an INTO clause", Pos: pos}
+	}
+
+	// Validate that the statement has a non-zero group by interval if it is aggregated.
+	interval, err := GroupByInterval(stmt.Source)
+	if !stmt.Source.IsRawQuery && (interval.IsZero() || err != nil) {
+		// Rewind so we can output an error with some info.
+		p.Unscan() // Unscan the whitespace
+		p.Unscan() // Unscan the last token
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		expected := []string{"GROUP BY time(...)"}
+		if err != nil {
+			expected = append(expected, err.Error())
+		}
+		return nil, &influxql.ParseError{Found: tokstr(tok, lit), Expected: expected, Pos: pos}
+	}
+
+	// Expect a "END" keyword.
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != influxql.END {
+		return nil, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{"END"}, Pos: pos}
+	}
+
+	// The query runs every step of sliding windows, so the FOR duration
+	// must cover at least a step.
+	if stmt.ResampleFor != 0 {
+		every := interval.Stride()
+		if stmt.ResampleEvery != 0 && stmt.ResampleEvery > every {
+			every = stmt.ResampleEvery
+		}
+		if every > stmt.ResampleFor {
+			return nil, fmt.Errorf("FOR duration must be >= GROUP BY time duration: must be a minimum of %s, got %s", influxql.FormatDuration(every), influxql.FormatDuration(stmt.ResampleFor))
+		}
+	}
+	return stmt, nil
+}
+
+// parseResample parses the EVERY and FOR durations following RESAMPLE.
+func parseResample(p *influxql.Parser) (every, max time.Duration, err error) {
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == influxql.EVERY {
+		if every, err = parseResampleDuration(p); err != nil {
+			return 0, 0, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == influxql.FOR {
+		if max, err = parseResampleDuration(p); err != nil {
+			return 0, 0, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	// Neither EVERY or FOR were read, so read the next token again
+	// so we can return a suitable error message.
+	if every == 0 && max == 0 {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		return 0, 0, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{"EVERY", "FOR"}, Pos: pos}
+	}
+	return every, max, nil
+}
+
+// parseResampleDuration parses the duration following EVERY or FOR.
+func parseResampleDuration(p *influxql.Parser) (time.Duration, error) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != influxql.DURATIONVAL {
+		return 0, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{"duration"}, Pos: pos}
+	}
+
+	d, err := influxql.ParseDuration(lit)
+	if err != nil {
+		return 0, &influxql.ParseError{Message: err.Error(), Pos: pos}
+	}
+	return d, nil
+}
+
+// tokstr returns a literal if provided, otherwise returns the token string.
+func tokstr(tok influxql.Token, lit string) string {
+	if lit != "" {
+		return lit
+	}
+	return tok.String()
+}
@@ -0,0 +1,48 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxql"
+)
+
+func TestCreateContinuousQueryStatement_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		s     string
+		every time.Duration
+		max   time.Duration
+	}{
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(value) INTO cpu_mean FROM cpu GROUP BY time(10m) END`},
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(value) INTO cpu_mean FROM cpu GROUP BY time(1h, 0s, 10m) END`},
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE EVERY 5m FOR 20m BEGIN SELECT mean(value) INTO cpu_mean FROM cpu GROUP BY time(1h, 0s, 10m) END`, every: 5 * time.Minute, max: 20 * time.Minute},
+	} {
+		stmt, err := influxql.ParseStatement(tt.s)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.s, err)
+		}
+
+		cq, ok := stmt.(*influxql.CreateContinuousQueryStatement)
+		if !ok {
+			t.Fatalf("%s: unexpected statement type: %T", tt.s, stmt)
+		} else if cq.Name != "cq0" || cq.Database != "db0" || cq.ResampleEvery != tt.every || cq.ResampleFor != tt.max {
+			t.Fatalf("%s: unexpected statement: %#v", tt.s, cq)
+		} else if got := stmt.String(); got != tt.s {
+			t.Fatalf("unexpected string:\n got: %s\n exp: %s", got, tt.s)
+		}
+	}
+
+	for _, tt := range []struct {
+		s   string
+		err string
+	}{
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(value) FROM cpu GROUP BY time(10m) END`, err: `continuous query requires an INTO clause at line 1, char 49`},
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 BEGIN SELECT mean(value) INTO cpu_mean FROM cpu END`, err: `found cpu, expected GROUP BY time(...) at line 1, char 80`},
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE FOR 5m BEGIN SELECT mean(value) INTO cpu_mean FROM cpu GROUP BY time(1h, 0s, 10m) END`, err: `FOR duration must be >= GROUP BY time duration: must be a minimum of 10m, got 5m`},
+		{s: `CREATE CONTINUOUS QUERY cq0 ON db0 RESAMPLE BEGIN SELECT mean(value) INTO cpu_mean FROM cpu GROUP BY time(10m) END`, err: `found BEGIN, expected EVERY, FOR at line 1, char 45`},
+	} {
+		if _, err := influxql.ParseStatement(tt.s); err == nil || err.Error() != tt.err {
+			t.Fatalf("%s: unexpected error: %v", tt.s, err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxql"
 )
 
 // Emitter groups values together by name, tags, and time.
@@ -97,6 +98,32 @@ func (e *Emitter) Emit() (*models.Row, bool, error) {
 	}
 }
 
+// ColumnTypes returns the type of each column of the emitted rows. A column
+// without an iterator has an unknown type.
+func (e *Emitter) ColumnTypes() []influxql.DataType {
+	types := make([]influxql.DataType, 0, len(e.itrs)+1)
+	if !e.OmitTime {
+		types = append(types, influxql.Time)
+	}
+	for _, itr := range e.itrs {
+		switch itr.(type) {
+		case FloatIterator:
+			types = append(types, influxql.Float)
+		case IntegerIterator:
+			types = append(types, influxql.Integer)
+		case UnsignedIterator:
+			types = append(types, influxql.Unsigned)
+		case StringIterator:
+			types = append(types, influxql.String)
+		case BooleanIterator:
+			types = append(types, influxql.Boolean)
+		default:
+			types = append(types, influxql.Unknown)
+		}
+	}
+	return types
+}
+
 // loadBuf reads in points into empty buffer slots.
 // Returns the next time/name/tags to emit for.
 func (e *Emitter) loadBuf() (t int64, name string, tags Tags, err error) {
@@ -279,6 +279,233 @@ func (r *UnsignedDerivativeReducer) Emit() []FloatPoint {
 	return nil
 }
 
+// counterIncrease returns the increase of a counter between two successive
+// values. A decrease is treated as a counter reset, so the counter is assumed
+// to have restarted from zero and the later value is the increase.
+func counterIncrease(prev, curr float64) float64 {
+	if curr < prev {
+		return curr
+	}
+	return curr - prev
+}
+
+// rateReducer calculates the counter-aware rate, or increase if there is no
+// interval, between successive points.
+type rateReducer struct {
+	interval  Interval
+	ascending bool
+	prev      FloatPoint
+	curr      FloatPoint
+}
+
+func newRateReducer(interval Interval, ascending bool) rateReducer {
+	return rateReducer{
+		interval:  interval,
+		ascending: ascending,
+		prev:      FloatPoint{Nil: true},
+		curr:      FloatPoint{Nil: true},
+	}
+}
+
+// aggregate updates the current point.
+func (r *rateReducer) aggregate(t int64, v float64) {
+	// Skip past a point when it does not advance the stream. A joined series
+	// may have multiple points at the same time so we will discard anything
+	// except the first point we encounter.
+	if !r.curr.Nil && r.curr.Time == t {
+		return
+	}
+
+	r.prev = r.curr
+	r.curr = FloatPoint{Time: t, Value: v}
+}
+
+// Emit emits the rate of the reducer at the current point.
+func (r *rateReducer) Emit() []FloatPoint {
+	if r.prev.Nil {
+		return nil
+	}
+
+	// Resets are detected in time order, regardless of the iteration order.
+	earlier, later := r.prev, r.curr
+	if !r.ascending {
+		earlier, later = later, earlier
+	}
+	value := counterIncrease(earlier.Value, later.Value)
+	if r.interval.Duration > 0 {
+		value /= float64(later.Time-earlier.Time) / float64(r.interval.Duration)
+	}
+
+	// Mark this point as read by changing the previous point to nil.
+	r.prev.Nil = true
+	return []FloatPoint{{Time: r.curr.Time, Value: value}}
+}
+
+// FloatRateReducer calculates the counter-aware rate of the aggregated points.
+type FloatRateReducer struct {
+	rateReducer
+}
+
+// NewFloatRateReducer creates a new FloatRateReducer. The rate is normalized
+// to interval. If interval is zero, the increase is calculated instead.
+func NewFloatRateReducer(interval Interval, ascending bool) *FloatRateReducer {
+	return &FloatRateReducer{newRateReducer(interval, ascending)}
+}
+
+// AggregateFloat aggregates a point into the reducer and updates the current window.
+func (r *FloatRateReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value)
+}
+
+// IntegerRateReducer calculates the counter-aware rate of the aggregated points.
+type IntegerRateReducer struct {
+	rateReducer
+}
+
+// NewIntegerRateReducer creates a new IntegerRateReducer.
+func NewIntegerRateReducer(interval Interval, ascending bool) *IntegerRateReducer {
+	return &IntegerRateReducer{newRateReducer(interval, ascending)}
+}
+
+// AggregateInteger aggregates a point into the reducer and updates the current window.
+func (r *IntegerRateReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// UnsignedRateReducer calculates the counter-aware rate of the aggregated points.
+type UnsignedRateReducer struct {
+	rateReducer
+}
+
+// NewUnsignedRateReducer creates a new UnsignedRateReducer.
+func NewUnsignedRateReducer(interval Interval, ascending bool) *UnsignedRateReducer {
+	return &UnsignedRateReducer{newRateReducer(interval, ascending)}
+}
+
+// AggregateUnsigned aggregates a point into the reducer and updates the current window.
+func (r *UnsignedRateReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// windowRateReducer calculates the counter-aware rate, or increase if there
+// is no interval, over a GROUP BY time() window. The increase between the
+// first and last samples in the window is extrapolated towards the window
+// edges, in the same manner as the Prometheus rate() and increase() functions.
+type windowRateReducer struct {
+	interval Interval
+	opt      IteratorOptions
+	points   []FloatPoint
+}
+
+// aggregate adds a point to the window.
+func (r *windowRateReducer) aggregate(t int64, v float64) {
+	r.points = append(r.points, FloatPoint{Time: t, Value: v})
+}
+
+// Emit emits the rate of the window. At least two samples are required.
+func (r *windowRateReducer) Emit() []FloatPoint {
+	if len(r.points) < 2 {
+		return nil
+	}
+	a := r.points
+	sort.Stable(floatPointsByTime(a))
+
+	var increase float64
+	for i := 1; i < len(a); i++ {
+		increase += counterIncrease(a[i-1].Value, a[i].Value)
+	}
+
+	first, last := a[0], a[len(a)-1]
+	sampled := float64(last.Time - first.Time)
+	if sampled == 0 {
+		return nil
+	}
+
+	// The window is limited to the time range of the query.
+	start, end := r.opt.Window(first.Time)
+	if start < r.opt.StartTime {
+		start = r.opt.StartTime
+	}
+	if end > r.opt.EndTime+1 {
+		end = r.opt.EndTime + 1
+	}
+
+	toStart := float64(first.Time - start)
+	toEnd := float64(end - last.Time)
+
+	// A counter cannot extrapolate below zero, so limit the extrapolation
+	// towards the start to where the counter would have been zero.
+	if increase > 0 && first.Value >= 0 {
+		if toZero := sampled * (first.Value / increase); toZero < toStart {
+			toStart = toZero
+		}
+	}
+
+	// Extrapolate to an edge if it is close to the samples. Otherwise, only
+	// extrapolate by half of the average distance between samples.
+	avg := sampled / float64(len(a)-1)
+	extrapolated := sampled
+	for _, d := range []float64{toStart, toEnd} {
+		if d < avg*1.1 {
+			extrapolated += d
+		} else {
+			extrapolated += avg / 2
+		}
+	}
+
+	value := increase * (extrapolated / sampled)
+	if r.interval.Duration > 0 {
+		value /= float64(end-start) / float64(r.interval.Duration)
+	}
+	return []FloatPoint{{Time: ZeroTime, Value: value}}
+}
+
+// FloatWindowRateReducer calculates the counter-aware rate of the aggregated points within a window.
+type FloatWindowRateReducer struct {
+	windowRateReducer
+}
+
+// NewFloatWindowRateReducer creates a new FloatWindowRateReducer. The rate is
+// normalized to interval. If interval is zero, the increase is calculated instead.
+func NewFloatWindowRateReducer(interval Interval, opt IteratorOptions) *FloatWindowRateReducer {
+	return &FloatWindowRateReducer{windowRateReducer{interval: interval, opt: opt}}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatWindowRateReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value)
+}
+
+// IntegerWindowRateReducer calculates the counter-aware rate of the aggregated points within a window.
+type IntegerWindowRateReducer struct {
+	windowRateReducer
+}
+
+// NewIntegerWindowRateReducer creates a new IntegerWindowRateReducer.
+func NewIntegerWindowRateReducer(interval Interval, opt IteratorOptions) *IntegerWindowRateReducer {
+	return &IntegerWindowRateReducer{windowRateReducer{interval: interval, opt: opt}}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerWindowRateReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// UnsignedWindowRateReducer calculates the counter-aware rate of the aggregated points within a window.
+type UnsignedWindowRateReducer struct {
+	windowRateReducer
+}
+
+// NewUnsignedWindowRateReducer creates a new UnsignedWindowRateReducer.
+func NewUnsignedWindowRateReducer(interval Interval, opt IteratorOptions) *UnsignedWindowRateReducer {
+	return &UnsignedWindowRateReducer{windowRateReducer{interval: interval, opt: opt}}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedWindowRateReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
 // FloatDifferenceReducer calculates the derivative of the aggregated points.
 type FloatDifferenceReducer struct {
 	isNonNegative bool
@@ -1315,6 +1542,1301 @@ func (r *UnsignedIntegralReducer) Close() error {
 	return nil
 }
 
+// segmentWindow is the GROUP BY time() bucket currently being filled by a
+// reducer that splits the time between consecutive points across bucket edges.
+type segmentWindow struct {
+	start int64
+	end   int64
+	opt   IteratorOptions
+}
+
+// reset moves the window to the bucket containing t.
+func (w *segmentWindow) reset(t int64) {
+	w.start, w.end = w.opt.Window(t)
+}
+
+// contains returns true if t is within the window.
+func (w *segmentWindow) contains(t int64) bool {
+	return w.opt.Interval.IsZero() || (t >= w.start && t < w.end)
+}
+
+// edge returns the boundary of the window that is crossed next in the
+// iteration order of the points.
+func (w *segmentWindow) edge() int64 {
+	if w.opt.Ascending {
+		return w.end
+	}
+	return w.start
+}
+
+// advance moves the window to the adjacent bucket in the iteration order.
+func (w *segmentWindow) advance() {
+	if w.opt.Ascending {
+		w.reset(w.end)
+	} else {
+		w.reset(w.start - 1)
+	}
+}
+
+// time returns the timestamp of the points emitted for the window, which is
+// the start of the bucket. Outside of a GROUP BY time() the timestamp is zero,
+// as it is for integral().
+func (w *segmentWindow) time() int64 {
+	if w.opt.Interval.IsZero() {
+		return 0
+	}
+	return w.start
+}
+
+// emitOrder reverses the queued points of a reducer in place, as the stream
+// iterators pop the emitted points off the end of the slice but the points
+// are queued in iteration order.
+func emitOrder(n int, swap func(i, j int)) {
+	for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+// timeWeightedAvgReducer calculates the average of the aggregated points
+// weighted by the time each value was held. Segments between points that
+// cross a bucket edge are split at the edge, so each bucket receives its share.
+type timeWeightedAvgReducer struct {
+	locf    bool
+	window  segmentWindow
+	prev    FloatPoint
+	area    float64
+	elapsed int64
+	points  []FloatPoint
+}
+
+func newTimeWeightedAvgReducer(locf bool, opt IteratorOptions) timeWeightedAvgReducer {
+	return timeWeightedAvgReducer{
+		locf:   locf,
+		window: segmentWindow{opt: opt},
+		prev:   FloatPoint{Nil: true},
+	}
+}
+
+// aggregate adds the segment from the previous point to the point at t.
+func (r *timeWeightedAvgReducer) aggregate(t int64, v float64) {
+	if r.prev.Nil {
+		r.window.reset(t)
+		r.prev = FloatPoint{Time: t, Value: v}
+		return
+	} else if r.prev.Time == t {
+		// Points are expected in order, so only the last value
+		// of a duplicate timestamp is kept.
+		r.prev.Value = v
+		return
+	}
+
+	// With LOCF, the value of the earlier point is held until the later point.
+	held := r.prev.Value
+	if !r.window.opt.Ascending {
+		held = v
+	}
+
+	pos, value := r.prev.Time, r.prev.Value
+	for !r.window.contains(t) {
+		edge := r.window.edge()
+		next := held
+		if !r.locf {
+			// Scale before dividing, so that values at edges that split
+			// the segment evenly are exact.
+			next = r.prev.Value + (v-r.prev.Value)*float64(edge-r.prev.Time)/float64(t-r.prev.Time)
+		}
+		r.add(pos, value, edge, next, held)
+		r.emit(next)
+		r.window.advance()
+		pos, value = edge, next
+	}
+	r.add(pos, value, t, v, held)
+	r.prev = FloatPoint{Time: t, Value: v}
+}
+
+// add accumulates the area of a single segment within the window.
+func (r *timeWeightedAvgReducer) add(t0 int64, v0 float64, t1 int64, v1, held float64) {
+	dt := t1 - t0
+	if dt < 0 {
+		dt = -dt
+	}
+	if r.locf {
+		r.area += held * float64(dt)
+	} else {
+		r.area += 0.5 * (v0 + v1) * float64(dt)
+	}
+	r.elapsed += dt
+}
+
+// emit queues the average of the window. A window without any elapsed time
+// only holds a single instant, so its value is used as the average.
+func (r *timeWeightedAvgReducer) emit(value float64) {
+	if r.elapsed > 0 {
+		value = r.area / float64(r.elapsed)
+	}
+	r.points = append(r.points, FloatPoint{Time: r.window.time(), Value: value})
+	r.area, r.elapsed = 0, 0
+}
+
+// Emit emits the averages of the windows completed so far.
+func (r *timeWeightedAvgReducer) Emit() []FloatPoint {
+	points := r.points
+	r.points = nil
+	emitOrder(len(points), func(i, j int) { points[i], points[j] = points[j], points[i] })
+	return points
+}
+
+// Close emits the average of the last window.
+func (r *timeWeightedAvgReducer) Close() error {
+	if !r.prev.Nil {
+		r.emit(r.prev.Value)
+		r.prev.Nil = true
+	}
+	return nil
+}
+
+// FloatTimeWeightedAvgReducer calculates the time-weighted average of the aggregated points.
+type FloatTimeWeightedAvgReducer struct {
+	timeWeightedAvgReducer
+}
+
+// NewFloatTimeWeightedAvgReducer creates a new FloatTimeWeightedAvgReducer.
+// Values between points are linearly interpolated unless locf is set, in
+// which case each value is held until the next point.
+func NewFloatTimeWeightedAvgReducer(locf bool, opt IteratorOptions) *FloatTimeWeightedAvgReducer {
+	return &FloatTimeWeightedAvgReducer{newTimeWeightedAvgReducer(locf, opt)}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatTimeWeightedAvgReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value)
+}
+
+// IntegerTimeWeightedAvgReducer calculates the time-weighted average of the aggregated points.
+type IntegerTimeWeightedAvgReducer struct {
+	timeWeightedAvgReducer
+}
+
+// NewIntegerTimeWeightedAvgReducer creates a new IntegerTimeWeightedAvgReducer.
+func NewIntegerTimeWeightedAvgReducer(locf bool, opt IteratorOptions) *IntegerTimeWeightedAvgReducer {
+	return &IntegerTimeWeightedAvgReducer{newTimeWeightedAvgReducer(locf, opt)}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerTimeWeightedAvgReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// UnsignedTimeWeightedAvgReducer calculates the time-weighted average of the aggregated points.
+type UnsignedTimeWeightedAvgReducer struct {
+	timeWeightedAvgReducer
+}
+
+// NewUnsignedTimeWeightedAvgReducer creates a new UnsignedTimeWeightedAvgReducer.
+func NewUnsignedTimeWeightedAvgReducer(locf bool, opt IteratorOptions) *UnsignedTimeWeightedAvgReducer {
+	return &UnsignedTimeWeightedAvgReducer{newTimeWeightedAvgReducer(locf, opt)}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedTimeWeightedAvgReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// stateReducer calculates either the time spent in a state or the number of
+// times the state was entered. A value is held until the next point, and the
+// time between points that cross a bucket edge is split at the edge.
+type stateReducer struct {
+	count  bool
+	unit   int64
+	window segmentWindow
+	prev   BooleanPoint
+	n      int64
+	points []IntegerPoint
+}
+
+func newStateReducer(count bool, unit time.Duration, opt IteratorOptions) stateReducer {
+	if unit <= 0 {
+		unit = time.Nanosecond
+	}
+	return stateReducer{
+		count:  count,
+		unit:   int64(unit),
+		window: segmentWindow{opt: opt},
+		prev:   BooleanPoint{Nil: true},
+	}
+}
+
+// aggregate records whether the point at t is in the state.
+func (r *stateReducer) aggregate(t int64, match bool) {
+	ascending := r.window.opt.Ascending
+	if r.prev.Nil {
+		r.window.reset(t)
+		r.prev = BooleanPoint{Time: t, Value: match}
+		// The earliest point enters the state if it matches. When descending,
+		// the earliest point is only known once the reducer is closed.
+		if r.count && match && ascending {
+			r.n++
+		}
+		return
+	} else if r.prev.Time == t {
+		r.prev.Value = match
+		return
+	}
+
+	earlier, later := r.prev.Value, match
+	if !ascending {
+		earlier, later = match, r.prev.Value
+
+		// The state is entered at the later point, which is in the current window.
+		if r.count && later && !earlier {
+			r.n++
+		}
+	}
+
+	pos := r.prev.Time
+	for !r.window.contains(t) {
+		edge := r.window.edge()
+		r.add(pos, edge, earlier)
+		r.emit()
+		r.window.advance()
+		pos = edge
+	}
+	r.add(pos, t, earlier)
+
+	if ascending && r.count && later && !earlier {
+		r.n++
+	}
+	r.prev = BooleanPoint{Time: t, Value: match}
+}
+
+// add accumulates the time between t0 and t1 if the state was held.
+func (r *stateReducer) add(t0, t1 int64, held bool) {
+	if r.count || !held {
+		return
+	}
+	if t1 < t0 {
+		t0, t1 = t1, t0
+	}
+	r.n += t1 - t0
+}
+
+// emit queues the result of the current window.
+func (r *stateReducer) emit() {
+	value := r.n
+	if !r.count {
+		value /= r.unit
+	}
+	r.points = append(r.points, IntegerPoint{Time: r.window.time(), Value: value})
+	r.n = 0
+}
+
+// Emit emits the results of the windows completed so far.
+func (r *stateReducer) Emit() []IntegerPoint {
+	points := r.points
+	r.points = nil
+	emitOrder(len(points), func(i, j int) { points[i], points[j] = points[j], points[i] })
+	return points
+}
+
+// Close emits the result of the last window.
+func (r *stateReducer) Close() error {
+	if !r.prev.Nil {
+		if r.count && r.prev.Value && !r.window.opt.Ascending {
+			r.n++
+		}
+		r.emit()
+		r.prev.Nil = true
+	}
+	return nil
+}
+
+// FloatStateReducer calculates the duration of, or the number of transitions
+// into, the state where the aggregated points equal a value.
+type FloatStateReducer struct {
+	stateReducer
+	state float64
+}
+
+// NewFloatStateReducer creates a new FloatStateReducer. If count is set, the
+// number of transitions into the state is returned. Otherwise, the time spent
+// in the state is returned in multiples of unit.
+func NewFloatStateReducer(state float64, count bool, unit time.Duration, opt IteratorOptions) *FloatStateReducer {
+	return &FloatStateReducer{stateReducer: newStateReducer(count, unit, opt), state: state}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatStateReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value == r.state)
+}
+
+// IntegerStateReducer calculates the duration of, or the number of transitions
+// into, the state where the aggregated points equal a value.
+type IntegerStateReducer struct {
+	stateReducer
+	state int64
+}
+
+// NewIntegerStateReducer creates a new IntegerStateReducer.
+func NewIntegerStateReducer(state int64, count bool, unit time.Duration, opt IteratorOptions) *IntegerStateReducer {
+	return &IntegerStateReducer{stateReducer: newStateReducer(count, unit, opt), state: state}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerStateReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, p.Value == r.state)
+}
+
+// UnsignedStateReducer calculates the duration of, or the number of transitions
+// into, the state where the aggregated points equal a value.
+type UnsignedStateReducer struct {
+	stateReducer
+	state uint64
+}
+
+// NewUnsignedStateReducer creates a new UnsignedStateReducer.
+func NewUnsignedStateReducer(state uint64, count bool, unit time.Duration, opt IteratorOptions) *UnsignedStateReducer {
+	return &UnsignedStateReducer{stateReducer: newStateReducer(count, unit, opt), state: state}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedStateReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, p.Value == r.state)
+}
+
+// StringStateReducer calculates the duration of, or the number of transitions
+// into, the state where the aggregated points equal a value.
+type StringStateReducer struct {
+	stateReducer
+	state string
+}
+
+// NewStringStateReducer creates a new StringStateReducer.
+func NewStringStateReducer(state string, count bool, unit time.Duration, opt IteratorOptions) *StringStateReducer {
+	return &StringStateReducer{stateReducer: newStateReducer(count, unit, opt), state: state}
+}
+
+// AggregateString aggregates a point into the reducer.
+func (r *StringStateReducer) AggregateString(p *StringPoint) {
+	r.aggregate(p.Time, p.Value == r.state)
+}
+
+// BooleanStateReducer calculates the duration of, or the number of transitions
+// into, the state where the aggregated points equal a value.
+type BooleanStateReducer struct {
+	stateReducer
+	state bool
+}
+
+// NewBooleanStateReducer creates a new BooleanStateReducer.
+func NewBooleanStateReducer(state bool, count bool, unit time.Duration, opt IteratorOptions) *BooleanStateReducer {
+	return &BooleanStateReducer{stateReducer: newStateReducer(count, unit, opt), state: state}
+}
+
+// AggregateBoolean aggregates a point into the reducer.
+func (r *BooleanStateReducer) AggregateBoolean(p *BooleanPoint) {
+	r.aggregate(p.Time, p.Value == r.state)
+}
+
+// interpolateGrid tracks the regular sample times of an interpolation.
+type interpolateGrid struct {
+	step      int64
+	maxGap    int64
+	ascending bool
+	started   bool
+	next      int64
+	buf       []int64
+}
+
+// times returns the sample times from the next sample time up to and
+// including t, in iteration order.
+func (g *interpolateGrid) times(t int64) []int64 {
+	if !g.started {
+		// Start at the first sample time at or beyond the first point.
+		g.next = t - t%g.step
+		if t%g.step < 0 {
+			g.next -= g.step
+		}
+		if g.ascending && g.next < t {
+			g.next += g.step
+		}
+		g.started = true
+	}
+
+	g.buf = g.buf[:0]
+	if g.ascending {
+		for ; g.next <= t; g.next += g.step {
+			g.buf = append(g.buf, g.next)
+		}
+	} else {
+		for ; g.next >= t; g.next -= g.step {
+			g.buf = append(g.buf, g.next)
+		}
+	}
+	return g.buf
+}
+
+// exceeds returns true if the duration between two points exceeds the maximum gap.
+func (g *interpolateGrid) exceeds(d int64) bool {
+	if d < 0 {
+		d = -d
+	}
+	return g.maxGap > 0 && d > g.maxGap
+}
+
+// FloatInterpolateReducer resamples the aggregated points at regular times.
+type FloatInterpolateReducer struct {
+	linear bool
+	grid   interpolateGrid
+	prev   FloatPoint
+	points []FloatPoint
+}
+
+// NewFloatInterpolateReducer creates a new FloatInterpolateReducer.
+// Values between points are linearly interpolated, unless linear is false, in
+// which case the previous value is used. A sample is null if the points around it
+// are further apart than maxGap, unless maxGap is zero.
+func NewFloatInterpolateReducer(linear bool, step, maxGap time.Duration, ascending bool) *FloatInterpolateReducer {
+	return &FloatInterpolateReducer{
+		linear: linear,
+		grid: interpolateGrid{
+			step:      int64(step),
+			maxGap:    int64(maxGap),
+			ascending: ascending,
+		},
+		prev: FloatPoint{Nil: true},
+	}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatInterpolateReducer) AggregateFloat(p *FloatPoint) {
+	// Skip past a point when it does not advance the stream.
+	if !r.prev.Nil && r.prev.Time == p.Time {
+		return
+	}
+
+	for _, t := range r.grid.times(p.Time) {
+		r.points = append(r.points, r.sample(t, p))
+	}
+	r.prev = *p
+}
+
+// sample returns the value at time t, between the previous point and p.
+func (r *FloatInterpolateReducer) sample(t int64, p *FloatPoint) FloatPoint {
+	if t == p.Time {
+		return FloatPoint{Time: t, Value: p.Value}
+	}
+
+	// A sample is null when the points around it are too far apart.
+	if r.grid.exceeds(p.Time - r.prev.Time) {
+		return FloatPoint{Time: t, Nil: true}
+	} else if r.linear {
+		return FloatPoint{Time: t, Value: linearFloat(t, r.prev.Time, p.Time, r.prev.Value, p.Value)}
+	}
+
+	// Use the value of the earlier point, in time order.
+	earlier := r.prev
+	if !r.grid.ascending {
+		earlier = *p
+	}
+	return FloatPoint{Time: t, Value: earlier.Value}
+}
+
+// Emit emits the samples up to the current point.
+func (r *FloatInterpolateReducer) Emit() []FloatPoint {
+	// The stream iterator returns emitted points from last to first.
+	points := r.points
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	r.points = nil
+	return points
+}
+
+// IntegerInterpolateReducer resamples the aggregated points at regular times.
+type IntegerInterpolateReducer struct {
+	linear bool
+	grid   interpolateGrid
+	prev   IntegerPoint
+	points []IntegerPoint
+}
+
+// NewIntegerInterpolateReducer creates a new IntegerInterpolateReducer.
+func NewIntegerInterpolateReducer(linear bool, step, maxGap time.Duration, ascending bool) *IntegerInterpolateReducer {
+	return &IntegerInterpolateReducer{
+		linear: linear,
+		grid: interpolateGrid{
+			step:      int64(step),
+			maxGap:    int64(maxGap),
+			ascending: ascending,
+		},
+		prev: IntegerPoint{Nil: true},
+	}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerInterpolateReducer) AggregateInteger(p *IntegerPoint) {
+	// Skip past a point when it does not advance the stream.
+	if !r.prev.Nil && r.prev.Time == p.Time {
+		return
+	}
+
+	for _, t := range r.grid.times(p.Time) {
+		r.points = append(r.points, r.sample(t, p))
+	}
+	r.prev = *p
+}
+
+// sample returns the value at time t, between the previous point and p.
+func (r *IntegerInterpolateReducer) sample(t int64, p *IntegerPoint) IntegerPoint {
+	if t == p.Time {
+		return IntegerPoint{Time: t, Value: p.Value}
+	}
+
+	// A sample is null when the points around it are too far apart.
+	if r.grid.exceeds(p.Time - r.prev.Time) {
+		return IntegerPoint{Time: t, Nil: true}
+	} else if r.linear {
+		return IntegerPoint{Time: t, Value: linearInteger(t, r.prev.Time, p.Time, r.prev.Value, p.Value)}
+	}
+
+	// Use the value of the earlier point, in time order.
+	earlier := r.prev
+	if !r.grid.ascending {
+		earlier = *p
+	}
+	return IntegerPoint{Time: t, Value: earlier.Value}
+}
+
+// Emit emits the samples up to the current point.
+func (r *IntegerInterpolateReducer) Emit() []IntegerPoint {
+	// The stream iterator returns emitted points from last to first.
+	points := r.points
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	r.points = nil
+	return points
+}
+
+// UnsignedInterpolateReducer resamples the aggregated points at regular times.
+type UnsignedInterpolateReducer struct {
+	linear bool
+	grid   interpolateGrid
+	prev   UnsignedPoint
+	points []UnsignedPoint
+}
+
+// NewUnsignedInterpolateReducer creates a new UnsignedInterpolateReducer.
+func NewUnsignedInterpolateReducer(linear bool, step, maxGap time.Duration, ascending bool) *UnsignedInterpolateReducer {
+	return &UnsignedInterpolateReducer{
+		linear: linear,
+		grid: interpolateGrid{
+			step:      int64(step),
+			maxGap:    int64(maxGap),
+			ascending: ascending,
+		},
+		prev: UnsignedPoint{Nil: true},
+	}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedInterpolateReducer) AggregateUnsigned(p *UnsignedPoint) {
+	// Skip past a point when it does not advance the stream.
+	if !r.prev.Nil && r.prev.Time == p.Time {
+		return
+	}
+
+	for _, t := range r.grid.times(p.Time) {
+		r.points = append(r.points, r.sample(t, p))
+	}
+	r.prev = *p
+}
+
+// sample returns the value at time t, between the previous point and p.
+func (r *UnsignedInterpolateReducer) sample(t int64, p *UnsignedPoint) UnsignedPoint {
+	if t == p.Time {
+		return UnsignedPoint{Time: t, Value: p.Value}
+	}
+
+	// A sample is null when the points around it are too far apart.
+	if r.grid.exceeds(p.Time - r.prev.Time) {
+		return UnsignedPoint{Time: t, Nil: true}
+	} else if r.linear {
+		return UnsignedPoint{Time: t, Value: linearUnsigned(t, r.prev.Time, p.Time, r.prev.Value, p.Value)}
+	}
+
+	// Use the value of the earlier point, in time order.
+	earlier := r.prev
+	if !r.grid.ascending {
+		earlier = *p
+	}
+	return UnsignedPoint{Time: t, Value: earlier.Value}
+}
+
+// Emit emits the samples up to the current point.
+func (r *UnsignedInterpolateReducer) Emit() []UnsignedPoint {
+	// The stream iterator returns emitted points from last to first.
+	points := r.points
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	r.points = nil
+	return points
+}
+
+// StringInterpolateReducer resamples the aggregated points at regular times.
+type StringInterpolateReducer struct {
+	grid   interpolateGrid
+	prev   StringPoint
+	points []StringPoint
+}
+
+// NewStringInterpolateReducer creates a new StringInterpolateReducer.
+// Only the previous value can be used for non-numeric points.
+func NewStringInterpolateReducer(step, maxGap time.Duration, ascending bool) *StringInterpolateReducer {
+	return &StringInterpolateReducer{
+		grid: interpolateGrid{
+			step:      int64(step),
+			maxGap:    int64(maxGap),
+			ascending: ascending,
+		},
+		prev: StringPoint{Nil: true},
+	}
+}
+
+// AggregateString aggregates a point into the reducer.
+func (r *StringInterpolateReducer) AggregateString(p *StringPoint) {
+	// Skip past a point when it does not advance the stream.
+	if !r.prev.Nil && r.prev.Time == p.Time {
+		return
+	}
+
+	for _, t := range r.grid.times(p.Time) {
+		r.points = append(r.points, r.sample(t, p))
+	}
+	r.prev = *p
+}
+
+// sample returns the value at time t, between the previous point and p.
+func (r *StringInterpolateReducer) sample(t int64, p *StringPoint) StringPoint {
+	if t == p.Time {
+		return StringPoint{Time: t, Value: p.Value}
+	}
+
+	// A sample is null when the points around it are too far apart.
+	if r.grid.exceeds(p.Time - r.prev.Time) {
+		return StringPoint{Time: t, Nil: true}
+	}
+
+	// Use the value of the earlier point, in time order.
+	earlier := r.prev
+	if !r.grid.ascending {
+		earlier = *p
+	}
+	return StringPoint{Time: t, Value: earlier.Value}
+}
+
+// Emit emits the samples up to the current point.
+func (r *StringInterpolateReducer) Emit() []StringPoint {
+	// The stream iterator returns emitted points from last to first.
+	points := r.points
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	r.points = nil
+	return points
+}
+
+// BooleanInterpolateReducer resamples the aggregated points at regular times.
+type BooleanInterpolateReducer struct {
+	grid   interpolateGrid
+	prev   BooleanPoint
+	points []BooleanPoint
+}
+
+// NewBooleanInterpolateReducer creates a new BooleanInterpolateReducer.
+// Only the previous value can be used for non-numeric points.
+func NewBooleanInterpolateReducer(step, maxGap time.Duration, ascending bool) *BooleanInterpolateReducer {
+	return &BooleanInterpolateReducer{
+		grid: interpolateGrid{
+			step:      int64(step),
+			maxGap:    int64(maxGap),
+			ascending: ascending,
+		},
+		prev: BooleanPoint{Nil: true},
+	}
+}
+
+// AggregateBoolean aggregates a point into the reducer.
+func (r *BooleanInterpolateReducer) AggregateBoolean(p *BooleanPoint) {
+	// Skip past a point when it does not advance the stream.
+	if !r.prev.Nil && r.prev.Time == p.Time {
+		return
+	}
+
+	for _, t := range r.grid.times(p.Time) {
+		r.points = append(r.points, r.sample(t, p))
+	}
+	r.prev = *p
+}
+
+// sample returns the value at time t, between the previous point and p.
+func (r *BooleanInterpolateReducer) sample(t int64, p *BooleanPoint) BooleanPoint {
+	if t == p.Time {
+		return BooleanPoint{Time: t, Value: p.Value}
+	}
+
+	// A sample is null when the points around it are too far apart.
+	if r.grid.exceeds(p.Time - r.prev.Time) {
+		return BooleanPoint{Time: t, Nil: true}
+	}
+
+	// Use the value of the earlier point, in time order.
+	earlier := r.prev
+	if !r.grid.ascending {
+		earlier = *p
+	}
+	return BooleanPoint{Time: t, Value: earlier.Value}
+}
+
+// Emit emits the samples up to the current point.
+func (r *BooleanInterpolateReducer) Emit() []BooleanPoint {
+	// The stream iterator returns emitted points from last to first.
+	points := r.points
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	r.points = nil
+	return points
+}
+
+// zscoreWindow calculates the z-score of each value against the mean and
+// sample standard deviation of the trailing window of values.
+type zscoreWindow struct {
+	pos    int
+	buf    []float64
+	points []FloatPoint
+}
+
+func newZScoreWindow(n int) zscoreWindow {
+	return zscoreWindow{buf: make([]float64, 0, n)}
+}
+
+// add adds a value to the window and calculates its z-score once the window
+// is full. A window without any variance has a z-score of zero.
+func (w *zscoreWindow) add(t int64, v float64) {
+	if len(w.buf) != cap(w.buf) {
+		w.buf = append(w.buf, v)
+	} else {
+		w.buf[w.pos] = v
+	}
+	w.pos++
+	if w.pos >= cap(w.buf) {
+		w.pos = 0
+	}
+	if len(w.buf) != cap(w.buf) {
+		return
+	}
+
+	var mean float64
+	for _, x := range w.buf {
+		mean += x
+	}
+	mean /= float64(len(w.buf))
+
+	var variance float64
+	for _, x := range w.buf {
+		variance += (x - mean) * (x - mean)
+	}
+	variance /= float64(len(w.buf) - 1)
+
+	var z float64
+	if stddev := math.Sqrt(variance); stddev > 0 {
+		z = (v - mean) / stddev
+	}
+	w.points = append(w.points, FloatPoint{Time: t, Value: z})
+}
+
+// Emit emits the z-score of the current point if the window is full.
+func (w *zscoreWindow) Emit() []FloatPoint {
+	points := w.points
+	w.points = nil
+	return points
+}
+
+// FloatZScoreReducer calculates the z-score of each aggregated point.
+type FloatZScoreReducer struct {
+	zscoreWindow
+}
+
+// NewFloatZScoreReducer creates a new FloatZScoreReducer over a window of n points.
+func NewFloatZScoreReducer(n int) *FloatZScoreReducer {
+	return &FloatZScoreReducer{newZScoreWindow(n)}
+}
+
+// AggregateFloat aggregates a point into the reducer and updates the current window.
+func (r *FloatZScoreReducer) AggregateFloat(p *FloatPoint) {
+	r.add(p.Time, p.Value)
+}
+
+// IntegerZScoreReducer calculates the z-score of each aggregated point.
+type IntegerZScoreReducer struct {
+	zscoreWindow
+}
+
+// NewIntegerZScoreReducer creates a new IntegerZScoreReducer over a window of n points.
+func NewIntegerZScoreReducer(n int) *IntegerZScoreReducer {
+	return &IntegerZScoreReducer{newZScoreWindow(n)}
+}
+
+// AggregateInteger aggregates a point into the reducer and updates the current window.
+func (r *IntegerZScoreReducer) AggregateInteger(p *IntegerPoint) {
+	r.add(p.Time, float64(p.Value))
+}
+
+// UnsignedZScoreReducer calculates the z-score of each aggregated point.
+type UnsignedZScoreReducer struct {
+	zscoreWindow
+}
+
+// NewUnsignedZScoreReducer creates a new UnsignedZScoreReducer over a window of n points.
+func NewUnsignedZScoreReducer(n int) *UnsignedZScoreReducer {
+	return &UnsignedZScoreReducer{newZScoreWindow(n)}
+}
+
+// AggregateUnsigned aggregates a point into the reducer and updates the current window.
+func (r *UnsignedZScoreReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.add(p.Time, float64(p.Value))
+}
+
+// madScale scales the median absolute deviation so it estimates the standard
+// deviation of normally distributed values.
+const madScale = 1.4826
+
+// madWindow finds outliers by their distance from the median of the trailing
+// window of values, measured in scaled median absolute deviations.
+type madWindow struct {
+	k   float64
+	pos int
+	buf []float64
+}
+
+func newMADWindow(n int, k float64) madWindow {
+	return madWindow{k: k, buf: make([]float64, 0, n)}
+}
+
+// add adds a value to the window and reports whether it is an outlier.
+// No value is an outlier until the window is full.
+func (w *madWindow) add(v float64) bool {
+	if len(w.buf) != cap(w.buf) {
+		w.buf = append(w.buf, v)
+	} else {
+		w.buf[w.pos] = v
+	}
+	w.pos++
+	if w.pos >= cap(w.buf) {
+		w.pos = 0
+	}
+	if len(w.buf) != cap(w.buf) {
+		return false
+	}
+
+	m := median(w.buf)
+	dev := make([]float64, len(w.buf))
+	for i, x := range w.buf {
+		dev[i] = math.Abs(x - m)
+	}
+	return math.Abs(v-m) > w.k*madScale*median(dev)
+}
+
+// median returns the median of the values without modifying them.
+func median(a []float64) float64 {
+	sorted := make([]float64, len(a))
+	copy(sorted, a)
+	sort.Float64s(sorted)
+	if n := len(sorted); n%2 == 0 {
+		return (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	return sorted[len(sorted)/2]
+}
+
+// FloatMADOutliersReducer emits the aggregated points that are outliers.
+type FloatMADOutliersReducer struct {
+	madWindow
+	point *FloatPoint
+}
+
+// NewFloatMADOutliersReducer creates a new FloatMADOutliersReducer over a
+// window of n points. A point is an outlier if it is more than k deviations
+// from the median.
+func NewFloatMADOutliersReducer(n int, k float64) *FloatMADOutliersReducer {
+	return &FloatMADOutliersReducer{madWindow: newMADWindow(n, k)}
+}
+
+// AggregateFloat aggregates a point into the reducer and updates the current window.
+func (r *FloatMADOutliersReducer) AggregateFloat(p *FloatPoint) {
+	r.point = nil
+	if r.add(p.Value) {
+		r.point = &FloatPoint{Time: p.Time, Value: p.Value}
+	}
+}
+
+// Emit emits the current point if it is an outlier.
+func (r *FloatMADOutliersReducer) Emit() []FloatPoint {
+	if r.point == nil {
+		return nil
+	}
+	p := *r.point
+	r.point = nil
+	return []FloatPoint{p}
+}
+
+// IntegerMADOutliersReducer emits the aggregated points that are outliers.
+type IntegerMADOutliersReducer struct {
+	madWindow
+	point *IntegerPoint
+}
+
+// NewIntegerMADOutliersReducer creates a new IntegerMADOutliersReducer over a
+// window of n points. A point is an outlier if it is more than k deviations
+// from the median.
+func NewIntegerMADOutliersReducer(n int, k float64) *IntegerMADOutliersReducer {
+	return &IntegerMADOutliersReducer{madWindow: newMADWindow(n, k)}
+}
+
+// AggregateInteger aggregates a point into the reducer and updates the current window.
+func (r *IntegerMADOutliersReducer) AggregateInteger(p *IntegerPoint) {
+	r.point = nil
+	if r.add(float64(p.Value)) {
+		r.point = &IntegerPoint{Time: p.Time, Value: p.Value}
+	}
+}
+
+// Emit emits the current point if it is an outlier.
+func (r *IntegerMADOutliersReducer) Emit() []IntegerPoint {
+	if r.point == nil {
+		return nil
+	}
+	p := *r.point
+	r.point = nil
+	return []IntegerPoint{p}
+}
+
+// UnsignedMADOutliersReducer emits the aggregated points that are outliers.
+type UnsignedMADOutliersReducer struct {
+	madWindow
+	point *UnsignedPoint
+}
+
+// NewUnsignedMADOutliersReducer creates a new UnsignedMADOutliersReducer over a
+// window of n points. A point is an outlier if it is more than k deviations
+// from the median.
+func NewUnsignedMADOutliersReducer(n int, k float64) *UnsignedMADOutliersReducer {
+	return &UnsignedMADOutliersReducer{madWindow: newMADWindow(n, k)}
+}
+
+// AggregateUnsigned aggregates a point into the reducer and updates the current window.
+func (r *UnsignedMADOutliersReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.point = nil
+	if r.add(float64(p.Value)) {
+		r.point = &UnsignedPoint{Time: p.Time, Value: p.Value}
+	}
+}
+
+// Emit emits the current point if it is an outlier.
+func (r *UnsignedMADOutliersReducer) Emit() []UnsignedPoint {
+	if r.point == nil {
+		return nil
+	}
+	p := *r.point
+	r.point = nil
+	return []UnsignedPoint{p}
+}
+
+// seasonalDecomposition splits the aggregated points into a trend, a seasonal
+// component that repeats every period intervals and the residual that
+// remains. This is the classical additive decomposition: the trend is a
+// centered moving average over one period and the seasonal component is the
+// mean detrended value at each phase of the period. The phase of a point is
+// derived from its time so windows missing from the series do not shift it.
+type seasonalDecomposition struct {
+	period   int
+	interval Interval
+	residual bool
+	times    []int64
+	values   []float64
+}
+
+func (r *seasonalDecomposition) aggregate(t int64, v float64) {
+	r.times = append(r.times, t)
+	r.values = append(r.values, v)
+}
+
+// slot returns the number of intervals between the first window after the
+// epoch and the window of t.
+func (r *seasonalDecomposition) slot(t int64) int64 {
+	stride := int64(r.interval.Stride())
+	t -= int64(r.interval.Offset)
+	k := t / stride
+	if t%stride < 0 {
+		k--
+	}
+	return k
+}
+
+// Emit emits the trend or the residual of every point with half of a period
+// of windows on either side of it. A point is skipped when any of those
+// windows has no value. Fewer than two periods of points emit nothing.
+func (r *seasonalDecomposition) Emit() []FloatPoint {
+	n, m := len(r.values), r.period
+	if n < 2*m {
+		return nil
+	}
+
+	// The decomposition runs forward in time.
+	if r.times[0] > r.times[n-1] {
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			r.times[i], r.times[j] = r.times[j], r.times[i]
+			r.values[i], r.values[j] = r.values[j], r.values[i]
+		}
+	}
+
+	// Place the values at the slot of their window, leaving gaps for the
+	// windows without a value.
+	first := r.slot(r.times[0])
+	span := int(r.slot(r.times[n-1])-first) + 1
+	values, times, ok := make([]float64, span), make([]int64, span), make([]bool, span)
+	for i, t := range r.times {
+		k := r.slot(t) - first
+		values[k], times[k], ok[k] = r.values[i], t, true
+	}
+
+	// An even period averages over one extra window with the ends weighted
+	// by half so the average stays centered on the window.
+	h := m / 2
+	trend, hasTrend := make([]float64, span), make([]bool, span)
+	for k := h; k < span-h; k++ {
+		var sum float64
+		complete := true
+		for j := k - h; j <= k+h; j++ {
+			if !ok[j] {
+				complete = false
+				break
+			} else if m%2 == 0 && (j == k-h || j == k+h) {
+				sum += values[j] / 2
+			} else {
+				sum += values[j]
+			}
+		}
+		trend[k], hasTrend[k] = sum/float64(m), complete
+	}
+
+	// Average the detrended values at each phase and center the seasonal
+	// component around zero.
+	phase := func(k int) int {
+		p := int((first + int64(k)) % int64(m))
+		if p < 0 {
+			p += m
+		}
+		return p
+	}
+	seasonal, counts := make([]float64, m), make([]int, m)
+	for k := range trend {
+		if hasTrend[k] {
+			seasonal[phase(k)] += values[k] - trend[k]
+			counts[phase(k)]++
+		}
+	}
+	var mean float64
+	var phases int
+	for j := range seasonal {
+		if counts[j] > 0 {
+			seasonal[j] /= float64(counts[j])
+			mean += seasonal[j]
+			phases++
+		}
+	}
+	if phases == 0 {
+		return nil
+	}
+	mean /= float64(phases)
+
+	var points []FloatPoint
+	for k := range trend {
+		if !hasTrend[k] {
+			continue
+		}
+		v := trend[k]
+		if r.residual {
+			v = values[k] - trend[k] - (seasonal[phase(k)] - mean)
+		}
+		points = append(points, FloatPoint{Time: times[k], Value: v})
+	}
+	return points
+}
+
+// FloatSeasonalDecompositionReducer decomposes the aggregated points.
+type FloatSeasonalDecompositionReducer struct {
+	seasonalDecomposition
+}
+
+// NewFloatSeasonalDecompositionReducer creates a new FloatSeasonalDecompositionReducer
+// for points aggregated over the windows of interval. The residual is emitted
+// instead of the trend if residual is set.
+func NewFloatSeasonalDecompositionReducer(period int, interval Interval, residual bool) *FloatSeasonalDecompositionReducer {
+	return &FloatSeasonalDecompositionReducer{seasonalDecomposition{period: period, interval: interval, residual: residual}}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatSeasonalDecompositionReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value)
+}
+
+// IntegerSeasonalDecompositionReducer decomposes the aggregated points.
+type IntegerSeasonalDecompositionReducer struct {
+	seasonalDecomposition
+}
+
+// NewIntegerSeasonalDecompositionReducer creates a new IntegerSeasonalDecompositionReducer.
+func NewIntegerSeasonalDecompositionReducer(period int, interval Interval, residual bool) *IntegerSeasonalDecompositionReducer {
+	return &IntegerSeasonalDecompositionReducer{seasonalDecomposition{period: period, interval: interval, residual: residual}}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerSeasonalDecompositionReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// UnsignedSeasonalDecompositionReducer decomposes the aggregated points.
+type UnsignedSeasonalDecompositionReducer struct {
+	seasonalDecomposition
+}
+
+// NewUnsignedSeasonalDecompositionReducer creates a new UnsignedSeasonalDecompositionReducer.
+func NewUnsignedSeasonalDecompositionReducer(period int, interval Interval, residual bool) *UnsignedSeasonalDecompositionReducer {
+	return &UnsignedSeasonalDecompositionReducer{seasonalDecomposition{period: period, interval: interval, residual: residual}}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedSeasonalDecompositionReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// linearForecast fits a least squares line through the aggregated points and
+// extends it past the last point.
+type linearForecast struct {
+	n        int
+	interval int64
+	times    []int64
+	values   []float64
+}
+
+func (r *linearForecast) aggregate(t int64, v float64) {
+	r.times = append(r.times, t)
+	r.values = append(r.values, v)
+}
+
+// Emit emits n points after the last point spaced by the interval. Without an
+// interval, the points are spaced by the average distance between the
+// aggregated points. At least two points at different times are required.
+func (r *linearForecast) Emit() []FloatPoint {
+	if len(r.values) < 2 {
+		return nil
+	}
+
+	first, last := r.times[0], r.times[0]
+	for _, t := range r.times[1:] {
+		if t < first {
+			first = t
+		} else if t > last {
+			last = t
+		}
+	}
+
+	// Fit the line around the means to avoid losing precision on large times.
+	var mx, my float64
+	for i, t := range r.times {
+		mx += float64(t - first)
+		my += r.values[i]
+	}
+	mx /= float64(len(r.times))
+	my /= float64(len(r.times))
+
+	var sxx, sxy float64
+	for i, t := range r.times {
+		dx := float64(t-first) - mx
+		sxx += dx * dx
+		sxy += dx * (r.values[i] - my)
+	}
+	if sxx == 0 {
+		return nil
+	}
+	slope := sxy / sxx
+
+	interval := r.interval
+	if interval <= 0 {
+		interval = (last - first) / int64(len(r.times)-1)
+		if interval <= 0 {
+			return nil
+		}
+	}
+
+	points := make([]FloatPoint, 0, r.n)
+	for i := 1; i <= r.n; i++ {
+		t := last + int64(i)*interval
+		points = append(points, FloatPoint{Time: t, Value: my + slope*(float64(t-first)-mx)})
+	}
+	return points
+}
+
+// FloatLinearForecastReducer forecasts future points from the aggregated points.
+type FloatLinearForecastReducer struct {
+	linearForecast
+}
+
+// NewFloatLinearForecastReducer creates a new FloatLinearForecastReducer that
+// forecasts n points.
+func NewFloatLinearForecastReducer(n int, interval time.Duration) *FloatLinearForecastReducer {
+	return &FloatLinearForecastReducer{linearForecast{n: n, interval: int64(interval)}}
+}
+
+// AggregateFloat aggregates a point into the reducer.
+func (r *FloatLinearForecastReducer) AggregateFloat(p *FloatPoint) {
+	r.aggregate(p.Time, p.Value)
+}
+
+// IntegerLinearForecastReducer forecasts future points from the aggregated points.
+type IntegerLinearForecastReducer struct {
+	linearForecast
+}
+
+// NewIntegerLinearForecastReducer creates a new IntegerLinearForecastReducer that
+// forecasts n points.
+func NewIntegerLinearForecastReducer(n int, interval time.Duration) *IntegerLinearForecastReducer {
+	return &IntegerLinearForecastReducer{linearForecast{n: n, interval: int64(interval)}}
+}
+
+// AggregateInteger aggregates a point into the reducer.
+func (r *IntegerLinearForecastReducer) AggregateInteger(p *IntegerPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
+// UnsignedLinearForecastReducer forecasts future points from the aggregated points.
+type UnsignedLinearForecastReducer struct {
+	linearForecast
+}
+
+// NewUnsignedLinearForecastReducer creates a new UnsignedLinearForecastReducer that
+// forecasts n points.
+func NewUnsignedLinearForecastReducer(n int, interval time.Duration) *UnsignedLinearForecastReducer {
+	return &UnsignedLinearForecastReducer{linearForecast{n: n, interval: int64(interval)}}
+}
+
+// AggregateUnsigned aggregates a point into the reducer.
+func (r *UnsignedLinearForecastReducer) AggregateUnsigned(p *UnsignedPoint) {
+	r.aggregate(p.Time, float64(p.Value))
+}
+
 type FloatTopReducer struct {
 	h *floatPointsByFunc
 }
@@ -467,6 +467,7 @@ func (m *Measurement) GetSystemIterator() string {
 type Interval struct {
 	Duration         *int64 `protobuf:"varint,1,opt,name=Duration" json:"Duration,omitempty"`
 	Offset           *int64 `protobuf:"varint,2,opt,name=Offset" json:"Offset,omitempty"`
+	Step             *int64 `protobuf:"varint,3,opt,name=Step" json:"Step,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -489,9 +490,19 @@ func (m *Interval) GetOffset() int64 {
 	return 0
 }
 
+func (m *Interval) GetStep() int64 {
+	if m != nil && m.Step != nil {
+		return *m.Step
+	}
+	return 0
+}
+
 type IteratorStats struct {
 	SeriesN          *int64 `protobuf:"varint,1,opt,name=SeriesN" json:"SeriesN,omitempty"`
 	PointN           *int64 `protobuf:"varint,2,opt,name=PointN" json:"PointN,omitempty"`
+	BlocksN          *int64 `protobuf:"varint,3,opt,name=BlocksN" json:"BlocksN,omitempty"`
+	BlockBytes       *int64 `protobuf:"varint,4,opt,name=BlockBytes" json:"BlockBytes,omitempty"`
+	CursorTime       *int64 `protobuf:"varint,5,opt,name=CursorTime" json:"CursorTime,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -514,6 +525,27 @@ func (m *IteratorStats) GetPointN() int64 {
 	return 0
 }
 
+func (m *IteratorStats) GetBlocksN() int64 {
+	if m != nil && m.BlocksN != nil {
+		return *m.BlocksN
+	}
+	return 0
+}
+
+func (m *IteratorStats) GetBlockBytes() int64 {
+	if m != nil && m.BlockBytes != nil {
+		return *m.BlockBytes
+	}
+	return 0
+}
+
+func (m *IteratorStats) GetCursorTime() int64 {
+	if m != nil && m.CursorTime != nil {
+		return *m.CursorTime
+	}
+	return 0
+}
+
 type VarRef struct {
 	Val              *string `protobuf:"bytes,1,req,name=Val" json:"Val,omitempty"`
 	Type             *int32  `protobuf:"varint,2,opt,name=Type" json:"Type,omitempty"`
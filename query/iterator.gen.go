@@ -634,7 +634,7 @@ type floatFillIterator struct {
 
 func newFloatFillIterator(input FloatIterator, expr influxql.Expr, opt IteratorOptions) *floatFillIterator {
 	if opt.Fill == influxql.NullFill {
-		if expr, ok := expr.(*influxql.Call); ok && expr.Name == "count" {
+		if expr, ok := expr.(*influxql.Call); ok && (expr.Name == "count" || expr.Name == "histogram") {
 			opt.Fill = influxql.NumberFill
 			opt.FillValue = float64(0)
 		}
@@ -746,7 +746,7 @@ func (itr *floatFillIterator) Next() (*FloatPoint, error) {
 				if err != nil {
 					return nil, err
 				} else if next != nil && next.Name == itr.window.name && next.Tags.ID() == itr.window.tags.ID() {
-					interval := int64(itr.opt.Interval.Duration)
+					interval := int64(itr.opt.Interval.Stride())
 					start := itr.window.time / interval
 					p.Value = linearFloat(start, itr.prev.Time/interval, next.Time/interval, itr.prev.Value, next.Value)
 				} else {
@@ -776,9 +776,9 @@ func (itr *floatFillIterator) Next() (*FloatPoint, error) {
 	// as there may be lingering points with the same timestamp in the previous
 	// window.
 	if itr.opt.Ascending {
-		itr.window.time += int64(itr.opt.Interval.Duration)
+		itr.window.time += int64(itr.opt.Interval.Stride())
 	} else {
-		itr.window.time -= int64(itr.opt.Interval.Duration)
+		itr.window.time -= int64(itr.opt.Interval.Stride())
 	}
 
 	// Check to see if we have passed over an offset change and adjust the time
@@ -1082,6 +1082,15 @@ type floatReduceFloatIterator struct {
 	opt      IteratorOptions
 	points   []FloatPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []FloatPoint
+	}
 }
 
 func newFloatReduceFloatIterator(input FloatIterator, opt IteratorOptions, createFn func() (FloatPointAggregator, FloatPointEmitter)) *floatReduceFloatIterator {
@@ -1127,6 +1136,10 @@ type floatReduceFloatPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1175,26 +1188,121 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &floatReduceFloatPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *floatReduceFloatIterator) reduceSliding() ([]FloatPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateFloat(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*floatReduceFloatPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *floatReduceFloatIterator) aggregate(m map[string]*floatReduceFloatPoint, p *FloatPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &floatReduceFloatPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateFloat(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *floatReduceFloatIterator) emit(m map[string]*floatReduceFloatPoint, startTime int64) []FloatPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -1230,8 +1338,7 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(floatPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // floatStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -1497,6 +1604,15 @@ type floatReduceIntegerIterator struct {
 	opt      IteratorOptions
 	points   []IntegerPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []FloatPoint
+	}
 }
 
 func newFloatReduceIntegerIterator(input FloatIterator, opt IteratorOptions, createFn func() (FloatPointAggregator, IntegerPointEmitter)) *floatReduceIntegerIterator {
@@ -1542,6 +1658,10 @@ type floatReduceIntegerPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1590,26 +1710,121 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &floatReduceIntegerPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *floatReduceIntegerIterator) reduceSliding() ([]IntegerPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateFloat(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*floatReduceIntegerPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *floatReduceIntegerIterator) aggregate(m map[string]*floatReduceIntegerPoint, p *FloatPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &floatReduceIntegerPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateFloat(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *floatReduceIntegerIterator) emit(m map[string]*floatReduceIntegerPoint, startTime int64) []IntegerPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -1645,8 +1860,7 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(integerPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // floatStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -1916,6 +2130,15 @@ type floatReduceUnsignedIterator struct {
 	opt      IteratorOptions
 	points   []UnsignedPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []FloatPoint
+	}
 }
 
 func newFloatReduceUnsignedIterator(input FloatIterator, opt IteratorOptions, createFn func() (FloatPointAggregator, UnsignedPointEmitter)) *floatReduceUnsignedIterator {
@@ -1961,6 +2184,10 @@ type floatReduceUnsignedPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -2009,26 +2236,121 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &floatReduceUnsignedPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *floatReduceUnsignedIterator) reduceSliding() ([]UnsignedPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateFloat(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*floatReduceUnsignedPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *floatReduceUnsignedIterator) aggregate(m map[string]*floatReduceUnsignedPoint, p *FloatPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &floatReduceUnsignedPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateFloat(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *floatReduceUnsignedIterator) emit(m map[string]*floatReduceUnsignedPoint, startTime int64) []UnsignedPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -2064,8 +2386,7 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // floatStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -2335,6 +2656,15 @@ type floatReduceStringIterator struct {
 	opt      IteratorOptions
 	points   []StringPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []FloatPoint
+	}
 }
 
 func newFloatReduceStringIterator(input FloatIterator, opt IteratorOptions, createFn func() (FloatPointAggregator, StringPointEmitter)) *floatReduceStringIterator {
@@ -2380,6 +2710,10 @@ type floatReduceStringPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -2428,26 +2762,121 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &floatReduceStringPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *floatReduceStringIterator) reduceSliding() ([]StringPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateFloat(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*floatReduceStringPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *floatReduceStringIterator) aggregate(m map[string]*floatReduceStringPoint, p *FloatPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &floatReduceStringPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateFloat(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *floatReduceStringIterator) emit(m map[string]*floatReduceStringPoint, startTime int64) []StringPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -2483,8 +2912,7 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(stringPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // floatStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -2754,6 +3182,15 @@ type floatReduceBooleanIterator struct {
 	opt      IteratorOptions
 	points   []BooleanPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []FloatPoint
+	}
 }
 
 func newFloatReduceBooleanIterator(input FloatIterator, opt IteratorOptions, createFn func() (FloatPointAggregator, BooleanPointEmitter)) *floatReduceBooleanIterator {
@@ -2799,6 +3236,10 @@ type floatReduceBooleanPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -2847,33 +3288,128 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &floatReduceBooleanPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *floatReduceBooleanIterator) reduceSliding() ([]BooleanPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateFloat(curr)
-	}
+		end := w.start + duration
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*floatReduceBooleanPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *floatReduceBooleanIterator) aggregate(m map[string]*floatReduceBooleanPoint, p *FloatPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &floatReduceBooleanPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateFloat(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *floatReduceBooleanIterator) emit(m map[string]*floatReduceBooleanPoint, startTime int64) []BooleanPoint {
+	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) > 1 && itr.opt.Ordered {
+		sort.Sort(reverseStringSlice(keys))
 	}
 
 	// Assume the points are already sorted until proven otherwise.
@@ -2902,8 +3438,7 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // floatStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -4050,7 +4585,7 @@ type integerFillIterator struct {
 
 func newIntegerFillIterator(input IntegerIterator, expr influxql.Expr, opt IteratorOptions) *integerFillIterator {
 	if opt.Fill == influxql.NullFill {
-		if expr, ok := expr.(*influxql.Call); ok && expr.Name == "count" {
+		if expr, ok := expr.(*influxql.Call); ok && (expr.Name == "count" || expr.Name == "histogram") {
 			opt.Fill = influxql.NumberFill
 			opt.FillValue = int64(0)
 		}
@@ -4162,7 +4697,7 @@ func (itr *integerFillIterator) Next() (*IntegerPoint, error) {
 				if err != nil {
 					return nil, err
 				} else if next != nil && next.Name == itr.window.name && next.Tags.ID() == itr.window.tags.ID() {
-					interval := int64(itr.opt.Interval.Duration)
+					interval := int64(itr.opt.Interval.Stride())
 					start := itr.window.time / interval
 					p.Value = linearInteger(start, itr.prev.Time/interval, next.Time/interval, itr.prev.Value, next.Value)
 				} else {
@@ -4192,9 +4727,9 @@ func (itr *integerFillIterator) Next() (*IntegerPoint, error) {
 	// as there may be lingering points with the same timestamp in the previous
 	// window.
 	if itr.opt.Ascending {
-		itr.window.time += int64(itr.opt.Interval.Duration)
+		itr.window.time += int64(itr.opt.Interval.Stride())
 	} else {
-		itr.window.time -= int64(itr.opt.Interval.Duration)
+		itr.window.time -= int64(itr.opt.Interval.Stride())
 	}
 
 	// Check to see if we have passed over an offset change and adjust the time
@@ -4495,6 +5030,15 @@ type integerReduceFloatIterator struct {
 	opt      IteratorOptions
 	points   []FloatPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []IntegerPoint
+	}
 }
 
 func newIntegerReduceFloatIterator(input IntegerIterator, opt IteratorOptions, createFn func() (IntegerPointAggregator, FloatPointEmitter)) *integerReduceFloatIterator {
@@ -4540,6 +5084,10 @@ type integerReduceFloatPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -4588,26 +5136,121 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &integerReduceFloatPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *integerReduceFloatIterator) reduceSliding() ([]FloatPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateInteger(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*integerReduceFloatPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *integerReduceFloatIterator) aggregate(m map[string]*integerReduceFloatPoint, p *IntegerPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &integerReduceFloatPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateInteger(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *integerReduceFloatIterator) emit(m map[string]*integerReduceFloatPoint, startTime int64) []FloatPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -4643,8 +5286,7 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(floatPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // integerStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -4914,6 +5556,15 @@ type integerReduceIntegerIterator struct {
 	opt      IteratorOptions
 	points   []IntegerPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []IntegerPoint
+	}
 }
 
 func newIntegerReduceIntegerIterator(input IntegerIterator, opt IteratorOptions, createFn func() (IntegerPointAggregator, IntegerPointEmitter)) *integerReduceIntegerIterator {
@@ -4959,6 +5610,10 @@ type integerReduceIntegerPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -5007,26 +5662,121 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &integerReduceIntegerPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *integerReduceIntegerIterator) reduceSliding() ([]IntegerPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateInteger(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*integerReduceIntegerPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *integerReduceIntegerIterator) aggregate(m map[string]*integerReduceIntegerPoint, p *IntegerPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &integerReduceIntegerPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateInteger(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *integerReduceIntegerIterator) emit(m map[string]*integerReduceIntegerPoint, startTime int64) []IntegerPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -5062,8 +5812,7 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(integerPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // integerStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -5329,6 +6078,15 @@ type integerReduceUnsignedIterator struct {
 	opt      IteratorOptions
 	points   []UnsignedPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []IntegerPoint
+	}
 }
 
 func newIntegerReduceUnsignedIterator(input IntegerIterator, opt IteratorOptions, createFn func() (IntegerPointAggregator, UnsignedPointEmitter)) *integerReduceUnsignedIterator {
@@ -5374,6 +6132,10 @@ type integerReduceUnsignedPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -5422,26 +6184,121 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &integerReduceUnsignedPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *integerReduceUnsignedIterator) reduceSliding() ([]UnsignedPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateInteger(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*integerReduceUnsignedPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *integerReduceUnsignedIterator) aggregate(m map[string]*integerReduceUnsignedPoint, p *IntegerPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &integerReduceUnsignedPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateInteger(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *integerReduceUnsignedIterator) emit(m map[string]*integerReduceUnsignedPoint, startTime int64) []UnsignedPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -5477,8 +6334,7 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // integerStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -5748,6 +6604,15 @@ type integerReduceStringIterator struct {
 	opt      IteratorOptions
 	points   []StringPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []IntegerPoint
+	}
 }
 
 func newIntegerReduceStringIterator(input IntegerIterator, opt IteratorOptions, createFn func() (IntegerPointAggregator, StringPointEmitter)) *integerReduceStringIterator {
@@ -5793,6 +6658,10 @@ type integerReduceStringPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -5841,41 +6710,136 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &integerReduceStringPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *integerReduceStringIterator) reduceSliding() ([]StringPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateInteger(curr)
-	}
+		end := w.start + duration
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
-	}
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
 
-	// Assume the points are already sorted until proven otherwise.
-	sortedByTime := true
-	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
-	for _, k := range keys {
-		rp := m[k]
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*integerReduceStringPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *integerReduceStringIterator) aggregate(m map[string]*integerReduceStringPoint, p *IntegerPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &integerReduceStringPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateInteger(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *integerReduceStringIterator) emit(m map[string]*integerReduceStringPoint, startTime int64) []StringPoint {
+	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) > 1 && itr.opt.Ordered {
+		sort.Sort(reverseStringSlice(keys))
+	}
+
+	// Assume the points are already sorted until proven otherwise.
+	sortedByTime := true
+	// Emit the points for each name & tag combination.
+	a := make([]StringPoint, 0, len(m))
+	for _, k := range keys {
+		rp := m[k]
 		points := rp.Emitter.Emit()
 		for i := len(points) - 1; i >= 0; i-- {
 			points[i].Name = rp.Name
@@ -5896,8 +6860,7 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(stringPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // integerStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -6167,6 +7130,15 @@ type integerReduceBooleanIterator struct {
 	opt      IteratorOptions
 	points   []BooleanPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []IntegerPoint
+	}
 }
 
 func newIntegerReduceBooleanIterator(input IntegerIterator, opt IteratorOptions, createFn func() (IntegerPointAggregator, BooleanPointEmitter)) *integerReduceBooleanIterator {
@@ -6212,6 +7184,10 @@ type integerReduceBooleanPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -6260,26 +7236,121 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &integerReduceBooleanPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *integerReduceBooleanIterator) reduceSliding() ([]BooleanPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateInteger(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*integerReduceBooleanPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *integerReduceBooleanIterator) aggregate(m map[string]*integerReduceBooleanPoint, p *IntegerPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &integerReduceBooleanPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
 	}
+	rp.Aggregator.AggregateInteger(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *integerReduceBooleanIterator) emit(m map[string]*integerReduceBooleanPoint, startTime int64) []BooleanPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -6315,8 +7386,7 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // integerStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -7463,7 +8533,7 @@ type unsignedFillIterator struct {
 
 func newUnsignedFillIterator(input UnsignedIterator, expr influxql.Expr, opt IteratorOptions) *unsignedFillIterator {
 	if opt.Fill == influxql.NullFill {
-		if expr, ok := expr.(*influxql.Call); ok && expr.Name == "count" {
+		if expr, ok := expr.(*influxql.Call); ok && (expr.Name == "count" || expr.Name == "histogram") {
 			opt.Fill = influxql.NumberFill
 			opt.FillValue = uint64(0)
 		}
@@ -7575,7 +8645,7 @@ func (itr *unsignedFillIterator) Next() (*UnsignedPoint, error) {
 				if err != nil {
 					return nil, err
 				} else if next != nil && next.Name == itr.window.name && next.Tags.ID() == itr.window.tags.ID() {
-					interval := int64(itr.opt.Interval.Duration)
+					interval := int64(itr.opt.Interval.Stride())
 					start := itr.window.time / interval
 					p.Value = linearUnsigned(start, itr.prev.Time/interval, next.Time/interval, itr.prev.Value, next.Value)
 				} else {
@@ -7605,9 +8675,9 @@ func (itr *unsignedFillIterator) Next() (*UnsignedPoint, error) {
 	// as there may be lingering points with the same timestamp in the previous
 	// window.
 	if itr.opt.Ascending {
-		itr.window.time += int64(itr.opt.Interval.Duration)
+		itr.window.time += int64(itr.opt.Interval.Stride())
 	} else {
-		itr.window.time -= int64(itr.opt.Interval.Duration)
+		itr.window.time -= int64(itr.opt.Interval.Stride())
 	}
 
 	// Check to see if we have passed over an offset change and adjust the time
@@ -7908,6 +8978,15 @@ type unsignedReduceFloatIterator struct {
 	opt      IteratorOptions
 	points   []FloatPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []UnsignedPoint
+	}
 }
 
 func newUnsignedReduceFloatIterator(input UnsignedIterator, opt IteratorOptions, createFn func() (UnsignedPointAggregator, FloatPointEmitter)) *unsignedReduceFloatIterator {
@@ -7953,6 +9032,10 @@ type unsignedReduceFloatPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -8001,26 +9084,121 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &unsignedReduceFloatPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *unsignedReduceFloatIterator) reduceSliding() ([]FloatPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateUnsigned(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*unsignedReduceFloatPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *unsignedReduceFloatIterator) aggregate(m map[string]*unsignedReduceFloatPoint, p *UnsignedPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &unsignedReduceFloatPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
 	}
+	rp.Aggregator.AggregateUnsigned(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *unsignedReduceFloatIterator) emit(m map[string]*unsignedReduceFloatPoint, startTime int64) []FloatPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -8056,8 +9234,7 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(floatPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // unsignedStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -8327,6 +9504,15 @@ type unsignedReduceIntegerIterator struct {
 	opt      IteratorOptions
 	points   []IntegerPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []UnsignedPoint
+	}
 }
 
 func newUnsignedReduceIntegerIterator(input UnsignedIterator, opt IteratorOptions, createFn func() (UnsignedPointAggregator, IntegerPointEmitter)) *unsignedReduceIntegerIterator {
@@ -8372,6 +9558,10 @@ type unsignedReduceIntegerPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -8420,26 +9610,121 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &unsignedReduceIntegerPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *unsignedReduceIntegerIterator) reduceSliding() ([]IntegerPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateUnsigned(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*unsignedReduceIntegerPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *unsignedReduceIntegerIterator) aggregate(m map[string]*unsignedReduceIntegerPoint, p *UnsignedPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &unsignedReduceIntegerPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
 	}
+	rp.Aggregator.AggregateUnsigned(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *unsignedReduceIntegerIterator) emit(m map[string]*unsignedReduceIntegerPoint, startTime int64) []IntegerPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -8475,8 +9760,7 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(integerPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // unsignedStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -8746,6 +10030,15 @@ type unsignedReduceUnsignedIterator struct {
 	opt      IteratorOptions
 	points   []UnsignedPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []UnsignedPoint
+	}
 }
 
 func newUnsignedReduceUnsignedIterator(input UnsignedIterator, opt IteratorOptions, createFn func() (UnsignedPointAggregator, UnsignedPointEmitter)) *unsignedReduceUnsignedIterator {
@@ -8791,6 +10084,10 @@ type unsignedReduceUnsignedPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -8839,43 +10136,138 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &unsignedReduceUnsignedPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *unsignedReduceUnsignedIterator) reduceSliding() ([]UnsignedPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateUnsigned(curr)
-	}
+		end := w.start + duration
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
-	}
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
 
-	// Assume the points are already sorted until proven otherwise.
-	sortedByTime := true
-	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
-	for _, k := range keys {
-		rp := m[k]
-		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*unsignedReduceUnsignedPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *unsignedReduceUnsignedIterator) aggregate(m map[string]*unsignedReduceUnsignedPoint, p *UnsignedPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &unsignedReduceUnsignedPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateUnsigned(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *unsignedReduceUnsignedIterator) emit(m map[string]*unsignedReduceUnsignedPoint, startTime int64) []UnsignedPoint {
+	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) > 1 && itr.opt.Ordered {
+		sort.Sort(reverseStringSlice(keys))
+	}
+
+	// Assume the points are already sorted until proven otherwise.
+	sortedByTime := true
+	// Emit the points for each name & tag combination.
+	a := make([]UnsignedPoint, 0, len(m))
+	for _, k := range keys {
+		rp := m[k]
+		points := rp.Emitter.Emit()
+		for i := len(points) - 1; i >= 0; i-- {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -8894,8 +10286,7 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // unsignedStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -9161,6 +10552,15 @@ type unsignedReduceStringIterator struct {
 	opt      IteratorOptions
 	points   []StringPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []UnsignedPoint
+	}
 }
 
 func newUnsignedReduceStringIterator(input UnsignedIterator, opt IteratorOptions, createFn func() (UnsignedPointAggregator, StringPointEmitter)) *unsignedReduceStringIterator {
@@ -9206,6 +10606,10 @@ type unsignedReduceStringPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -9254,26 +10658,121 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &unsignedReduceStringPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *unsignedReduceStringIterator) reduceSliding() ([]StringPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateUnsigned(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*unsignedReduceStringPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *unsignedReduceStringIterator) aggregate(m map[string]*unsignedReduceStringPoint, p *UnsignedPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &unsignedReduceStringPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateUnsigned(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *unsignedReduceStringIterator) emit(m map[string]*unsignedReduceStringPoint, startTime int64) []StringPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -9309,8 +10808,7 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(stringPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // unsignedStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -9580,6 +11078,15 @@ type unsignedReduceBooleanIterator struct {
 	opt      IteratorOptions
 	points   []BooleanPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []UnsignedPoint
+	}
 }
 
 func newUnsignedReduceBooleanIterator(input UnsignedIterator, opt IteratorOptions, createFn func() (UnsignedPointAggregator, BooleanPointEmitter)) *unsignedReduceBooleanIterator {
@@ -9625,6 +11132,10 @@ type unsignedReduceBooleanPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -9673,26 +11184,121 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &unsignedReduceBooleanPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *unsignedReduceBooleanIterator) reduceSliding() ([]BooleanPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateUnsigned(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*unsignedReduceBooleanPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *unsignedReduceBooleanIterator) aggregate(m map[string]*unsignedReduceBooleanPoint, p *UnsignedPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &unsignedReduceBooleanPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateUnsigned(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *unsignedReduceBooleanIterator) emit(m map[string]*unsignedReduceBooleanPoint, startTime int64) []BooleanPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -9728,8 +11334,7 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // unsignedStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -10876,7 +12481,7 @@ type stringFillIterator struct {
 
 func newStringFillIterator(input StringIterator, expr influxql.Expr, opt IteratorOptions) *stringFillIterator {
 	if opt.Fill == influxql.NullFill {
-		if expr, ok := expr.(*influxql.Call); ok && expr.Name == "count" {
+		if expr, ok := expr.(*influxql.Call); ok && (expr.Name == "count" || expr.Name == "histogram") {
 			opt.Fill = influxql.NumberFill
 			opt.FillValue = ""
 		}
@@ -11004,9 +12609,9 @@ func (itr *stringFillIterator) Next() (*StringPoint, error) {
 	// as there may be lingering points with the same timestamp in the previous
 	// window.
 	if itr.opt.Ascending {
-		itr.window.time += int64(itr.opt.Interval.Duration)
+		itr.window.time += int64(itr.opt.Interval.Stride())
 	} else {
-		itr.window.time -= int64(itr.opt.Interval.Duration)
+		itr.window.time -= int64(itr.opt.Interval.Stride())
 	}
 
 	// Check to see if we have passed over an offset change and adjust the time
@@ -11307,6 +12912,15 @@ type stringReduceFloatIterator struct {
 	opt      IteratorOptions
 	points   []FloatPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []StringPoint
+	}
 }
 
 func newStringReduceFloatIterator(input StringIterator, opt IteratorOptions, createFn func() (StringPointAggregator, FloatPointEmitter)) *stringReduceFloatIterator {
@@ -11352,6 +12966,10 @@ type stringReduceFloatPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -11400,26 +13018,121 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &stringReduceFloatPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *stringReduceFloatIterator) reduceSliding() ([]FloatPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateString(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*stringReduceFloatPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *stringReduceFloatIterator) aggregate(m map[string]*stringReduceFloatPoint, p *StringPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &stringReduceFloatPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateString(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *stringReduceFloatIterator) emit(m map[string]*stringReduceFloatPoint, startTime int64) []FloatPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -11455,8 +13168,7 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(floatPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // stringStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -11726,6 +13438,15 @@ type stringReduceIntegerIterator struct {
 	opt      IteratorOptions
 	points   []IntegerPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []StringPoint
+	}
 }
 
 func newStringReduceIntegerIterator(input StringIterator, opt IteratorOptions, createFn func() (StringPointAggregator, IntegerPointEmitter)) *stringReduceIntegerIterator {
@@ -11771,6 +13492,10 @@ type stringReduceIntegerPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -11819,41 +13544,136 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &stringReduceIntegerPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *stringReduceIntegerIterator) reduceSliding() ([]IntegerPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateString(curr)
-	}
+		end := w.start + duration
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
-	}
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
 
-	// Assume the points are already sorted until proven otherwise.
-	sortedByTime := true
-	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
-	for _, k := range keys {
-		rp := m[k]
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*stringReduceIntegerPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *stringReduceIntegerIterator) aggregate(m map[string]*stringReduceIntegerPoint, p *StringPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &stringReduceIntegerPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateString(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *stringReduceIntegerIterator) emit(m map[string]*stringReduceIntegerPoint, startTime int64) []IntegerPoint {
+	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	if len(keys) > 1 && itr.opt.Ordered {
+		sort.Sort(reverseStringSlice(keys))
+	}
+
+	// Assume the points are already sorted until proven otherwise.
+	sortedByTime := true
+	// Emit the points for each name & tag combination.
+	a := make([]IntegerPoint, 0, len(m))
+	for _, k := range keys {
+		rp := m[k]
 		points := rp.Emitter.Emit()
 		for i := len(points) - 1; i >= 0; i-- {
 			points[i].Name = rp.Name
@@ -11874,8 +13694,7 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(integerPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // stringStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -12145,6 +13964,15 @@ type stringReduceUnsignedIterator struct {
 	opt      IteratorOptions
 	points   []UnsignedPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []StringPoint
+	}
 }
 
 func newStringReduceUnsignedIterator(input StringIterator, opt IteratorOptions, createFn func() (StringPointAggregator, UnsignedPointEmitter)) *stringReduceUnsignedIterator {
@@ -12190,6 +14018,10 @@ type stringReduceUnsignedPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -12238,26 +14070,121 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &stringReduceUnsignedPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *stringReduceUnsignedIterator) reduceSliding() ([]UnsignedPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateString(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*stringReduceUnsignedPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *stringReduceUnsignedIterator) aggregate(m map[string]*stringReduceUnsignedPoint, p *StringPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &stringReduceUnsignedPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateString(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *stringReduceUnsignedIterator) emit(m map[string]*stringReduceUnsignedPoint, startTime int64) []UnsignedPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -12293,8 +14220,7 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // stringStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -12564,6 +14490,15 @@ type stringReduceStringIterator struct {
 	opt      IteratorOptions
 	points   []StringPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []StringPoint
+	}
 }
 
 func newStringReduceStringIterator(input StringIterator, opt IteratorOptions, createFn func() (StringPointAggregator, StringPointEmitter)) *stringReduceStringIterator {
@@ -12609,6 +14544,10 @@ type stringReduceStringPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -12657,26 +14596,121 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &stringReduceStringPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *stringReduceStringIterator) reduceSliding() ([]StringPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateString(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*stringReduceStringPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *stringReduceStringIterator) aggregate(m map[string]*stringReduceStringPoint, p *StringPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
 
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &stringReduceStringPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateString(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *stringReduceStringIterator) emit(m map[string]*stringReduceStringPoint, startTime int64) []StringPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -12712,8 +14746,7 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(stringPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // stringStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -12979,6 +15012,15 @@ type stringReduceBooleanIterator struct {
 	opt      IteratorOptions
 	points   []BooleanPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []StringPoint
+	}
 }
 
 func newStringReduceBooleanIterator(input StringIterator, opt IteratorOptions, createFn func() (StringPointAggregator, BooleanPointEmitter)) *stringReduceBooleanIterator {
@@ -13024,6 +15066,10 @@ type stringReduceBooleanPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -13072,26 +15118,121 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &stringReduceBooleanPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *stringReduceBooleanIterator) reduceSliding() ([]BooleanPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateString(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*stringReduceBooleanPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *stringReduceBooleanIterator) aggregate(m map[string]*stringReduceBooleanPoint, p *StringPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &stringReduceBooleanPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateString(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *stringReduceBooleanIterator) emit(m map[string]*stringReduceBooleanPoint, startTime int64) []BooleanPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -13127,8 +15268,7 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // stringStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -14275,7 +16415,7 @@ type booleanFillIterator struct {
 
 func newBooleanFillIterator(input BooleanIterator, expr influxql.Expr, opt IteratorOptions) *booleanFillIterator {
 	if opt.Fill == influxql.NullFill {
-		if expr, ok := expr.(*influxql.Call); ok && expr.Name == "count" {
+		if expr, ok := expr.(*influxql.Call); ok && (expr.Name == "count" || expr.Name == "histogram") {
 			opt.Fill = influxql.NumberFill
 			opt.FillValue = false
 		}
@@ -14403,9 +16543,9 @@ func (itr *booleanFillIterator) Next() (*BooleanPoint, error) {
 	// as there may be lingering points with the same timestamp in the previous
 	// window.
 	if itr.opt.Ascending {
-		itr.window.time += int64(itr.opt.Interval.Duration)
+		itr.window.time += int64(itr.opt.Interval.Stride())
 	} else {
-		itr.window.time -= int64(itr.opt.Interval.Duration)
+		itr.window.time -= int64(itr.opt.Interval.Stride())
 	}
 
 	// Check to see if we have passed over an offset change and adjust the time
@@ -14706,6 +16846,15 @@ type booleanReduceFloatIterator struct {
 	opt      IteratorOptions
 	points   []FloatPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []BooleanPoint
+	}
 }
 
 func newBooleanReduceFloatIterator(input BooleanIterator, opt IteratorOptions, createFn func() (BooleanPointAggregator, FloatPointEmitter)) *booleanReduceFloatIterator {
@@ -14751,6 +16900,10 @@ type booleanReduceFloatPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -14799,26 +16952,121 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &booleanReduceFloatPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *booleanReduceFloatIterator) reduceSliding() ([]FloatPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateBoolean(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*booleanReduceFloatPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
 
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *booleanReduceFloatIterator) aggregate(m map[string]*booleanReduceFloatPoint, p *BooleanPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &booleanReduceFloatPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateBoolean(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *booleanReduceFloatIterator) emit(m map[string]*booleanReduceFloatPoint, startTime int64) []FloatPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -14854,8 +17102,7 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(floatPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // booleanStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -15125,6 +17372,15 @@ type booleanReduceIntegerIterator struct {
 	opt      IteratorOptions
 	points   []IntegerPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []BooleanPoint
+	}
 }
 
 func newBooleanReduceIntegerIterator(input BooleanIterator, opt IteratorOptions, createFn func() (BooleanPointAggregator, IntegerPointEmitter)) *booleanReduceIntegerIterator {
@@ -15170,6 +17426,10 @@ type booleanReduceIntegerPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -15218,26 +17478,121 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &booleanReduceIntegerPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *booleanReduceIntegerIterator) reduceSliding() ([]IntegerPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateBoolean(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*booleanReduceIntegerPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *booleanReduceIntegerIterator) aggregate(m map[string]*booleanReduceIntegerPoint, p *BooleanPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &booleanReduceIntegerPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
 	}
+	rp.Aggregator.AggregateBoolean(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *booleanReduceIntegerIterator) emit(m map[string]*booleanReduceIntegerPoint, startTime int64) []IntegerPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -15273,8 +17628,7 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(integerPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // booleanStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -15544,6 +17898,15 @@ type booleanReduceUnsignedIterator struct {
 	opt      IteratorOptions
 	points   []UnsignedPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []BooleanPoint
+	}
 }
 
 func newBooleanReduceUnsignedIterator(input BooleanIterator, opt IteratorOptions, createFn func() (BooleanPointAggregator, UnsignedPointEmitter)) *booleanReduceUnsignedIterator {
@@ -15589,6 +17952,10 @@ type booleanReduceUnsignedPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -15637,26 +18004,121 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &booleanReduceUnsignedPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *booleanReduceUnsignedIterator) reduceSliding() ([]UnsignedPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateBoolean(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*booleanReduceUnsignedPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
+	}
+}
+
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *booleanReduceUnsignedIterator) aggregate(m map[string]*booleanReduceUnsignedPoint, p *BooleanPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &booleanReduceUnsignedPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
 	}
+	rp.Aggregator.AggregateBoolean(p)
+}
 
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *booleanReduceUnsignedIterator) emit(m map[string]*booleanReduceUnsignedPoint, startTime int64) []UnsignedPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -15692,8 +18154,7 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // booleanStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -15963,6 +18424,15 @@ type booleanReduceStringIterator struct {
 	opt      IteratorOptions
 	points   []StringPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []BooleanPoint
+	}
 }
 
 func newBooleanReduceStringIterator(input BooleanIterator, opt IteratorOptions, createFn func() (BooleanPointAggregator, StringPointEmitter)) *booleanReduceStringIterator {
@@ -16008,6 +18478,10 @@ type booleanReduceStringPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -16056,26 +18530,121 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &booleanReduceStringPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *booleanReduceStringIterator) reduceSliding() ([]StringPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateBoolean(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*booleanReduceStringPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
 
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *booleanReduceStringIterator) aggregate(m map[string]*booleanReduceStringPoint, p *BooleanPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &booleanReduceStringPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateBoolean(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *booleanReduceStringIterator) emit(m map[string]*booleanReduceStringPoint, startTime int64) []StringPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -16111,8 +18680,7 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(stringPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // booleanStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -16382,6 +18950,15 @@ type booleanReduceBooleanIterator struct {
 	opt      IteratorOptions
 	points   []BooleanPoint
 	keepTags bool
+
+	// sliding holds the points of the current series when windows overlap
+	// so each point is read once and shared by every window it falls into.
+	sliding struct {
+		name   string
+		tags   string
+		start  int64
+		points []BooleanPoint
+	}
 }
 
 func newBooleanReduceBooleanIterator(input BooleanIterator, opt IteratorOptions, createFn func() (BooleanPointAggregator, BooleanPointEmitter)) *booleanReduceBooleanIterator {
@@ -16427,6 +19004,10 @@ type booleanReduceBooleanPoint struct {
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
 func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+	if itr.opt.Interval.Sliding() {
+		return itr.reduceSliding()
+	}
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -16475,26 +19056,121 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			break
 		}
 
-		// Retrieve the tags on this point for this level of the query.
-		// This may be different than the bucket dimensions.
-		tags := curr.Tags.Subset(itr.dims)
-		id := tags.ID()
+		itr.aggregate(m, curr)
+	}
+	return itr.emit(m, startTime), nil
+}
 
-		// Retrieve the aggregator for this name/tag combination or create one.
-		rp := m[id]
-		if rp == nil {
-			aggregator, emitter := itr.create()
-			rp = &booleanReduceBooleanPoint{
-				Name:       curr.Name,
-				Tags:       tags,
-				Aggregator: aggregator,
-				Emitter:    emitter,
+// reduceSliding executes the reducer for the next window of a series when
+// windows overlap. The points of the series are buffered until no remaining
+// window contains them. Windows are a whole number of steps long so the input
+// only needs to be ordered by step.
+func (itr *booleanReduceBooleanIterator) reduceSliding() ([]BooleanPoint, error) {
+	w := &itr.sliding
+	duration, stride := int64(itr.opt.Interval.Duration), int64(itr.opt.Interval.Stride())
+	first, _ := itr.opt.Window(itr.opt.StartTime)
+	for {
+		// Begin a new series with the earliest window that contains the next
+		// point if there are no buffered points left.
+		if len(w.points) == 0 {
+			p, err := itr.input.Next()
+			if err != nil || p == nil {
+				return nil, err
+			} else if p.Nil {
+				continue
+			}
+			itr.input.unread(p)
+
+			w.name, w.tags = p.Name, p.Tags.Subset(itr.opt.Dimensions).ID()
+			w.start, _ = itr.opt.Window(p.Time)
+			if itr.opt.Ascending {
+				w.start -= duration - stride
+				if w.start < first {
+					w.start = first
+				}
 			}
-			m[id] = rp
 		}
-		rp.Aggregator.AggregateBoolean(curr)
+		end := w.start + duration
+
+		// Buffer the points of the series until the window is complete.
+		for {
+			p, err := itr.input.Next()
+			if err != nil {
+				return nil, err
+			} else if p == nil {
+				break
+			} else if p.Nil {
+				continue
+			} else if p.Name != w.name || p.Tags.Subset(itr.opt.Dimensions).ID() != w.tags ||
+				(itr.opt.Ascending && p.Time >= end) || (!itr.opt.Ascending && p.Time < w.start) {
+				itr.input.unread(p)
+				break
+			}
+			w.points = append(w.points, *p)
+		}
+
+		// Aggregate the buffered points that fall within the window.
+		m := make(map[string]*booleanReduceBooleanPoint)
+		for i := range w.points {
+			if p := w.points[i]; p.Time >= w.start && p.Time < end {
+				itr.aggregate(m, &p)
+			}
+		}
+		startTime := w.start
+
+		// Move to the next window and drop the points it no longer covers.
+		if itr.opt.Ascending {
+			w.start += stride
+		} else {
+			w.start -= stride
+		}
+		n := 0
+		for _, p := range w.points {
+			if p.Time >= w.start && p.Time < w.start+duration {
+				w.points[n] = p
+				n++
+			}
+		}
+		w.points = w.points[:n]
+		if !itr.opt.Ascending && w.start < first {
+			w.points = w.points[:0]
+		}
+
+		// Report every point at the start of its window. The interval iterator
+		// cannot do this for overlapping windows as a time falls into several.
+		if a := itr.emit(m, startTime); len(a) > 0 {
+			for i := range a {
+				a[i].Time = startTime
+			}
+			return a, nil
+		}
 	}
+}
 
+// aggregate passes the point to the aggregator for its name/tag combination.
+func (itr *booleanReduceBooleanIterator) aggregate(m map[string]*booleanReduceBooleanPoint, p *BooleanPoint) {
+	// Retrieve the tags on this point for this level of the query.
+	// This may be different than the bucket dimensions.
+	tags := p.Tags.Subset(itr.dims)
+	id := tags.ID()
+
+	// Retrieve the aggregator for this name/tag combination or create one.
+	rp := m[id]
+	if rp == nil {
+		aggregator, emitter := itr.create()
+		rp = &booleanReduceBooleanPoint{
+			Name:       p.Name,
+			Tags:       tags,
+			Aggregator: aggregator,
+			Emitter:    emitter,
+		}
+		m[id] = rp
+	}
+	rp.Aggregator.AggregateBoolean(p)
+}
+
+// emit returns the points emitted for each name/tag combination of the window.
+func (itr *booleanReduceBooleanIterator) emit(m map[string]*booleanReduceBooleanPoint, startTime int64) []BooleanPoint {
 	// Reverse sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -16530,8 +19206,7 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	if !sortedByTime && itr.opt.Ordered {
 		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
 	}
-
-	return a, nil
+	return a
 }
 
 // booleanStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -137,6 +137,11 @@ func (a Iterators) Merge(opt IteratorOptions) (Iterator, error) {
 		return itr, nil
 	}
 
+	// Partial histograms are merged by summing the counts of each bucket.
+	if call.Name == "histogram" {
+		return newHistogramMergeIterator(itr, opt)
+	}
+
 	// When merging the count() function, use sum() to sum the counted points.
 	if call.Name == "count" {
 		opt.Expr = &influxql.Call{
@@ -749,20 +754,14 @@ func newIteratorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 	opt.Location = stmt.Location
 
 	// Determine group by interval.
-	interval, err := stmt.GroupByInterval()
+	interval, err := GroupByInterval(stmt)
 	if err != nil {
 		return opt, err
 	}
 	// Set duration to zero if a negative interval has been used.
-	if interval < 0 {
-		interval = 0
-	} else if interval > 0 {
-		opt.Interval.Offset, err = stmt.GroupByOffset()
-		if err != nil {
-			return opt, err
-		}
+	if interval.Duration > 0 {
+		opt.Interval = interval
 	}
-	opt.Interval.Duration = interval
 
 	// Always request an ordered output for the top level iterators.
 	// The emitter will always emit points as ordered.
@@ -856,15 +855,61 @@ func newIteratorOptionsSubstatement(stmt *influxql.SelectStatement, opt Iterator
 
 	// If there is no interval for this subquery, but the outer query has an
 	// interval, inherit the parent interval.
-	interval, err := stmt.GroupByInterval()
+	interval, err := GroupByInterval(stmt)
 	if err != nil {
 		return IteratorOptions{}, err
-	} else if interval == 0 {
+	} else if interval.IsZero() {
 		subOpt.Interval = opt.Interval
 	}
 	return subOpt, nil
 }
 
+// GroupByInterval returns the interval of the time dimension of the
+// statement. influxql only accepts time(interval[, offset]), so the sliding
+// form time(interval, offset, step) is parsed here; its offset aligns the
+// windows to the step rather than to the interval.
+func GroupByInterval(stmt *influxql.SelectStatement) (Interval, error) {
+	call := groupByTimeCall(stmt)
+	if call == nil || len(call.Args) != 3 {
+		interval, err := stmt.GroupByInterval()
+		if err != nil || interval <= 0 {
+			return Interval{Duration: interval}, err
+		}
+		offset, err := stmt.GroupByOffset()
+		return Interval{Duration: interval, Offset: offset}, err
+	}
+
+	interval, ok := call.Args[0].(*influxql.DurationLiteral)
+	if !ok {
+		return Interval{}, errors.New("time dimension must have duration argument")
+	}
+	step, ok := call.Args[2].(*influxql.DurationLiteral)
+	if !ok || step.Val <= 0 {
+		return Interval{}, fmt.Errorf("invalid time dimension step: %s", call.Args[2])
+	}
+
+	var offset time.Duration
+	switch expr := call.Args[1].(type) {
+	case *influxql.DurationLiteral:
+		offset = expr.Val % step.Val
+	case *influxql.TimeLiteral:
+		offset = expr.Val.Sub(expr.Val.Truncate(step.Val))
+	default:
+		return Interval{}, fmt.Errorf("invalid time dimension offset: %s", expr)
+	}
+	return Interval{Duration: interval.Val, Offset: offset, Step: step.Val}, nil
+}
+
+// groupByTimeCall returns the time() call in the dimensions of the statement.
+func groupByTimeCall(stmt *influxql.SelectStatement) *influxql.Call {
+	for _, d := range stmt.Dimensions {
+		if call, ok := d.Expr.(*influxql.Call); ok && call.Name == "time" {
+			return call
+		}
+	}
+	return nil
+}
+
 // MergeSorted returns true if the options require a sorted merge.
 func (opt IteratorOptions) MergeSorted() bool {
 	return opt.Ordered
@@ -894,12 +939,14 @@ func (opt IteratorOptions) Window(t int64) (start, end int64) {
 		_, zone = opt.Zone(t)
 	}
 
-	// Truncate time by duration.
-	dt := (t + zone) % int64(opt.Interval.Duration)
+	// Truncate time by the stride. When windows overlap, this finds the
+	// latest window that contains the time.
+	stride := int64(opt.Interval.Stride())
+	dt := (t + zone) % stride
 	if dt < 0 {
 		// Negative modulo rounds up instead of down, so offset
-		// with the duration.
-		dt += int64(opt.Interval.Duration)
+		// with the stride.
+		dt += stride
 	}
 
 	// Find the start time.
@@ -973,6 +1020,16 @@ func (opt IteratorOptions) DerivativeInterval() Interval {
 	return Interval{Duration: time.Second}
 }
 
+// RateInterval returns the time interval for the rate function.
+func (opt IteratorOptions) RateInterval() Interval {
+	// Use the interval on the rate() call, if specified.
+	if expr, ok := opt.Expr.(*influxql.Call); ok && len(expr.Args) == 2 {
+		return Interval{Duration: expr.Args[1].(*influxql.DurationLiteral).Val}
+	}
+
+	return Interval{Duration: time.Second}
+}
+
 // ElapsedInterval returns the time interval for the elapsed function.
 func (opt IteratorOptions) ElapsedInterval() Interval {
 	// Use the interval on the elapsed() call, if specified.
@@ -1247,6 +1304,10 @@ func newSelectInfo(stmt *influxql.SelectStatement) *selectInfo {
 func (v *selectInfo) Visit(n influxql.Node) influxql.Visitor {
 	switch n := n.(type) {
 	case *influxql.Call:
+		// Scalar functions are applied to the results of their arguments.
+		if isScalarFunction(n.Name) {
+			return v
+		}
 		v.calls[n] = struct{}{}
 		return nil
 	case *influxql.VarRef:
@@ -1276,15 +1337,31 @@ func (v *selectInfo) FindSelector() *influxql.Call {
 type Interval struct {
 	Duration time.Duration
 	Offset   time.Duration
+
+	// Step is the distance between the start of consecutive windows.
+	// Windows overlap when the step is smaller than the duration.
+	Step time.Duration
 }
 
 // IsZero returns true if the interval has no duration.
 func (i Interval) IsZero() bool { return i.Duration == 0 }
 
+// Stride returns the distance between the start of consecutive windows.
+func (i Interval) Stride() time.Duration {
+	if i.Step > 0 {
+		return i.Step
+	}
+	return i.Duration
+}
+
+// Sliding returns true if consecutive windows overlap.
+func (i Interval) Sliding() bool { return i.Step > 0 && i.Step < i.Duration }
+
 func encodeInterval(i Interval) *internal.Interval {
 	return &internal.Interval{
 		Duration: proto.Int64(i.Duration.Nanoseconds()),
 		Offset:   proto.Int64(i.Offset.Nanoseconds()),
+		Step:     proto.Int64(i.Step.Nanoseconds()),
 	}
 }
 
@@ -1292,6 +1369,7 @@ func decodeInterval(pb *internal.Interval) Interval {
 	return Interval{
 		Duration: time.Duration(pb.GetDuration()),
 		Offset:   time.Duration(pb.GetOffset()),
+		Step:     time.Duration(pb.GetStep()),
 	}
 }
 
@@ -1408,25 +1486,38 @@ func (itr *unsignedFloatCastIterator) Next() (*FloatPoint, error) {
 type IteratorStats struct {
 	SeriesN int // series represented
 	PointN  int // points returned
+
+	BlocksN    int           // storage blocks decoded
+	BlockBytes int64         // bytes of storage blocks read
+	CursorTime time.Duration // time spent reading blocks in cursors
 }
 
 // Add aggregates fields from s and other together. Overwrites s.
 func (s *IteratorStats) Add(other IteratorStats) {
 	s.SeriesN += other.SeriesN
 	s.PointN += other.PointN
+	s.BlocksN += other.BlocksN
+	s.BlockBytes += other.BlockBytes
+	s.CursorTime += other.CursorTime
 }
 
 func encodeIteratorStats(stats *IteratorStats) *internal.IteratorStats {
 	return &internal.IteratorStats{
-		SeriesN: proto.Int64(int64(stats.SeriesN)),
-		PointN:  proto.Int64(int64(stats.PointN)),
+		SeriesN:    proto.Int64(int64(stats.SeriesN)),
+		PointN:     proto.Int64(int64(stats.PointN)),
+		BlocksN:    proto.Int64(int64(stats.BlocksN)),
+		BlockBytes: proto.Int64(stats.BlockBytes),
+		CursorTime: proto.Int64(int64(stats.CursorTime)),
 	}
 }
 
 func decodeIteratorStats(pb *internal.IteratorStats) IteratorStats {
 	return IteratorStats{
-		SeriesN: int(pb.GetSeriesN()),
-		PointN:  int(pb.GetPointN()),
+		SeriesN:    int(pb.GetSeriesN()),
+		PointN:     int(pb.GetPointN()),
+		BlocksN:    int(pb.GetBlocksN()),
+		BlockBytes: pb.GetBlockBytes(),
+		CursorTime: time.Duration(pb.GetCursorTime()),
 	}
 }
 
@@ -1513,8 +1513,11 @@ func TestIterator_EncodeDecode(t *testing.T) {
 			{Name: "mem", Tags: ParseTags("host=B"), Time: 1, Value: 10},
 		},
 		stats: query.IteratorStats{
-			SeriesN: 2,
-			PointN:  0,
+			SeriesN:    2,
+			PointN:     0,
+			BlocksN:    3,
+			BlockBytes: 4096,
+			CursorTime: time.Millisecond,
 		},
 	}
 
@@ -1530,7 +1533,7 @@ func TestIterator_EncodeDecode(t *testing.T) {
 
 	// Initial stats should exist immediately.
 	fdec := dec.(query.FloatIterator)
-	if stats := fdec.Stats(); !reflect.DeepEqual(stats, query.IteratorStats{SeriesN: 2, PointN: 0}) {
+	if stats := fdec.Stats(); !reflect.DeepEqual(stats, query.IteratorStats{SeriesN: 2, PointN: 0, BlocksN: 3, BlockBytes: 4096, CursorTime: time.Millisecond}) {
 		t.Fatalf("unexpected stats(initial): %#v", stats)
 	}
 
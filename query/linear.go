@@ -24,8 +24,8 @@ func linearInteger(windowTime, previousTime, nextTime int64, previousValue, next
 // and returns the value of the point on the line with time windowTime
 // y = mx + b
 func linearUnsigned(windowTime, previousTime, nextTime int64, previousValue, nextValue uint64) uint64 {
-	m := float64(nextValue-previousValue) / float64(nextTime-previousTime) // the slope of the line
-	x := float64(windowTime - previousTime)                                // how far into the interval we are
+	m := (float64(nextValue) - float64(previousValue)) / float64(nextTime-previousTime) // the slope of the line
+	x := float64(windowTime - previousTime)                                             // how far into the interval we are
 	b := float64(previousValue)
 	return uint64(m*x + b)
 }
@@ -21,3 +21,37 @@ func PointLimitMonitor(itrs Iterators, interval time.Duration, limit int) QueryM
 		}
 	}
 }
+
+// CostLimits holds the per-query limits on the cost of reading blocks from
+// storage. A zero value disables the corresponding limit.
+type CostLimits struct {
+	MaxBlocksN    int
+	MaxBytesN     int64
+	MaxCursorTime time.Duration
+}
+
+// CostLimitMonitor is a query monitor that exits when the blocks decoded, the
+// block bytes read or the time spent reading blocks exceeds its limit.
+func CostLimitMonitor(itrs Iterators, interval time.Duration, limits CostLimits) QueryMonitorFunc {
+	return func(closing <-chan struct{}) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				stats := itrs.Stats()
+				if limits.MaxBlocksN > 0 && stats.BlocksN >= limits.MaxBlocksN {
+					return ErrMaxSelectBlocksLimitExceeded(stats.BlocksN, limits.MaxBlocksN)
+				}
+				if limits.MaxBytesN > 0 && stats.BlockBytes >= limits.MaxBytesN {
+					return ErrMaxSelectBytesLimitExceeded(stats.BlockBytes, limits.MaxBytesN)
+				}
+				if limits.MaxCursorTime > 0 && stats.CursorTime >= limits.MaxCursorTime {
+					return ErrMaxSelectCursorTimeLimitExceeded(stats.CursorTime, limits.MaxCursorTime)
+				}
+			case <-closing:
+				return nil
+			}
+		}
+	}
+}
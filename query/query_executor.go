@@ -37,6 +37,10 @@ var (
 	// ErrQueryTimeoutLimitExceeded is an error when a query hits the max time allowed to run.
 	ErrQueryTimeoutLimitExceeded = errors.New("query-timeout limit exceeded")
 
+	// ErrQueryQueueTimeoutLimitExceeded is an error when a query waits too
+	// long for a free query slot.
+	ErrQueryQueueTimeoutLimitExceeded = errors.New("query-queue-timeout limit exceeded")
+
 	// ErrAlreadyKilled is returned when attempting to kill a query that has already been killed.
 	ErrAlreadyKilled = errors.New("already killed")
 )
@@ -49,6 +53,9 @@ const (
 	statQueryExecutionDuration = "queryDurationNs" // Total (wall) time spent executing queries.
 	statRecoveredPanics        = "recoveredPanics" // Number of panics recovered by Query Executor.
 
+	statQueriesQueued     = "queriesQueued"       // Number of queries waiting for a query slot.
+	statQueueWaitDuration = "queueWaitDurationNs" // Total (wall) time queries spent waiting for a query slot.
+
 	// PanicCrashEnv is the environment variable that, when set, will prevent
 	// the handler from recovering any panics.
 	PanicCrashEnv = "INFLUXDB_PANIC_CRASH"
@@ -62,6 +69,24 @@ func ErrMaxSelectPointsLimitExceeded(n, limit int) error {
 	return fmt.Errorf("max-select-point limit exceeed: (%d/%d)", n, limit)
 }
 
+// ErrMaxSelectBlocksLimitExceeded is an error when a query hits the maximum
+// number of blocks decoded.
+func ErrMaxSelectBlocksLimitExceeded(n, limit int) error {
+	return fmt.Errorf("max-select-blocks limit exceeded: (%d/%d)", n, limit)
+}
+
+// ErrMaxSelectBytesLimitExceeded is an error when a query hits the maximum
+// number of block bytes read.
+func ErrMaxSelectBytesLimitExceeded(n, limit int64) error {
+	return fmt.Errorf("max-select-bytes limit exceeded: (%d/%d)", n, limit)
+}
+
+// ErrMaxSelectCursorTimeLimitExceeded is an error when a query hits the
+// maximum time spent reading blocks.
+func ErrMaxSelectCursorTimeLimitExceeded(d, limit time.Duration) error {
+	return fmt.Errorf("max-select-cursor-time limit exceeded: (%s/%s)", d, limit)
+}
+
 // ErrMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
 // because the maximum number of queries has been reached.
 func ErrMaxConcurrentQueriesLimitExceeded(n, limit int) error {
@@ -135,6 +160,9 @@ type ExecutionOptions struct {
 	// Quiet suppresses non-essential output from the query executor.
 	Quiet bool
 
+	// Priority of the query when waiting for a free query slot.
+	Priority QueryPriority
+
 	// AbortCh is a channel that signals when results are no longer desired by the caller.
 	AbortCh <-chan struct{}
 }
@@ -188,6 +216,7 @@ type contextKey int
 
 const (
 	iteratorsContextKey contextKey = iota
+	cursorTimeContextKey
 )
 
 // NewContextWithIterators returns a new context.Context with the *Iterators slice added.
@@ -196,6 +225,13 @@ func NewContextWithIterators(ctx context.Context, itr *Iterators) context.Contex
 	return context.WithValue(ctx, iteratorsContextKey, itr)
 }
 
+// IteratorsFromContext returns the *Iterators slice added to the context with
+// NewContextWithIterators, or nil if there is none.
+func IteratorsFromContext(ctx context.Context) *Iterators {
+	v, _ := ctx.Value(iteratorsContextKey).(*Iterators)
+	return v
+}
+
 // tryAddAuxIteratorToContext will capture itr in the *Iterators slice, when configured
 // with a call to NewContextWithIterators.
 func tryAddAuxIteratorToContext(ctx context.Context, itr AuxIterator) {
@@ -204,6 +240,19 @@ func tryAddAuxIteratorToContext(ctx context.Context, itr AuxIterator) {
 	}
 }
 
+// NewContextWithCursorTime returns a new context.Context that asks the storage
+// engine to time the blocks read by the query's cursors. Timing every block
+// read has a cost, so it is only recorded when a limit or log needs it.
+func NewContextWithCursorTime(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cursorTimeContextKey, true)
+}
+
+// CursorTimeFromContext reports whether the blocks read with ctx are timed.
+func CursorTimeFromContext(ctx context.Context) bool {
+	v, _ := ctx.Value(cursorTimeContextKey).(bool)
+	return v
+}
+
 // StatementExecutor executes a statement within the QueryExecutor.
 type StatementExecutor interface {
 	// ExecuteStatement executes a statement. Results should be sent to the
@@ -263,6 +312,8 @@ func (e *QueryExecutor) Statistics(tags map[string]string) []models.Statistic {
 			statQueriesFinished:        atomic.LoadInt64(&e.stats.FinishedQueries),
 			statQueryExecutionDuration: atomic.LoadInt64(&e.stats.QueryExecutionDuration),
 			statRecoveredPanics:        atomic.LoadInt64(&e.stats.RecoveredPanics),
+			statQueriesQueued:          int64(e.TaskManager.QueuedQueries()),
+			statQueueWaitDuration:      atomic.LoadInt64(&e.TaskManager.queueWaitDuration),
 		},
 	}}
 }
@@ -298,7 +349,7 @@ func (e *QueryExecutor) executeQuery(query *influxql.Query, opt ExecutionOptions
 		atomic.AddInt64(&e.stats.QueryExecutionDuration, time.Since(start).Nanoseconds())
 	}(time.Now())
 
-	qid, task, err := e.TaskManager.AttachQuery(query, opt.Database, closing)
+	qid, task, err := e.TaskManager.AttachQuery(query, opt, closing)
 	if err != nil {
 		select {
 		case results <- &Result{Err: err}:
@@ -473,14 +524,35 @@ type QueryMonitorFunc func(<-chan struct{}) error
 type QueryTask struct {
 	query     string
 	database  string
+	priority  QueryPriority
 	status    TaskStatus
+	queueTime time.Time
 	startTime time.Time
+	waitTime  time.Duration
+	admitted  chan struct{}
 	closing   chan struct{}
 	monitorCh chan error
+	itrs      Iterators
 	err       error
 	mu        sync.Mutex
 }
 
+// SetIterators records the iterators used by the query so their stats can
+// be reported while the query is running.
+func (q *QueryTask) SetIterators(itrs Iterators) {
+	q.mu.Lock()
+	q.itrs = append(q.itrs, itrs...)
+	q.mu.Unlock()
+}
+
+// Stats returns the combined stats of the iterators used by the query.
+func (q *QueryTask) Stats() IteratorStats {
+	q.mu.Lock()
+	itrs := q.itrs
+	q.mu.Unlock()
+	return itrs.Stats()
+}
+
 // Monitor starts a new goroutine that will monitor a query. The function
 // will be passed in a channel to signal when the query has been finished
 // normally. If the function returns with an error and the query is still
@@ -3,6 +3,7 @@ package query_test
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -339,6 +340,65 @@ func TestQueryExecutor_ShowQueries(t *testing.T) {
 	}
 }
 
+func TestQueryExecutor_ShowQueries_BlockStats(t *testing.T) {
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			switch stmt.(type) {
+			case *influxql.ShowQueriesStatement:
+				return e.TaskManager.ExecuteStatement(stmt, ctx)
+			}
+
+			ctx.Query.SetIterators(query.Iterators{
+				&FloatIterator{stats: query.IteratorStats{BlocksN: 2, BlockBytes: 1024, CursorTime: time.Millisecond}},
+				&FloatIterator{stats: query.IteratorStats{BlocksN: 1, BlockBytes: 3072, CursorTime: 2 * time.Millisecond}},
+			})
+			close(started)
+			<-done
+			return nil
+		},
+	}
+
+	results := e.ExecuteQuery(q, query.ExecutionOptions{}, nil)
+	<-started
+
+	q, err = influxql.ParseQuery(`SHOW QUERIES`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := <-e.ExecuteQuery(q, query.ExecutionOptions{}, nil)
+	close(done)
+	discardOutput(results)
+
+	if result.Err != nil {
+		t.Fatalf("unexpected error: %s", result.Err)
+	} else if len(result.Series) != 1 {
+		t.Fatalf("expected %d series, got %d", 1, len(result.Series))
+	}
+	row := result.Series[0]
+	if got, exp := row.Columns[5:8], []string{"blocks", "bytes", "cursor_time"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected columns: got %v, exp %v", got, exp)
+	}
+	for _, v := range row.Values {
+		if v[1] != `SELECT count(value) FROM cpu` {
+			continue
+		}
+		if got, exp := v[5:8], []interface{}{3, int64(4096), "3ms"}; !reflect.DeepEqual(got, exp) {
+			t.Fatalf("unexpected block stats: got %v, exp %v", got, exp)
+		}
+		return
+	}
+	t.Fatalf("query not found: %v", row.Values)
+}
+
 func TestQueryExecutor_Limit_Timeout(t *testing.T) {
 	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
 	if err != nil {
@@ -405,6 +465,239 @@ func TestQueryExecutor_Limit_ConcurrentQueries(t *testing.T) {
 	}
 }
 
+func TestQueryExecutor_Limit_QueuedQueries(t *testing.T) {
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	qid := make(chan uint64)
+	done := make(chan struct{})
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			qid <- ctx.QueryID
+			<-done
+			return nil
+		},
+	}
+	e.TaskManager.MaxConcurrentQueries = 1
+	e.TaskManager.MaxQueuedQueries = 1
+	defer e.Close()
+
+	// Start first query and wait for it to be executing.
+	go discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{}, nil))
+	<-qid
+
+	// Start second query and wait for it to be queued.
+	go discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{}, nil))
+	for i := 0; e.TaskManager.QueuedQueries() == 0; i++ {
+		if i == 100 {
+			t.Fatal("second query was not queued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// The queue is full so a third query should fail.
+	result := <-e.ExecuteQuery(q, query.ExecutionOptions{}, nil)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "max-concurrent-queries") {
+		t.Errorf("unexpected error: %s", result.Err)
+	}
+
+	// The queued query should be displayed with its status. This is run
+	// against the task manager directly as there is no slot for it.
+	results := make(chan *query.Result, 1)
+	if err := e.TaskManager.ExecuteStatement(&influxql.ShowQueriesStatement{}, query.ExecutionContext{Results: results}); err != nil {
+		t.Fatal(err)
+	}
+	result = <-results
+	if len(result.Series) != 1 {
+		t.Fatalf("expected %d series, got %d", 1, len(result.Series))
+	}
+	var queued int
+	for _, row := range result.Series[0].Values {
+		if row[4] == "queued" {
+			queued++
+		}
+	}
+	if queued != 1 {
+		t.Errorf("expected %d queued query, got %d", 1, queued)
+	}
+
+	// Finishing the first query should start the second.
+	done <- struct{}{}
+	select {
+	case <-qid:
+	case <-time.After(time.Second):
+		t.Fatal("queued query was not started")
+	}
+	close(done)
+}
+
+func TestQueryExecutor_Limit_QueueTimeout(t *testing.T) {
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	qid := make(chan uint64)
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			qid <- ctx.QueryID
+			<-ctx.InterruptCh
+			return query.ErrQueryInterrupted
+		},
+	}
+	e.TaskManager.MaxConcurrentQueries = 1
+	e.TaskManager.MaxQueuedQueries = 1
+	e.TaskManager.QueueTimeout = time.Millisecond
+	defer e.Close()
+
+	go discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{}, nil))
+	<-qid
+
+	select {
+	case result := <-e.ExecuteQuery(q, query.ExecutionOptions{}, nil):
+		if result.Err != query.ErrQueryQueueTimeoutLimitExceeded {
+			t.Errorf("unexpected error: %s", result.Err)
+		}
+	case <-qid:
+		t.Errorf("unexpected statement execution for the second query")
+	}
+}
+
+func TestQueryExecutor_Limit_QueuePriority(t *testing.T) {
+	started := make(chan string)
+	done := make(chan struct{})
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			started <- stmt.(*influxql.SelectStatement).Sources.Measurements()[0].Name
+			<-done
+			return nil
+		},
+	}
+	e.TaskManager.MaxConcurrentQueries = 1
+	e.TaskManager.MaxQueuedQueries = 2
+	defer e.Close()
+
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM first`), query.ExecutionOptions{}, nil))
+	<-started
+
+	// Queue a batch query before an interactive one.
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM batch`), query.ExecutionOptions{Priority: query.BatchPriority}, nil))
+	waitForQueuedQueries(t, e, 1)
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM interactive`), query.ExecutionOptions{}, nil))
+	waitForQueuedQueries(t, e, 2)
+
+	// The interactive query should be started first.
+	for _, exp := range []string{"interactive", "batch"} {
+		done <- struct{}{}
+		select {
+		case name := <-started:
+			if name != exp {
+				t.Fatalf("unexpected query started: got %s, exp %s", name, exp)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("queued query %s was not started", exp)
+		}
+	}
+	close(done)
+}
+
+func TestQueryExecutor_Limit_QueueEviction(t *testing.T) {
+	started := make(chan string)
+	done := make(chan struct{})
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			started <- stmt.(*influxql.SelectStatement).Sources.Measurements()[0].Name
+			<-done
+			return nil
+		},
+	}
+	e.TaskManager.MaxConcurrentQueries = 1
+	e.TaskManager.MaxQueuedQueries = 1
+	defer e.Close()
+
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM first`), query.ExecutionOptions{}, nil))
+	<-started
+
+	batch := e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM batch`), query.ExecutionOptions{Priority: query.BatchPriority}, nil)
+	waitForQueuedQueries(t, e, 1)
+
+	// The queue is full, so the interactive query should take the place of
+	// the batch query.
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM interactive`), query.ExecutionOptions{}, nil))
+	select {
+	case result := <-batch:
+		if result.Err == nil || !strings.Contains(result.Err.Error(), "max-concurrent-queries") {
+			t.Errorf("unexpected error: %s", result.Err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("batch query was not evicted")
+	}
+
+	// A query with the same priority as the queued one is rejected instead.
+	result := <-e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM other`), query.ExecutionOptions{}, nil)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "max-concurrent-queries") {
+		t.Errorf("unexpected error: %s", result.Err)
+	}
+
+	done <- struct{}{}
+	select {
+	case name := <-started:
+		if name != "interactive" {
+			t.Fatalf("unexpected query started: %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("queued query was not started")
+	}
+	close(done)
+}
+
+func TestQueryExecutor_Limit_ReservedContinuousQueries(t *testing.T) {
+	started := make(chan string)
+	done := make(chan struct{})
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+			started <- stmt.(*influxql.SelectStatement).Sources.Measurements()[0].Name
+			<-done
+			return nil
+		},
+	}
+	e.TaskManager.MaxConcurrentQueries = 2
+	e.TaskManager.ReservedContinuousQueries = 1
+	defer e.Close()
+	defer close(done)
+
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM first`), query.ExecutionOptions{}, nil))
+	<-started
+
+	// The remaining slot is reserved for continuous queries.
+	result := <-e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM second`), query.ExecutionOptions{}, nil)
+	if result.Err == nil || !strings.Contains(result.Err.Error(), "max-concurrent-queries") {
+		t.Errorf("unexpected error: %s", result.Err)
+	}
+
+	go discardOutput(e.ExecuteQuery(mustParseQuery(`SELECT count(value) FROM cq`), query.ExecutionOptions{Priority: query.ContinuousQueryPriority}, nil))
+	select {
+	case name := <-started:
+		if name != "cq" {
+			t.Fatalf("unexpected query started: %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("continuous query was not started")
+	}
+}
+
 func TestQueryExecutor_Close(t *testing.T) {
 	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
 	if err != nil {
@@ -533,3 +826,23 @@ func discardOutput(results <-chan *query.Result) {
 		// Read all results and discard.
 	}
 }
+
+// mustParseQuery parses a query. Panic on error.
+func mustParseQuery(s string) *influxql.Query {
+	q, err := influxql.ParseQuery(s)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// waitForQueuedQueries waits until n queries are queued by the executor.
+func waitForQueuedQueries(t *testing.T, e *query.QueryExecutor, n int) {
+	t.Helper()
+	for i := 0; e.TaskManager.QueuedQueries() != n; i++ {
+		if i == 1000 {
+			t.Fatalf("expected %d queued queries, got %d", n, e.TaskManager.QueuedQueries())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/influxdata/influxql"
+)
+
+// ShowRejectedPointsStatement is a SHOW REJECTED POINTS statement listing the
+// points dropped from writes to a database, from oldest to newest:
+//
+//	SHOW REJECTED POINTS [ON db]
+type ShowRejectedPointsStatement struct {
+	// Statement is always nil. It provides the unexported methods that
+	// implementations of influxql.Statement require.
+	influxql.Statement
+
+	// Database is the database of the rejected points log.
+	Database string
+}
+
+// String returns a string representation of the statement.
+func (s *ShowRejectedPointsStatement) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("SHOW REJECTED POINTS")
+	if s.Database != "" {
+		buf.WriteString(" ON ")
+		buf.WriteString(influxql.QuoteIdent(s.Database))
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute the statement.
+func (s *ShowRejectedPointsStatement) RequiredPrivileges() (influxql.ExecutionPrivileges, error) {
+	return influxql.ExecutionPrivileges{{Admin: false, Name: s.Database, Privilege: influxql.ReadPrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowRejectedPointsStatement) DefaultDatabase() string {
+	return s.Database
+}
+
+func init() {
+	// REJECTED isn't an influxql keyword, so the statement is parsed from the
+	// identifiers following SHOW.
+	influxql.Language.Group(influxql.SHOW).Handle(influxql.IDENT, func(p *influxql.Parser) (influxql.Statement, error) {
+		p.Unscan()
+		for _, word := range []string{"REJECTED", "POINTS"} {
+			if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != influxql.IDENT || !strings.EqualFold(lit, word) {
+				return nil, &influxql.ParseError{Found: lit, Expected: []string{word}, Pos: pos}
+			}
+		}
+
+		stmt := &ShowRejectedPointsStatement{}
+		if tok, _, _ := p.ScanIgnoreWhitespace(); tok != influxql.ON {
+			p.Unscan()
+			return stmt, nil
+		}
+
+		database, err := p.ParseIdent()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Database = database
+		return stmt, nil
+	})
+}
@@ -0,0 +1,41 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxql"
+)
+
+func TestShowRejectedPointsStatement_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		s   string
+		db  string
+		str string
+	}{
+		{s: `SHOW REJECTED POINTS`, str: `SHOW REJECTED POINTS`},
+		{s: `show rejected points ON db0`, db: "db0", str: `SHOW REJECTED POINTS ON db0`},
+	} {
+		q, err := influxql.ParseQuery(tt.s + "; SHOW DATABASES")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.s, err)
+		} else if len(q.Statements) != 2 {
+			t.Fatalf("%s: unexpected statements: %s", tt.s, q)
+		}
+
+		stmt, ok := q.Statements[0].(*query.ShowRejectedPointsStatement)
+		if !ok {
+			t.Fatalf("%s: unexpected statement type: %T", tt.s, q.Statements[0])
+		} else if stmt.Database != tt.db {
+			t.Fatalf("%s: unexpected database: %s", tt.s, stmt.Database)
+		} else if got := stmt.String(); got != tt.str {
+			t.Fatalf("unexpected string:\n got: %s\n exp: %s", got, tt.str)
+		}
+	}
+
+	for _, s := range []string{`SHOW REJECTED`, `SHOW REJECTED SERIES`, `SHOW POINTS`, `SHOW REJECTED POINTS ON`} {
+		if _, err := influxql.ParseStatement(s); err == nil {
+			t.Fatalf("%s: expected error", s)
+		}
+	}
+}
@@ -91,6 +91,11 @@ type Result struct {
 	Messages    []*Message
 	Partial     bool
 	Err         error
+
+	// ColumnTypes holds the type of each column of the series, when the
+	// statement knows them. It is not part of the encoded result and lets
+	// typed formats avoid inferring the types from the values.
+	ColumnTypes []influxql.DataType
 }
 
 // MarshalJSON encodes the result into JSON.
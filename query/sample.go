@@ -0,0 +1,72 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxql"
+)
+
+// The SAMPLE EVERY clause sets the sample interval of the interpolate() calls
+// of a SELECT statement:
+//
+//	SELECT interpolate(value, 'linear'[, max_gap]) FROM cpu WHERE ... SAMPLE EVERY 10s
+//
+// The interval is added to each call as its third argument, so the statement
+// is equivalent to, and formatted as, interpolate(value, 'linear', 10s[, max_gap]).
+func init() {
+	parse := influxql.Language.Handlers[influxql.SELECT]
+	influxql.Language.Handlers[influxql.SELECT] = func(p *influxql.Parser) (influxql.Statement, error) {
+		stmt, err := parse(p)
+		if err != nil {
+			return nil, err
+		}
+
+		// SAMPLE isn't an influxql keyword, so the clause is parsed from the
+		// identifier following the statement.
+		if tok, _, lit := p.ScanIgnoreWhitespace(); tok != influxql.IDENT || !strings.EqualFold(lit, "SAMPLE") {
+			p.Unscan()
+			return stmt, nil
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != influxql.EVERY {
+			return nil, &influxql.ParseError{Found: tokstr(tok, lit), Expected: []string{"EVERY"}, Pos: pos}
+		}
+
+		_, pos, _ := p.ScanIgnoreWhitespace()
+		p.Unscan()
+		d, err := p.ParseDuration()
+		if err != nil {
+			return nil, err
+		} else if d <= 0 {
+			return nil, &influxql.ParseError{Message: "SAMPLE EVERY duration must be positive", Pos: pos}
+		}
+
+		if err := setSampleInterval(stmt.(*influxql.SelectStatement), d); err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	}
+}
+
+// setSampleInterval adds the sample interval to the interpolate() calls of
+// the statement.
+func setSampleInterval(stmt *influxql.SelectStatement, d time.Duration) error {
+	var n int
+	influxql.WalkFunc(stmt.Fields, func(node influxql.Node) {
+		call, ok := node.(*influxql.Call)
+		if !ok || call.Name != "interpolate" || len(call.Args) < 2 {
+			return
+		}
+
+		args := make([]influxql.Expr, 0, len(call.Args)+1)
+		args = append(args, call.Args[:2]...)
+		args = append(args, &influxql.DurationLiteral{Val: d})
+		call.Args = append(args, call.Args[2:]...)
+		n++
+	})
+	if n == 0 {
+		return errors.New("SAMPLE EVERY requires an interpolate() call")
+	}
+	return nil
+}
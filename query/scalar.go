@@ -0,0 +1,458 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/influxql"
+)
+
+// scalarFunction is a function that is evaluated on every point of its first
+// argument, such as abs() or round(). The first argument may be a field, an
+// aggregate or any other expression. The remaining arguments must be literals.
+type scalarFunction struct {
+	// minArgs and maxArgs are the number of literal arguments allowed
+	// after the first argument.
+	minArgs, maxArgs int
+
+	// integerArgs is set if the literal arguments must be integers.
+	integerArgs bool
+
+	// iterator wraps the input iterator with one that applies the function.
+	iterator func(input Iterator, args []float64) (Iterator, error)
+}
+
+// scalarFunctions holds the registered scalar functions by name.
+var scalarFunctions = map[string]*scalarFunction{
+	// Functions that keep the type of a numeric input.
+	"abs": {
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			return newNumericScalarIterator("abs", input, math.Abs, func(v int64) (int64, bool) {
+				// The absolute value of the smallest integer does not fit.
+				if v == math.MinInt64 {
+					return 0, true
+				} else if v < 0 {
+					return -v, false
+				}
+				return v, false
+			}, nil)
+		},
+	},
+	"ceil": {
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			return newNumericScalarIterator("ceil", input, math.Ceil, nil, nil)
+		},
+	},
+	"floor": {
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			return newNumericScalarIterator("floor", input, math.Floor, nil, nil)
+		},
+	},
+	"round": {
+		maxArgs:     1,
+		integerArgs: true,
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			var precision int
+			if len(args) > 0 {
+				precision = int(args[0])
+			}
+			if precision >= 0 {
+				return newNumericScalarIterator("round", input, func(v float64) float64 {
+					return roundFloat(v, precision)
+				}, nil, nil)
+			}
+
+			// Integers are only affected when rounding to a power of ten,
+			// which is done without converting them to floats so that they
+			// keep their precision.
+			m := math.Pow10(-precision)
+			return newNumericScalarIterator("round", input, func(v float64) float64 {
+				return roundFloat(v/m, 0) * m
+			}, func(v int64) (int64, bool) {
+				return roundInteger(v, -precision)
+			}, func(v uint64) (uint64, bool) {
+				return roundUnsigned(v, -precision)
+			})
+		},
+	},
+
+	// Functions with a float result.
+	"sqrt":  floatScalarFunction("sqrt", math.Sqrt),
+	"exp":   floatScalarFunction("exp", math.Exp),
+	"ln":    floatScalarFunction("ln", math.Log),
+	"log2":  floatScalarFunction("log2", math.Log2),
+	"log10": floatScalarFunction("log10", math.Log10),
+	"sin":   floatScalarFunction("sin", math.Sin),
+	"cos":   floatScalarFunction("cos", math.Cos),
+	"tan":   floatScalarFunction("tan", math.Tan),
+	"asin":  floatScalarFunction("asin", math.Asin),
+	"acos":  floatScalarFunction("acos", math.Acos),
+	"atan":  floatScalarFunction("atan", math.Atan),
+	"log": {
+		minArgs: 1,
+		maxArgs: 1,
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			base := math.Log(args[0])
+			return newFloatScalarIterator("log", input, func(v float64) float64 {
+				return math.Log(v) / base
+			})
+		},
+	},
+	"pow": {
+		minArgs: 1,
+		maxArgs: 1,
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			exp := args[0]
+			return newFloatScalarIterator("pow", input, func(v float64) float64 {
+				return math.Pow(v, exp)
+			})
+		},
+	},
+
+	// String functions.
+	"lower": stringScalarFunction("lower", strings.ToLower),
+	"upper": stringScalarFunction("upper", strings.ToUpper),
+	"trim":  stringScalarFunction("trim", strings.TrimSpace),
+	"substr": {
+		minArgs:     1,
+		maxArgs:     2,
+		integerArgs: true,
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			start, length := int(args[0]), -1
+			if len(args) > 1 {
+				length = int(args[1])
+			}
+			return newStringScalarIterator("substr", input, func(s string) string {
+				return substr(s, start, length)
+			})
+		},
+	},
+	"strlen": {
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			itr, ok := input.(StringIterator)
+			if !ok {
+				return nil, fmt.Errorf("type mismatch, unable to use %T as a StringIterator in strlen()", input)
+			}
+			return &stringIntegerTransformIterator{
+				input: itr,
+				fn: func(p *StringPoint) *IntegerPoint {
+					ip := &IntegerPoint{
+						Name: p.Name,
+						Tags: p.Tags,
+						Time: p.Time,
+						Aux:  p.Aux,
+						Nil:  p.Nil,
+					}
+					if !p.Nil {
+						ip.Value = int64(utf8.RuneCountInString(p.Value))
+					}
+					return ip
+				},
+			}, nil
+		},
+	},
+}
+
+// isScalarFunction returns true if name is a scalar function.
+func isScalarFunction(name string) bool {
+	_, ok := scalarFunctions[name]
+	return ok
+}
+
+// floatScalarFunction returns a scalar function without literal arguments
+// which converts its input to a float.
+func floatScalarFunction(name string, fn func(float64) float64) *scalarFunction {
+	return &scalarFunction{
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			return newFloatScalarIterator(name, input, fn)
+		},
+	}
+}
+
+// stringScalarFunction returns a scalar function without literal arguments
+// which transforms a string.
+func stringScalarFunction(name string, fn func(string) string) *scalarFunction {
+	return &scalarFunction{
+		iterator: func(input Iterator, args []float64) (Iterator, error) {
+			return newStringScalarIterator(name, input, fn)
+		},
+	}
+}
+
+// validateScalarFunction validates the arguments of a call to a scalar
+// function and returns the values of its literal arguments.
+func validateScalarFunction(expr *influxql.Call) ([]float64, error) {
+	fn := scalarFunctions[expr.Name]
+	if fn == nil {
+		return nil, fmt.Errorf("undefined function %s()", expr.Name)
+	}
+
+	if got := len(expr.Args); got < fn.minArgs+1 || got > fn.maxArgs+1 {
+		if fn.minArgs == fn.maxArgs {
+			return nil, fmt.Errorf("invalid number of arguments for %s, expected %d, got %d", expr.Name, fn.minArgs+1, got)
+		}
+		return nil, fmt.Errorf("invalid number of arguments for %s, expected at least %d but no more than %d, got %d", expr.Name, fn.minArgs+1, fn.maxArgs+1, got)
+	}
+
+	args := make([]float64, 0, len(expr.Args)-1)
+	for i, arg := range expr.Args[1:] {
+		switch arg := arg.(type) {
+		case *influxql.IntegerLiteral:
+			args = append(args, float64(arg.Val))
+		case *influxql.NumberLiteral:
+			if fn.integerArgs {
+				return nil, fmt.Errorf("expected integer argument as argument %d in %s()", i+2, expr.Name)
+			}
+			args = append(args, arg.Val)
+		default:
+			if fn.integerArgs {
+				return nil, fmt.Errorf("expected integer argument as argument %d in %s()", i+2, expr.Name)
+			}
+			return nil, fmt.Errorf("expected number argument as argument %d in %s()", i+2, expr.Name)
+		}
+	}
+	return args, nil
+}
+
+// buildScalarIterator applies the scalar function called by expr to input.
+func buildScalarIterator(expr *influxql.Call, input Iterator) (Iterator, error) {
+	args, err := validateScalarFunction(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// A field that does not exist has no values to transform.
+	if _, ok := input.(*nilFloatIterator); ok {
+		return input, nil
+	}
+	return scalarFunctions[expr.Name].iterator(input, args)
+}
+
+// newNumericScalarIterator returns an iterator that applies a function to a
+// numeric input and keeps its type. A nil integer or unsigned function leaves
+// those values unchanged. The integer and unsigned functions return true if
+// the result does not fit the type, which is returned as a nil value.
+func newNumericScalarIterator(name string, input Iterator, ffn func(float64) float64, ifn func(int64) (int64, bool), ufn func(uint64) (uint64, bool)) (Iterator, error) {
+	switch input := input.(type) {
+	case FloatIterator:
+		return &floatTransformIterator{
+			input: input,
+			fn: func(p *FloatPoint) *FloatPoint {
+				if !p.Nil {
+					p.Value, p.Nil = finiteFloat(ffn(p.Value))
+				}
+				return p
+			},
+		}, nil
+	case IntegerIterator:
+		if ifn == nil {
+			return input, nil
+		}
+		return &integerTransformIterator{
+			input: input,
+			fn: func(p *IntegerPoint) *IntegerPoint {
+				if !p.Nil {
+					p.Value, p.Nil = ifn(p.Value)
+				}
+				return p
+			},
+		}, nil
+	case UnsignedIterator:
+		if ufn == nil {
+			return input, nil
+		}
+		return &unsignedTransformIterator{
+			input: input,
+			fn: func(p *UnsignedPoint) *UnsignedPoint {
+				if !p.Nil {
+					p.Value, p.Nil = ufn(p.Value)
+				}
+				return p
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("type mismatch, unable to use %T as a numeric iterator in %s()", input, name)
+	}
+}
+
+// newFloatScalarIterator returns an iterator that applies a function to a
+// numeric input and returns floats. Results that are not a number or are
+// infinite are returned as nil values.
+func newFloatScalarIterator(name string, input Iterator, fn func(float64) float64) (Iterator, error) {
+	var itr FloatIterator
+	switch input := input.(type) {
+	case FloatIterator:
+		itr = input
+	case IntegerIterator:
+		itr = &integerFloatCastIterator{input: input}
+	case UnsignedIterator:
+		itr = &unsignedFloatCastIterator{input: input}
+	default:
+		return nil, fmt.Errorf("type mismatch, unable to use %T as a numeric iterator in %s()", input, name)
+	}
+
+	return &floatTransformIterator{
+		input: itr,
+		fn: func(p *FloatPoint) *FloatPoint {
+			if !p.Nil {
+				p.Value, p.Nil = finiteFloat(fn(p.Value))
+			}
+			return p
+		},
+	}, nil
+}
+
+// newStringScalarIterator returns an iterator that applies a function to a
+// string input.
+func newStringScalarIterator(name string, input Iterator, fn func(string) string) (Iterator, error) {
+	itr, ok := input.(StringIterator)
+	if !ok {
+		return nil, fmt.Errorf("type mismatch, unable to use %T as a StringIterator in %s()", input, name)
+	}
+	return &stringTransformIterator{
+		input: itr,
+		fn: func(p *StringPoint) *StringPoint {
+			if !p.Nil {
+				p.Value = fn(p.Value)
+			}
+			return p
+		},
+	}, nil
+}
+
+// finiteFloat returns v and false if v is a finite number. Otherwise it
+// returns a nil value, as NaN and infinity cannot be encoded in results.
+func finiteFloat(v float64) (float64, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, true
+	}
+	return v, false
+}
+
+// roundFloat rounds v to the given number of decimal places, rounding half
+// away from zero.
+func roundFloat(v float64, precision int) float64 {
+	m := math.Pow10(precision)
+	if math.IsInf(v*m, 0) {
+		return v
+	}
+	if v < 0 {
+		return -math.Floor(-v*m+0.5) / m
+	}
+	return math.Floor(v*m+0.5) / m
+}
+
+// roundUnsigned rounds v to a multiple of 10^n, rounding half up. Returns
+// true if the result does not fit in an unsigned integer.
+func roundUnsigned(v uint64, n int) (uint64, bool) {
+	// 10^19 is the largest power of ten that fits, and every value is
+	// below half of the next one.
+	if n > 19 {
+		return 0, false
+	}
+	m := uint64(1)
+	for i := 0; i < n; i++ {
+		m *= 10
+	}
+
+	q, r := v/m, v%m
+	if r >= m-r {
+		q++
+	}
+	if q > math.MaxUint64/m {
+		return 0, true
+	}
+	return q * m, false
+}
+
+// roundInteger rounds v to a multiple of 10^n, rounding half away from zero.
+// Returns true if the result does not fit in an integer.
+func roundInteger(v int64, n int) (int64, bool) {
+	if v >= 0 {
+		u, overflow := roundUnsigned(uint64(v), n)
+		if overflow || u > math.MaxInt64 {
+			return 0, true
+		}
+		return int64(u), false
+	}
+
+	u, overflow := roundUnsigned(uint64(-(v+1))+1, n)
+	if overflow || u > 1<<63 {
+		return 0, true
+	}
+	return int64(-u), false
+}
+
+// substr returns the substring of s starting at the 1-based character
+// position start. A negative start counts from the end of the string. A
+// negative length returns the rest of the string.
+func substr(s string, start, length int) string {
+	runes := []rune(s)
+	if start < 0 {
+		start += len(runes)
+	} else if start > 0 {
+		start--
+	}
+	if start < 0 {
+		start = 0
+	} else if start > len(runes) {
+		start = len(runes)
+	}
+
+	end := len(runes)
+	if length >= 0 && start+length < end {
+		end = start + length
+	}
+	return string(runes[start:end])
+}
+
+// containsVarRef returns true if expr contains a variable reference outside
+// of an aggregate or selector. Unlike influxql.ContainsVarRef, the arguments
+// of scalar functions are included.
+func containsVarRef(expr influxql.Expr) bool {
+	var v containsVarRefVisitor
+	influxql.Walk(&v, expr)
+	return v.contains
+}
+
+type containsVarRefVisitor struct {
+	contains bool
+}
+
+func (v *containsVarRefVisitor) Visit(n influxql.Node) influxql.Visitor {
+	switch n := n.(type) {
+	case *influxql.Call:
+		if !isScalarFunction(n.Name) {
+			return nil
+		}
+	case *influxql.VarRef:
+		v.contains = true
+	}
+	return v
+}
+
+// stringIntegerTransformIterator executes a function to modify an existing
+// point for every output of the input iterator.
+type stringIntegerTransformIterator struct {
+	input StringIterator
+	fn    func(p *StringPoint) *IntegerPoint
+}
+
+// Stats returns stats from the input iterator.
+func (itr *stringIntegerTransformIterator) Stats() IteratorStats { return itr.input.Stats() }
+
+// Close closes the iterator and all child iterators.
+func (itr *stringIntegerTransformIterator) Close() error { return itr.input.Close() }
+
+// Next returns the next transformed point.
+func (itr *stringIntegerTransformIterator) Next() (*IntegerPoint, error) {
+	p, err := itr.input.Next()
+	if err != nil {
+		return nil, err
+	} else if p != nil {
+		return itr.fn(p), nil
+	}
+	return nil, nil
+}
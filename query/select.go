@@ -7,6 +7,7 @@ import (
 	"io"
 	"math"
 	"sort"
+	"time"
 
 	"github.com/influxdata/influxdb/pkg/tracing"
 	"github.com/influxdata/influxql"
@@ -312,6 +313,15 @@ func buildAuxIterator(expr influxql.Expr, aitr AuxIterator, opt IteratorOptions)
 			}
 			return buildTransformIterator(lhs, rhs, expr.Op, opt)
 		}
+	case *influxql.Call:
+		if !isScalarFunction(expr.Name) {
+			return nil, fmt.Errorf("invalid function call in auxiliary field: %s()", expr.Name)
+		}
+		input, err := buildAuxIterator(expr.Args[0], aitr, opt)
+		if err != nil {
+			return nil, err
+		}
+		return buildScalarIterator(expr, input)
 	case *influxql.ParenExpr:
 		return buildAuxIterator(expr.Expr, aitr, opt)
 	case *influxql.NilLiteral:
@@ -330,12 +340,15 @@ func buildFieldIterators(ctx context.Context, fields influxql.Fields, ic Iterato
 	if err := func() error {
 		hasAuxFields := false
 
+		// The buckets of each histogram share the iterator counting them.
+		histograms := make(map[string]*histogramBuckets)
+
 		var input Iterator
 		for i, f := range fields {
 			// Build iterators for calls first and save the iterator.
 			// We do this so we can keep the ordering provided by the user, but
 			// still build the Call's iterator first.
-			if influxql.ContainsVarRef(f.Expr) {
+			if containsVarRef(f.Expr) {
 				hasAuxFields = true
 				continue
 			}
@@ -350,7 +363,13 @@ func buildFieldIterators(ctx context.Context, fields influxql.Fields, ic Iterato
 			}
 
 			expr := influxql.Reduce(f.Expr, nil)
-			itr, err := buildExprIterator(localContext, expr, ic, sources, opt, selector, writeMode)
+			var itr Iterator
+			var err error
+			if call, index, ok := histogramBucket(expr); ok {
+				itr, err = buildHistogramBucketIterator(localContext, histograms, call, index, ic, sources, opt, selector, writeMode)
+			} else {
+				itr, err = buildExprIterator(localContext, expr, ic, sources, opt, selector, writeMode)
+			}
 
 			if localSpan != nil {
 				localSpan.Finish()
@@ -405,6 +424,36 @@ func buildFieldIterators(ctx context.Context, fields influxql.Fields, ic Iterato
 	return itrs, nil
 }
 
+// buildHistogramBucketIterator returns the iterator of a bucket of the
+// histogram call. The iterator counting all of the buckets is built for the
+// first of them and shared through histograms.
+func buildHistogramBucketIterator(ctx context.Context, histograms map[string]*histogramBuckets, call *influxql.Call, index int, ic IteratorCreator, sources influxql.Sources, opt IteratorOptions, selector, writeMode bool) (Iterator, error) {
+	key := call.String()
+	buckets := histograms[key]
+	if buckets == nil {
+		bounds, err := histogramBounds(call.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		// Windows without points are filled for each bucket.
+		callOpt := opt
+		callOpt.Fill = influxql.NoFill
+		input, err := buildExprIterator(ctx, call, ic, sources, callOpt, selector, writeMode)
+		if err != nil {
+			return nil, err
+		}
+		buckets = newHistogramBuckets(input, len(bounds)-1)
+		histograms[key] = buckets
+	}
+
+	itr := buckets.Iterator(index)
+	if !opt.Interval.IsZero() && opt.Fill != influxql.NoFill {
+		itr = NewFillIterator(itr, call, opt)
+	}
+	return itr, nil
+}
+
 // buildExprIterator creates an iterator for an expression.
 func buildExprIterator(ctx context.Context, expr influxql.Expr, ic IteratorCreator, sources influxql.Sources, opt IteratorOptions, selector, writeMode bool) (Iterator, error) {
 	opt.Expr = expr
@@ -420,6 +469,9 @@ func buildExprIterator(ctx context.Context, expr influxql.Expr, ic IteratorCreat
 	case *influxql.VarRef:
 		return b.buildVarRefIterator(ctx, expr)
 	case *influxql.Call:
+		if isScalarFunction(expr.Name) {
+			return b.buildScalarCallIterator(ctx, expr)
+		}
 		return b.buildCallIterator(ctx, expr)
 	case *influxql.BinaryExpr:
 		return b.buildBinaryExprIterator(ctx, expr)
@@ -527,12 +579,61 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 		opt.Interval = Interval{}
 
 		return newHoltWintersIterator(input, opt, int(h.Val), int(m.Val), includeFitData, interval)
-	case "derivative", "non_negative_derivative", "difference", "non_negative_difference", "moving_average", "elapsed":
+	case "decompose_trend", "decompose_residual":
+		opt.Ordered = true
+		input, err := buildExprIterator(ctx, expr.Args[0], b.ic, b.sources, opt, b.selector, false)
+		if err != nil {
+			return nil, err
+		}
+		period := expr.Args[1].(*influxql.IntegerLiteral)
+
+		// Redefine interval to be unbounded to capture all aggregate results
+		interval := opt.Interval
+		opt.StartTime = influxql.MinTime
+		opt.EndTime = influxql.MaxTime
+		opt.Interval = Interval{}
+
+		return newSeasonalDecompositionIterator(input, opt, int(period.Val), interval, expr.Name == "decompose_residual")
+	case "forecast_linear":
+		opt.Ordered = true
+		input, err := buildExprIterator(ctx, expr.Args[0], b.ic, b.sources, opt, b.selector, false)
+		if err != nil {
+			return nil, err
+		}
+		n := expr.Args[1].(*influxql.IntegerLiteral)
+
+		// Forecast points are spaced by the interval over an aggregate. Over
+		// raw points, the spacing is taken from the points themselves.
+		var interval time.Duration
+		if !opt.Interval.IsZero() {
+			interval = opt.Interval.Stride()
+			opt.StartTime = influxql.MinTime
+			opt.EndTime = influxql.MaxTime
+			opt.Interval = Interval{}
+		}
+		return newLinearForecastIterator(input, opt, int(n.Val), interval)
+	case "rate", "increase":
+		// Over a field with a GROUP BY interval, these are aggregates of each window.
+		if ref, ok := expr.Args[0].(*influxql.VarRef); ok && !opt.Interval.IsZero() {
+			opt.Ordered = true
+			input, err := buildExprIterator(ctx, ref, b.ic, b.sources, opt, false, false)
+			if err != nil {
+				return nil, err
+			}
+
+			var interval Interval
+			if expr.Name == "rate" {
+				interval = opt.RateInterval()
+			}
+			return newWindowRateIterator(input, opt, interval)
+		}
+		fallthrough
+	case "derivative", "non_negative_derivative", "difference", "non_negative_difference", "moving_average", "elapsed", "zscore", "mad_outliers":
 		if !opt.Interval.IsZero() {
 			if opt.Ascending {
-				opt.StartTime -= int64(opt.Interval.Duration)
+				opt.StartTime -= int64(opt.Interval.Stride())
 			} else {
-				opt.EndTime += int64(opt.Interval.Duration)
+				opt.EndTime += int64(opt.Interval.Stride())
 			}
 		}
 		opt.Ordered = true
@@ -550,6 +651,12 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 		case "elapsed":
 			interval := opt.ElapsedInterval()
 			return newElapsedIterator(input, opt, interval)
+		case "rate", "increase":
+			var interval Interval
+			if expr.Name == "rate" {
+				interval = opt.RateInterval()
+			}
+			return newRateIterator(input, opt, interval)
 		case "difference", "non_negative_difference":
 			isNonNegative := (expr.Name == "non_negative_difference")
 			return newDifferenceIterator(input, opt, isNonNegative)
@@ -557,12 +664,33 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 			n := expr.Args[1].(*influxql.IntegerLiteral)
 			if n.Val > 1 && !opt.Interval.IsZero() {
 				if opt.Ascending {
-					opt.StartTime -= int64(opt.Interval.Duration) * (n.Val - 1)
+					opt.StartTime -= int64(opt.Interval.Stride()) * (n.Val - 1)
 				} else {
-					opt.EndTime += int64(opt.Interval.Duration) * (n.Val - 1)
+					opt.EndTime += int64(opt.Interval.Stride()) * (n.Val - 1)
 				}
 			}
 			return newMovingAverageIterator(input, int(n.Val), opt)
+		case "zscore", "mad_outliers":
+			n := expr.Args[1].(*influxql.IntegerLiteral)
+			if n.Val > 1 && !opt.Interval.IsZero() {
+				if opt.Ascending {
+					opt.StartTime -= int64(opt.Interval.Stride()) * (n.Val - 1)
+				} else {
+					opt.EndTime += int64(opt.Interval.Stride()) * (n.Val - 1)
+				}
+			}
+			if expr.Name == "zscore" {
+				return newZScoreIterator(input, int(n.Val), opt)
+			}
+
+			var k float64
+			switch arg := expr.Args[2].(type) {
+			case *influxql.NumberLiteral:
+				k = arg.Val
+			case *influxql.IntegerLiteral:
+				k = float64(arg.Val)
+			}
+			return newMADOutliersIterator(input, int(n.Val), k, opt)
 		}
 		panic(fmt.Sprintf("invalid series aggregate function: %s", expr.Name))
 	case "cumulative_sum":
@@ -580,6 +708,38 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 		}
 		interval := opt.IntegralInterval()
 		return newIntegralIterator(input, opt, interval)
+	case "interpolate":
+		opt.Ordered = true
+		input, err := buildExprIterator(ctx, expr.Args[0].(*influxql.VarRef), b.ic, b.sources, opt, false, false)
+		if err != nil {
+			return nil, err
+		}
+		linear := expr.Args[1].(*influxql.StringLiteral).Val == "linear"
+		step := expr.Args[2].(*influxql.DurationLiteral).Val
+		var maxGap time.Duration
+		if len(expr.Args) == 4 {
+			maxGap = expr.Args[3].(*influxql.DurationLiteral).Val
+		}
+		return newInterpolateIterator(input, opt, linear, step, maxGap)
+	case "time_weighted_avg":
+		opt.Ordered = true
+		input, err := buildExprIterator(ctx, expr.Args[0].(*influxql.VarRef), b.ic, b.sources, opt, false, false)
+		if err != nil {
+			return nil, err
+		}
+		locf := len(expr.Args) == 2 && expr.Args[1].(*influxql.StringLiteral).Val == "locf"
+		return newTimeWeightedAvgIterator(input, opt, locf)
+	case "state_duration", "state_count":
+		opt.Ordered = true
+		input, err := buildExprIterator(ctx, expr.Args[0].(*influxql.VarRef), b.ic, b.sources, opt, false, false)
+		if err != nil {
+			return nil, err
+		}
+		unit := time.Nanosecond
+		if len(expr.Args) == 3 {
+			unit = expr.Args[2].(*influxql.DurationLiteral).Val
+		}
+		return newStateIterator(input, opt, expr.Args[1].(influxql.Literal), expr.Name == "state_count", unit)
 	case "top":
 		if len(expr.Args) < 2 {
 			return nil, fmt.Errorf("top() requires 2 or more arguments, got %d", len(expr.Args))
@@ -702,7 +862,7 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 				}
 			}
 			fallthrough
-		case "min", "max", "sum", "first", "last", "mean":
+		case "min", "max", "sum", "first", "last", "mean", "histogram":
 			return b.callIterator(ctx, expr, opt)
 		case "median":
 			opt.Ordered = true
@@ -765,6 +925,20 @@ func (b *exprIteratorBuilder) buildCallIterator(ctx context.Context, expr *influ
 	return itr, nil
 }
 
+func (b *exprIteratorBuilder) buildScalarCallIterator(ctx context.Context, expr *influxql.Call) (Iterator, error) {
+	input, err := buildExprIterator(ctx, expr.Args[0], b.ic, b.sources, b.opt, b.selector, false)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := buildScalarIterator(expr, input)
+	if err != nil {
+		input.Close()
+		return nil, err
+	}
+	return itr, nil
+}
+
 func (b *exprIteratorBuilder) buildBinaryExprIterator(ctx context.Context, expr *influxql.BinaryExpr) (Iterator, error) {
 	if rhs, ok := expr.RHS.(influxql.Literal); ok {
 		// The right hand side is a literal. It is more common to have the RHS be a literal,
@@ -800,6 +974,10 @@ func (b *exprIteratorBuilder) buildBinaryExprIterator(ctx context.Context, expr
 }
 
 func (b *exprIteratorBuilder) callIterator(ctx context.Context, expr *influxql.Call, opt IteratorOptions) (Iterator, error) {
+	if opt.Interval.Sliding() {
+		return b.slidingCallIterator(ctx, expr, opt)
+	}
+
 	inputs := make([]Iterator, 0, len(b.sources))
 	if err := func() error {
 		for _, source := range b.sources {
@@ -844,6 +1022,45 @@ func (b *exprIteratorBuilder) callIterator(ctx context.Context, expr *influxql.C
 	return itr, nil
 }
 
+// slidingCallIterator returns an iterator for a call over overlapping windows.
+// Windows are a whole number of steps long, so mergeable calls are computed
+// on the shards for each step and the partial aggregates are combined into
+// windows here. Other calls reduce the raw points.
+func (b *exprIteratorBuilder) slidingCallIterator(ctx context.Context, expr *influxql.Call, opt IteratorOptions) (Iterator, error) {
+	switch expr.Name {
+	case "count", "sum", "min", "max", "mean":
+		stepOpt := opt
+		stepOpt.Interval = Interval{Duration: opt.Interval.Step, Offset: opt.Interval.Offset}
+		input, err := b.callIterator(ctx, expr, stepOpt)
+		if err != nil {
+			return nil, err
+		}
+
+		// Counts are combined by summing them. The mean of a window weights
+		// the mean of each step by the number of points it aggregated.
+		if expr.Name == "count" {
+			opt.Expr = &influxql.Call{Name: "sum", Args: expr.Args}
+		}
+		itr, err := NewCallIterator(input, opt)
+		if err != nil {
+			input.Close()
+			return nil, err
+		}
+		return itr, nil
+	}
+
+	input, err := buildExprIterator(ctx, expr.Args[0], b.ic, b.sources, opt, b.selector, false)
+	if err != nil {
+		return nil, err
+	}
+	itr, err := NewCallIterator(input, opt)
+	if err != nil {
+		input.Close()
+		return nil, err
+	}
+	return itr, nil
+}
+
 func buildRHSTransformIterator(lhs Iterator, rhs influxql.Literal, op influxql.Token, opt IteratorOptions) (Iterator, error) {
 	itrType, litType := iteratorDataType(lhs), literalDataType(rhs)
 	if litType == influxql.Unsigned && itrType == influxql.Integer {
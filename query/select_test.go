@@ -3,6 +3,7 @@ package query_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"reflect"
 	"testing"
@@ -2121,6 +2122,61 @@ func TestSelect(t *testing.T) {
 				{&query.FloatPoint{Name: "cpu", Time: 4 * Second, Value: -2.5}},
 			},
 		},
+		{
+			name: "Rate_Float",
+			q:    `SELECT rate(value) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:40Z'`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 10},
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 20 * Second, Value: 5},
+					{Name: "cpu", Time: 30 * Second, Value: 15},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 1}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 0.5}},
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 1}},
+			},
+		},
+		{
+			name: "Increase_Integer",
+			q:    `SELECT increase(value) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:40Z'`,
+			typ:  influxql.Integer,
+			itrs: []query.Iterator{
+				&IntegerIterator{Points: []query.IntegerPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 10},
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 20 * Second, Value: 5},
+					{Name: "cpu", Time: 30 * Second, Value: 15},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 10}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 5}},
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 10}},
+			},
+		},
+		{
+			name: "Rate_Float_GroupByTime",
+			q:    `SELECT rate(value, 1s) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:40Z' GROUP BY time(20s)`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 15 * Second, Value: 5},
+					{Name: "cpu", Time: 25 * Second, Value: 15},
+					{Name: "cpu", Time: 30 * Second, Value: 25},
+					{Name: "cpu", Time: 35 * Second, Value: 35},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Value: 1.5}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 2}},
+			},
+		},
 		{
 			name: "Difference_Float",
 			q:    `SELECT difference(value) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:16Z'`,
@@ -2561,6 +2617,191 @@ func TestSelect(t *testing.T) {
 				{&query.FloatPoint{Name: "cpu", Time: 0, Value: 125}},
 			},
 		},
+		{
+			name: "Interpolate_Float_Linear",
+			q:    `SELECT interpolate(value, 'linear') FROM cpu SAMPLE EVERY 10s`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+					{Name: "cpu", Time: 12 * Second, Value: 17},
+					{Name: "cpu", Time: 30 * Second, Value: 35},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 15}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 25}},
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 35}},
+			},
+		},
+		{
+			name: "Interpolate_Integer_MaxGap",
+			q:    `SELECT interpolate(value, 'linear', 10s, 15s) FROM cpu`,
+			typ:  influxql.Integer,
+			itrs: []query.Iterator{
+				&IntegerIterator{Points: []query.IntegerPoint{
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+					{Name: "cpu", Time: 12 * Second, Value: 17},
+					{Name: "cpu", Time: 30 * Second, Value: 35},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 15}},
+				{&query.IntegerPoint{Name: "cpu", Time: 20 * Second, Nil: true}},
+				{&query.IntegerPoint{Name: "cpu", Time: 30 * Second, Value: 35}},
+			},
+		},
+		{
+			name: "Interpolate_Float_Previous_MaxGap",
+			q:    `SELECT interpolate(value, 'previous', 15s) FROM cpu SAMPLE EVERY 10s`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+					{Name: "cpu", Time: 12 * Second, Value: 17},
+					{Name: "cpu", Time: 30 * Second, Value: 35},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 10}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Nil: true}},
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 35}},
+			},
+		},
+		{
+			name: "Interpolate_Float_Previous_MaxGap_Descending",
+			q:    `SELECT interpolate(value, 'previous', 15s) FROM cpu ORDER BY time DESC SAMPLE EVERY 10s`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 30 * Second, Value: 35},
+					{Name: "cpu", Time: 12 * Second, Value: 17},
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 35}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Nil: true}},
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 10}},
+			},
+		},
+		{
+			name: "Interpolate_String_Previous",
+			q:    `SELECT interpolate(value, 'previous', 10s) FROM cpu`,
+			typ:  influxql.String,
+			itrs: []query.Iterator{
+				&StringIterator{Points: []query.StringPoint{
+					{Name: "cpu", Time: 5 * Second, Value: "a"},
+					{Name: "cpu", Time: 12 * Second, Value: "b"},
+					{Name: "cpu", Time: 30 * Second, Value: "c"},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.StringPoint{Name: "cpu", Time: 10 * Second, Value: "a"}},
+				{&query.StringPoint{Name: "cpu", Time: 20 * Second, Value: "b"}},
+				{&query.StringPoint{Name: "cpu", Time: 30 * Second, Value: "c"}},
+			},
+		},
+		{
+			name: "Interpolate_String_Linear",
+			q:    `SELECT interpolate(value, 'linear', 10s) FROM cpu`,
+			typ:  influxql.String,
+			itrs: []query.Iterator{
+				&StringIterator{Points: []query.StringPoint{}},
+			},
+			err: `linear interpolation is not supported for string fields`,
+		},
+		{
+			name: "TimeWeightedAvg_Float",
+			q:    `SELECT time_weighted_avg(value) FROM cpu`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 15 * Second, Value: 10},
+					{Name: "cpu", Time: 20 * Second, Value: 0},
+					{Name: "cpu", Time: 30 * Second, Value: -10},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0, Value: 2.5}},
+			},
+		},
+		{
+			name: "TimeWeightedAvg_Float_InterpolateGroupByTime",
+			q:    `SELECT time_weighted_avg(value, 'linear') FROM cpu WHERE time >= 0s AND time < 80s GROUP BY time(20s)`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 15 * Second, Value: 10},
+					{Name: "cpu", Time: 25 * Second, Value: 0},
+					{Name: "cpu", Time: 30 * Second, Value: -10},
+					{Name: "cpu", Time: 60 * Second, Value: 20},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0, Value: 11.25}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: -3.125}},
+				{&query.FloatPoint{Name: "cpu", Time: 40 * Second, Value: 10}},
+				{&query.FloatPoint{Name: "cpu", Time: 60 * Second, Value: 20}},
+			},
+		},
+		{
+			name: "TimeWeightedAvg_Integer_LOCF_GroupByTime",
+			q:    `SELECT time_weighted_avg(value, 'locf') FROM cpu WHERE time >= 0s AND time < 40s GROUP BY time(20s)`,
+			typ:  influxql.Integer,
+			itrs: []query.Iterator{
+				&IntegerIterator{Points: []query.IntegerPoint{
+					{Name: "cpu", Time: 10 * Second, Value: 20},
+					{Name: "cpu", Time: 15 * Second, Value: 10},
+					{Name: "cpu", Time: 25 * Second, Value: 0},
+					{Name: "cpu", Time: 30 * Second, Value: -10},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0, Value: 15}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 5}},
+			},
+		},
+		{
+			name: "StateDuration_String_GroupByTime",
+			q:    `SELECT state_duration(value, 'up', 1s) FROM cpu WHERE time >= 0s AND time < 60s GROUP BY time(20s)`,
+			typ:  influxql.String,
+			itrs: []query.Iterator{
+				&StringIterator{Points: []query.StringPoint{
+					{Name: "cpu", Time: 0 * Second, Value: "up"},
+					{Name: "cpu", Time: 10 * Second, Value: "down"},
+					{Name: "cpu", Time: 15 * Second, Value: "up"},
+					{Name: "cpu", Time: 50 * Second, Value: "up"},
+					{Name: "cpu", Time: 55 * Second, Value: "down"},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0, Value: 15}},
+				{&query.IntegerPoint{Name: "cpu", Time: 20 * Second, Value: 20}},
+				{&query.IntegerPoint{Name: "cpu", Time: 40 * Second, Value: 15}},
+			},
+		},
+		{
+			name: "StateCount_Float_GroupByTime",
+			q:    `SELECT state_count(value, 1) FROM cpu WHERE time >= 0s AND time < 60s GROUP BY time(20s)`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+					{Name: "cpu", Time: 10 * Second, Value: 0},
+					{Name: "cpu", Time: 15 * Second, Value: 1},
+					{Name: "cpu", Time: 50 * Second, Value: 1},
+					{Name: "cpu", Time: 55 * Second, Value: 0},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0, Value: 2}},
+				{&query.IntegerPoint{Name: "cpu", Time: 20 * Second, Value: 0}},
+				{&query.IntegerPoint{Name: "cpu", Time: 40 * Second, Value: 0}},
+			},
+		},
 		{
 			name: "MovingAverage_Float",
 			q:    `SELECT moving_average(value, 2) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:16Z'`,
@@ -2757,6 +2998,216 @@ func TestSelect(t *testing.T) {
 				{&query.FloatPoint{Name: "cpu", Time: 22 * Second, Value: 7.953140268154609}},
 			},
 		},
+		{
+			name: "Round_Mean",
+			q:    `SELECT round(mean(value), 1) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-02T00:00:00Z' GROUP BY time(10s), host fill(none)`,
+			typ:  influxql.Float,
+			expr: `mean(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 0 * Second, Value: 20},
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 1 * Second, Value: 3},
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 2 * Second, Value: 3},
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 11 * Second, Value: 1.25},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 0 * Second, Value: 8.7, Aggregated: 3}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 10 * Second, Value: 1.3, Aggregated: 1}},
+			},
+		},
+		{
+			name: "ZScore_Float",
+			q:    `SELECT zscore(value, 3) FROM cpu`,
+			typ:  influxql.Float,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+					{Name: "cpu", Time: 1 * Second, Value: 2},
+					{Name: "cpu", Time: 2 * Second, Value: 3},
+					{Name: "cpu", Time: 3 * Second, Value: 10},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 2 * Second, Value: 1}},
+				{&query.FloatPoint{Name: "cpu", Time: 3 * Second, Value: 1.1470786693528088}},
+			},
+		},
+		{
+			name: "MADOutliers_Integer",
+			q:    `SELECT mad_outliers(value, 5, 3) FROM cpu`,
+			typ:  influxql.Integer,
+			itrs: []query.Iterator{
+				&IntegerIterator{Points: []query.IntegerPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 10},
+					{Name: "cpu", Time: 1 * Second, Value: 11},
+					{Name: "cpu", Time: 2 * Second, Value: 10},
+					{Name: "cpu", Time: 3 * Second, Value: 12},
+					{Name: "cpu", Time: 4 * Second, Value: 11},
+					{Name: "cpu", Time: 5 * Second, Value: 10},
+					{Name: "cpu", Time: 6 * Second, Value: 50},
+					{Name: "cpu", Time: 7 * Second, Value: 11},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 6 * Second, Value: 50}},
+			},
+		},
+		{
+			name: "DecomposeTrend",
+			q:    `SELECT decompose_trend(mean(value), 4) FROM cpu WHERE time >= 0s AND time < 12s GROUP BY time(1s)`,
+			typ:  influxql.Float,
+			expr: `mean(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+					{Name: "cpu", Time: 1 * Second, Value: 5},
+					{Name: "cpu", Time: 2 * Second, Value: 3},
+					{Name: "cpu", Time: 3 * Second, Value: 7},
+					{Name: "cpu", Time: 4 * Second, Value: 2},
+					{Name: "cpu", Time: 5 * Second, Value: 6},
+					{Name: "cpu", Time: 6 * Second, Value: 4},
+					{Name: "cpu", Time: 7 * Second, Value: 8},
+					{Name: "cpu", Time: 8 * Second, Value: 3},
+					{Name: "cpu", Time: 9 * Second, Value: 7},
+					{Name: "cpu", Time: 10 * Second, Value: 5},
+					{Name: "cpu", Time: 11 * Second, Value: 9},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 2 * Second, Value: 4.125}},
+				{&query.FloatPoint{Name: "cpu", Time: 3 * Second, Value: 4.375}},
+				{&query.FloatPoint{Name: "cpu", Time: 4 * Second, Value: 4.625}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 4.875}},
+				{&query.FloatPoint{Name: "cpu", Time: 6 * Second, Value: 5.125}},
+				{&query.FloatPoint{Name: "cpu", Time: 7 * Second, Value: 5.375}},
+				{&query.FloatPoint{Name: "cpu", Time: 8 * Second, Value: 5.625}},
+				{&query.FloatPoint{Name: "cpu", Time: 9 * Second, Value: 5.875}},
+			},
+		},
+		{
+			name: "DecomposeResidual",
+			q:    `SELECT decompose_residual(mean(value), 4) FROM cpu WHERE time >= 0s AND time < 12s GROUP BY time(1s)`,
+			typ:  influxql.Float,
+			expr: `mean(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+					{Name: "cpu", Time: 1 * Second, Value: 5},
+					{Name: "cpu", Time: 2 * Second, Value: 3},
+					{Name: "cpu", Time: 3 * Second, Value: 7},
+					{Name: "cpu", Time: 4 * Second, Value: 2},
+					{Name: "cpu", Time: 5 * Second, Value: 6},
+					{Name: "cpu", Time: 6 * Second, Value: 4},
+					{Name: "cpu", Time: 7 * Second, Value: 8},
+					{Name: "cpu", Time: 8 * Second, Value: 3},
+					{Name: "cpu", Time: 9 * Second, Value: 7},
+					{Name: "cpu", Time: 10 * Second, Value: 5},
+					{Name: "cpu", Time: 11 * Second, Value: 9},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 2 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 3 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 4 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 6 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 7 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 8 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 9 * Second, Value: 0}},
+			},
+		},
+		{
+			name: "DecomposeResidual_MissingWindow",
+			q:    `SELECT decompose_residual(mean(value), 2) FROM cpu WHERE time >= 0s AND time < 10s GROUP BY time(1s) fill(none)`,
+			typ:  influxql.Float,
+			expr: `mean(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 11},
+					{Name: "cpu", Time: 1 * Second, Value: 9},
+					{Name: "cpu", Time: 2 * Second, Value: 11},
+					{Name: "cpu", Time: 3 * Second, Value: 9},
+					{Name: "cpu", Time: 5 * Second, Value: 9},
+					{Name: "cpu", Time: 6 * Second, Value: 11},
+					{Name: "cpu", Time: 7 * Second, Value: 9},
+					{Name: "cpu", Time: 8 * Second, Value: 11},
+					{Name: "cpu", Time: 9 * Second, Value: 9},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 1 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 2 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 6 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 7 * Second, Value: 0}},
+				{&query.FloatPoint{Name: "cpu", Time: 8 * Second, Value: 0}},
+			},
+		},
+		{
+			name: "ForecastLinear_Integer",
+			q:    `SELECT forecast_linear(value, 2) FROM cpu`,
+			typ:  influxql.Integer,
+			itrs: []query.Iterator{
+				&IntegerIterator{Points: []query.IntegerPoint{
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+					{Name: "cpu", Time: 10 * Second, Value: 3},
+					{Name: "cpu", Time: 20 * Second, Value: 5},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 7}},
+				{&query.FloatPoint{Name: "cpu", Time: 40 * Second, Value: 9}},
+			},
+		},
+		{
+			name: "Sum_Sliding",
+			q:    `SELECT sum(value) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:40Z' GROUP BY time(20s, 0s, 10s), host fill(none)`,
+			typ:  influxql.Float,
+			expr: `sum(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 0 * Second, Value: 1},
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 12 * Second, Value: 4},
+					{Name: "cpu", Tags: ParseTags("region=west,host=A"), Time: 31 * Second, Value: 16},
+				}},
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Tags: ParseTags("region=east,host=A"), Time: 5 * Second, Value: 2},
+					{Name: "cpu", Tags: ParseTags("region=east,host=A"), Time: 25 * Second, Value: 8},
+				}},
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Tags: ParseTags("region=west,host=B"), Time: 15 * Second, Value: 100},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 0 * Second, Value: 7, Aggregated: 3}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 10 * Second, Value: 12, Aggregated: 2}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 20 * Second, Value: 24, Aggregated: 2}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 30 * Second, Value: 16, Aggregated: 1}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=B"), Time: 0 * Second, Value: 100, Aggregated: 1}},
+				{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=B"), Time: 10 * Second, Value: 100, Aggregated: 1}},
+			},
+		},
+		{
+			name: "Max_Sliding_Descending",
+			q:    `SELECT max(value) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-01T00:00:40Z' GROUP BY time(20s, 0s, 10s) fill(none) ORDER BY time DESC`,
+			typ:  influxql.Float,
+			expr: `max(value::float)`,
+			itrs: []query.Iterator{
+				&FloatIterator{Points: []query.FloatPoint{
+					{Name: "cpu", Time: 31 * Second, Value: 16},
+					{Name: "cpu", Time: 25 * Second, Value: 8},
+					{Name: "cpu", Time: 12 * Second, Value: 4},
+					{Name: "cpu", Time: 5 * Second, Value: 2},
+					{Name: "cpu", Time: 0 * Second, Value: 1},
+				}},
+			},
+			points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 30 * Second, Value: 16, Aggregated: 1}},
+				{&query.FloatPoint{Name: "cpu", Time: 20 * Second, Value: 16, Aggregated: 2}},
+				{&query.FloatPoint{Name: "cpu", Time: 10 * Second, Value: 8, Aggregated: 2}},
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Value: 4, Aggregated: 3}},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			shardMapper := ShardMapper{
@@ -2808,6 +3259,42 @@ func TestSelect(t *testing.T) {
 	}
 }
 
+// Ensure the number of interpolate() samples is limited by max-select-buckets.
+func TestSelect_Interpolate_MaxBuckets(t *testing.T) {
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"value": influxql.Float,
+				},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					return &FloatIterator{}, nil
+				},
+			}
+		},
+	}
+
+	for _, tt := range []struct {
+		q   string
+		err string
+	}{
+		{q: `SELECT interpolate(value, 'linear') FROM cpu WHERE time >= 0s AND time < 90s SAMPLE EVERY 10s`},
+		{q: `SELECT interpolate(value, 'linear') FROM cpu WHERE time >= 0s AND time <= 100s SAMPLE EVERY 10s`, err: `max-select-buckets limit exceeded: (11/10)`},
+		{q: `SELECT interpolate(value, 'linear') FROM cpu WHERE time >= 0s AND time < 1h SAMPLE EVERY 1ns`, err: `max-select-buckets limit exceeded: (3600000000000/10)`},
+		{q: `SELECT interpolate(value, 'linear') FROM cpu SAMPLE EVERY 10s`, err: `interpolate requires a time range in the WHERE clause when max-select-buckets is set`},
+	} {
+		itrs, _, err := query.Select(context.Background(), MustParseSelectStatement(tt.q), &shardMapper, query.SelectOptions{MaxBucketsN: 10})
+		if err == nil {
+			query.Iterators(itrs).Close()
+		}
+		if tt.err == "" && err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.q, err)
+		} else if tt.err != "" && (err == nil || err.Error() != tt.err) {
+			t.Fatalf("%s: unexpected error: %v", tt.q, err)
+		}
+	}
+}
+
 // Ensure a SELECT with raw fields works for all types.
 func TestSelect_Raw(t *testing.T) {
 	shardMapper := ShardMapper{
@@ -3932,6 +4419,252 @@ func TestSelect_BinaryExpr_NilValues(t *testing.T) {
 	}
 }
 
+// Ensure scalar functions can be applied to raw fields of every type.
+func TestSelect_ScalarFunctions(t *testing.T) {
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"f": influxql.Float,
+					"i": influxql.Integer,
+					"u": influxql.Unsigned,
+					"s": influxql.String,
+				},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					if m.Name != "cpu" {
+						t.Fatalf("unexpected source: %s", m.Name)
+					}
+					makeAuxFields := func(f float64, i int64, s string) []interface{} {
+						aux := make([]interface{}, len(opt.Aux))
+						for j := range aux {
+							switch opt.Aux[j].Type {
+							case influxql.Float:
+								aux[j] = f
+							case influxql.Integer:
+								aux[j] = i
+							case influxql.Unsigned:
+								aux[j] = uint64(i)
+							case influxql.String:
+								aux[j] = s
+							}
+						}
+						return aux
+					}
+					return &FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Time: 0 * Second, Aux: makeAuxFields(-2.5, -25, " Server-A ")},
+						{Name: "cpu", Time: 5 * Second, Aux: makeAuxFields(4, 16, "b")},
+					}}, nil
+				},
+			}
+		},
+	}
+
+	for _, test := range []struct {
+		Name      string
+		Statement string
+		Points    [][]query.Point
+		Err       string
+	}{
+		{
+			Name:      "Float_Abs",
+			Statement: `SELECT abs(f) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Value: 2.5}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 4}},
+			},
+		},
+		{
+			Name:      "Integer_Abs",
+			Statement: `SELECT abs(i) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 25}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 16}},
+			},
+		},
+		{
+			Name:      "Float_Round",
+			Statement: `SELECT round(f) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Value: -3}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 4}},
+			},
+		},
+		{
+			Name:      "Integer_Round_NegativePrecision",
+			Statement: `SELECT round(i, -1) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: -30}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 20}},
+			},
+		},
+		{
+			Name:      "Unsigned_Floor",
+			Statement: `SELECT floor(u) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.UnsignedPoint{Name: "cpu", Time: 0 * Second, Value: uint64(1<<64 - 25)}},
+				{&query.UnsignedPoint{Name: "cpu", Time: 5 * Second, Value: 16}},
+			},
+		},
+		{
+			Name:      "Integer_Sqrt",
+			Statement: `SELECT sqrt(i) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Nil: true}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 4}},
+			},
+		},
+		{
+			Name:      "Float_Pow_Binary",
+			Statement: `SELECT pow(f, 2) + 1 FROM cpu`,
+			Points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Value: 7.25}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 17}},
+			},
+		},
+		{
+			Name:      "Integer_Log",
+			Statement: `SELECT log(i, 2) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.FloatPoint{Name: "cpu", Time: 0 * Second, Nil: true}},
+				{&query.FloatPoint{Name: "cpu", Time: 5 * Second, Value: 4}},
+			},
+		},
+		{
+			Name:      "String_Upper_Trim",
+			Statement: `SELECT upper(trim(s)) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.StringPoint{Name: "cpu", Time: 0 * Second, Value: "SERVER-A"}},
+				{&query.StringPoint{Name: "cpu", Time: 5 * Second, Value: "B"}},
+			},
+		},
+		{
+			Name:      "String_Substr",
+			Statement: `SELECT substr(s, 2, 6) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.StringPoint{Name: "cpu", Time: 0 * Second, Value: "Server"}},
+				{&query.StringPoint{Name: "cpu", Time: 5 * Second, Value: ""}},
+			},
+		},
+		{
+			Name:      "String_Strlen",
+			Statement: `SELECT strlen(s) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 10}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 1}},
+			},
+		},
+		{
+			Name:      "Float_Lower",
+			Statement: `SELECT lower(f) FROM cpu`,
+			Err:       `type mismatch, unable to use *query.floatChanIterator as a StringIterator in lower()`,
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			stmt := MustParseSelectStatement(test.Statement)
+			itrs, _, err := query.Select(context.Background(), stmt, &shardMapper, query.SelectOptions{})
+			if err != nil {
+				if have, want := err.Error(), test.Err; want == "" || have != want {
+					t.Errorf("%s: unexpected error: %s", test.Name, have)
+				}
+			} else if test.Err != "" {
+				t.Fatalf("%s: expected error", test.Name)
+			} else if a, err := Iterators(itrs).ReadAll(); err != nil {
+				t.Fatalf("%s: unexpected error: %s", test.Name, err)
+			} else if diff := cmp.Diff(a, test.Points); diff != "" {
+				t.Errorf("%s: unexpected points:\n%s", test.Name, diff)
+			}
+		})
+	}
+}
+
+// Ensure scalar functions keep integers exact at the limits of their type.
+func TestSelect_ScalarFunctions_IntegerLimits(t *testing.T) {
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"i": influxql.Integer,
+					"u": influxql.Unsigned,
+				},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					makeAuxFields := func(i int64, u uint64) []interface{} {
+						aux := make([]interface{}, len(opt.Aux))
+						for j := range aux {
+							switch opt.Aux[j].Type {
+							case influxql.Integer:
+								aux[j] = i
+							case influxql.Unsigned:
+								aux[j] = u
+							}
+						}
+						return aux
+					}
+					return &FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Time: 0 * Second, Aux: makeAuxFields(math.MinInt64, math.MaxUint64)},
+						{Name: "cpu", Time: 5 * Second, Aux: makeAuxFields(1<<53+1, 1<<53+1)},
+						{Name: "cpu", Time: 10 * Second, Aux: makeAuxFields(-1<<53-5, 15)},
+					}}, nil
+				},
+			}
+		},
+	}
+
+	for _, test := range []struct {
+		Name      string
+		Statement string
+		Points    [][]query.Point
+	}{
+		{
+			Name:      "Integer_Abs",
+			Statement: `SELECT abs(i) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Nil: true}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 1<<53 + 1}},
+				{&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1<<53 + 5}},
+			},
+		},
+		{
+			Name:      "Integer_Round",
+			Statement: `SELECT round(i, 2) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: math.MinInt64}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 1<<53 + 1}},
+				{&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: -1<<53 - 5}},
+			},
+		},
+		{
+			Name:      "Integer_Round_NegativePrecision",
+			Statement: `SELECT round(i, -1) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Nil: true}},
+				{&query.IntegerPoint{Name: "cpu", Time: 5 * Second, Value: 9007199254740990}},
+				{&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: -9007199254741000}},
+			},
+		},
+		{
+			Name:      "Unsigned_Round_NegativePrecision",
+			Statement: `SELECT round(u, -1) FROM cpu`,
+			Points: [][]query.Point{
+				{&query.UnsignedPoint{Name: "cpu", Time: 0 * Second, Nil: true}},
+				{&query.UnsignedPoint{Name: "cpu", Time: 5 * Second, Value: 9007199254740990}},
+				{&query.UnsignedPoint{Name: "cpu", Time: 10 * Second, Value: 20}},
+			},
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			stmt := MustParseSelectStatement(test.Statement)
+			itrs, _, err := query.Select(context.Background(), stmt, &shardMapper, query.SelectOptions{})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", test.Name, err)
+			} else if a, err := Iterators(itrs).ReadAll(); err != nil {
+				t.Fatalf("%s: unexpected error: %s", test.Name, err)
+			} else if diff := cmp.Diff(a, test.Points); diff != "" {
+				t.Errorf("%s: unexpected points:\n%s", test.Name, diff)
+			}
+		})
+	}
+}
+
 type ShardMapper struct {
 	MapShardsFn func(sources influxql.Sources, t influxql.TimeRange) query.ShardGroup
 }
@@ -4111,3 +4844,196 @@ func benchmarkSelectTop(b *testing.B, seriesN, pointsPerSeries int) {
 }
 
 func BenchmarkSelect_Top_1K(b *testing.B) { benchmarkSelectTop(b, 1000, 1000) }
+
+// Ensure mergeable calls over sliding windows are computed by the shards for
+// each step and combined into windows.
+func TestSelect_Sliding_StepPartials(t *testing.T) {
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"value": influxql.Float,
+				},
+				Dimensions: []string{"host"},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					if _, ok := opt.Expr.(*influxql.Call); !ok {
+						t.Fatalf("unexpected expr: %s", opt.Expr)
+					} else if got, exp := opt.Interval, (query.Interval{Duration: 10 * time.Second}); got != exp {
+						t.Fatalf("unexpected interval: %+v", got)
+					}
+
+					shard0, err := query.NewCallIterator(&FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Tags: ParseTags("host=A"), Time: 0 * Second, Value: 1},
+						{Name: "cpu", Tags: ParseTags("host=A"), Time: 12 * Second, Value: 3},
+						{Name: "cpu", Tags: ParseTags("host=B"), Time: 15 * Second, Value: 10},
+					}}, opt)
+					if err != nil {
+						return nil, err
+					}
+					shard1, err := query.NewCallIterator(&FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Tags: ParseTags("host=A"), Time: 5 * Second, Value: 2},
+						{Name: "cpu", Tags: ParseTags("host=A"), Time: 25 * Second, Value: 6},
+					}}, opt)
+					if err != nil {
+						return nil, err
+					}
+					return query.Iterators{shard0, shard1}.Merge(opt)
+				},
+			}
+		},
+	}
+
+	stmt := MustParseSelectStatement(`SELECT count(value), mean(value), min(value) FROM cpu WHERE time >= 0s AND time < 30s GROUP BY time(20s, 0s, 10s), host fill(none)`)
+	itrs, _, err := query.Select(context.Background(), stmt, &shardMapper, query.SelectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := Iterators(itrs).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type row struct {
+		Host      string
+		Time      int64
+		Count     int64
+		Mean, Min float64
+	}
+	var got []row
+	for _, points := range a {
+		got = append(got, row{
+			Host:  points[0].(*query.IntegerPoint).Tags.KeyValues()["host"],
+			Time:  points[0].(*query.IntegerPoint).Time,
+			Count: points[0].(*query.IntegerPoint).Value,
+			Mean:  points[1].(*query.FloatPoint).Value,
+			Min:   points[2].(*query.FloatPoint).Value,
+		})
+	}
+	if diff := cmp.Diff(got, []row{
+		{Host: "A", Time: 0 * Second, Count: 3, Mean: 2, Min: 1},
+		{Host: "A", Time: 10 * Second, Count: 2, Mean: 4.5, Min: 3},
+		{Host: "A", Time: 20 * Second, Count: 1, Mean: 6, Min: 6},
+		{Host: "B", Time: 0 * Second, Count: 1, Mean: 10, Min: 10},
+		{Host: "B", Time: 10 * Second, Count: 1, Mean: 10, Min: 10},
+	}); diff != "" {
+		t.Fatalf("unexpected points:\n%s", diff)
+	}
+}
+
+func TestSelect_Histogram(t *testing.T) {
+	var calls int
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"value": influxql.Float,
+				},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					if m.Name != "cpu" {
+						t.Fatalf("unexpected source: %s", m.Name)
+					}
+					calls++
+
+					// Each shard counts the buckets of its own points and
+					// the partial histograms are merged.
+					shard0, err := query.NewCallIterator(&FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Time: 0 * Second, Value: 5},
+						{Name: "cpu", Time: 1 * Second, Value: 12},
+						{Name: "cpu", Time: 10 * Second, Value: 1},
+					}}, opt)
+					if err != nil {
+						return nil, err
+					}
+					shard1, err := query.NewCallIterator(&FloatIterator{Points: []query.FloatPoint{
+						{Name: "cpu", Time: 2 * Second, Value: 15},
+						{Name: "cpu", Time: 3 * Second, Value: 25},
+						{Name: "cpu", Time: 11 * Second, Value: 29},
+					}}, opt)
+					if err != nil {
+						return nil, err
+					}
+					return query.Iterators{shard0, shard1}.Merge(opt)
+				},
+			}
+		},
+	}
+
+	for _, test := range []struct {
+		Name      string
+		Statement string
+		Columns   []string
+		Points    [][]query.Point
+	}{
+		{
+			Name:      "Width",
+			Statement: `SELECT histogram(value, 0, 10, 3) FROM cpu WHERE time >= 0s AND time < 20s GROUP BY time(10s)`,
+			Columns:   []string{"time", "histogram_0", "histogram_10", "histogram_20"},
+			Points: [][]query.Point{
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 2},
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 1},
+				},
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 0},
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+				},
+			},
+		},
+		{
+			Name:      "Bounds",
+			Statement: `SELECT histogram(value, bounds(0, 10, 30)) AS latency FROM cpu WHERE time >= 0s AND time < 20s GROUP BY time(10s)`,
+			Columns:   []string{"time", "latency_0", "latency_10"},
+			Points: [][]query.Point{
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 3},
+				},
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+				},
+			},
+		},
+		{
+			Name:      "Fill",
+			Statement: `SELECT histogram(value, bounds(0, 10, 30)) FROM cpu WHERE time >= 0s AND time < 30s GROUP BY time(10s)`,
+			Columns:   []string{"time", "histogram_0", "histogram_10"},
+			Points: [][]query.Point{
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 0 * Second, Value: 3},
+				},
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+					&query.IntegerPoint{Name: "cpu", Time: 10 * Second, Value: 1},
+				},
+				{
+					&query.IntegerPoint{Name: "cpu", Time: 20 * Second, Value: 0},
+					&query.IntegerPoint{Name: "cpu", Time: 20 * Second, Value: 0},
+				},
+			},
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			calls = 0
+			stmt := MustParseSelectStatement(test.Statement)
+			itrs, columns, err := query.Select(context.Background(), stmt, &shardMapper, query.SelectOptions{})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", test.Name, err)
+			} else if !reflect.DeepEqual(columns, test.Columns) {
+				t.Fatalf("%s: unexpected columns: %v", test.Name, columns)
+			} else if a, err := Iterators(itrs).ReadAll(); err != nil {
+				t.Fatalf("%s: unexpected error: %s", test.Name, err)
+			} else if diff := cmp.Diff(a, test.Points); diff != "" {
+				t.Errorf("%s: unexpected points:\n%s", test.Name, diff)
+			}
+
+			// The buckets are counted by a single iterator.
+			if calls != 1 {
+				t.Errorf("%s: unexpected number of iterators: %d", test.Name, calls)
+			}
+		})
+	}
+}
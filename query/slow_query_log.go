@@ -0,0 +1,196 @@
+package query
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrSlowQueryLogClosed is returned when writing to a closed slow query log.
+var ErrSlowQueryLogClosed = errors.New("slow query log closed")
+
+// SlowQueryEntry is a single entry in the slow query log.
+type SlowQueryEntry struct {
+	// The time the query finished.
+	Time time.Time `json:"time"`
+
+	// The text of the statement and the context it was run in.
+	Query    string `json:"query"`
+	Database string `json:"database,omitempty"`
+	User     string `json:"user,omitempty"`
+
+	// The wall time taken to run the statement.
+	Duration time.Duration `json:"duration_ns"`
+
+	// Statistics collected while executing the statement.
+	SeriesN    int           `json:"series"`
+	ShardsN    int           `json:"shards"`
+	PointN     int           `json:"points_scanned"`
+	BlocksN    int           `json:"blocks_decoded"`
+	BlockBytes int64         `json:"block_bytes"`
+	CursorTime time.Duration `json:"cursor_time_ns"`
+
+	// The error returned by the statement, if any.
+	Error string `json:"error,omitempty"`
+
+	// The EXPLAIN ANALYZE tree of the statement, one line per element.
+	Plan []string `json:"plan,omitempty"`
+}
+
+// SlowQueryLog is an append-only file of slow query entries encoded as
+// newline-delimited JSON. If the log has no path, entries are discarded.
+type SlowQueryLog struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	w    *bufio.Writer
+	size int64
+
+	// Threshold is the minimum duration of a statement for it to be logged.
+	Threshold time.Duration
+
+	// MaxSize is the size in bytes at which the file is rotated. The file is
+	// moved to the same path with a ".1" suffix, replacing any previously
+	// rotated file. A value of 0 disables rotation.
+	MaxSize int64
+
+	Logger *zap.Logger
+}
+
+// NewSlowQueryLog returns a new instance of SlowQueryLog writing to path.
+// An empty path disables writing entries to a file.
+func NewSlowQueryLog(path string) *SlowQueryLog {
+	return &SlowQueryLog{
+		path:   path,
+		Logger: zap.NewNop(),
+	}
+}
+
+// Path returns the path of the active file.
+func (l *SlowQueryLog) Path() string { return l.path }
+
+// Open opens the log file, creating it and its directory if they do not exist.
+func (l *SlowQueryLog) Open() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.path == "" {
+		return nil
+	} else if err := os.MkdirAll(filepath.Dir(l.path), 0777); err != nil {
+		return err
+	}
+	return l.openFile()
+}
+
+// openFile opens the active file for appending. Must be called under lock.
+func (l *SlowQueryLog) openFile() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	l.f, l.w, l.size = f, bufio.NewWriter(f), fi.Size()
+	return nil
+}
+
+// Close flushes and closes the log.
+func (l *SlowQueryLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closeFile()
+}
+
+// closeFile flushes and closes the active file. Must be called under lock.
+func (l *SlowQueryLog) closeFile() error {
+	if l.f == nil {
+		return nil
+	}
+
+	err := l.w.Flush()
+	if e := l.f.Close(); e != nil && err == nil {
+		err = e
+	}
+	l.f, l.w = nil, nil
+	return err
+}
+
+// WriteEntry appends an entry to the log.
+func (l *SlowQueryLog) WriteEntry(entry *SlowQueryEntry) error {
+	if l.path == "" {
+		return nil
+	}
+
+	buf, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return ErrSlowQueryLogClosed
+	}
+
+	n, err := l.w.Write(buf)
+	l.size += int64(n)
+	if err != nil {
+		return err
+	} else if err := l.w.Flush(); err != nil {
+		return err
+	}
+
+	if l.MaxSize > 0 && l.size >= l.MaxSize {
+		return l.rotate()
+	}
+	return nil
+}
+
+// rotate moves the active file aside, replacing any previously rotated file,
+// and opens a new active file. Must be called under lock.
+func (l *SlowQueryLog) rotate() error {
+	if err := l.closeFile(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		// Keep appending to the active file so the log stays open and the
+		// rotation is retried on the next write.
+		if err := l.openFile(); err != nil {
+			return err
+		}
+		return err
+	}
+	l.Logger.Info("Rotated slow query log", zap.String("path", l.path))
+
+	return l.openFile()
+}
+
+// ReadSlowQueryEntries decodes all entries from r.
+func ReadSlowQueryEntries(r io.Reader) ([]*SlowQueryEntry, error) {
+	var entries []*SlowQueryEntry
+	dec := json.NewDecoder(r)
+	for {
+		var entry SlowQueryEntry
+		if err := dec.Decode(&entry); err == io.EOF {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+}
@@ -0,0 +1,136 @@
+package query_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/query"
+)
+
+// Ensure slow query entries can be written to and read back from the log.
+func TestSlowQueryLog_WriteEntry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "query-slow-query-log-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := query.NewSlowQueryLog(filepath.Join(dir, "slow", "queries.log"))
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	entry := &query.SlowQueryEntry{
+		Time:     time.Unix(0, 10).UTC(),
+		Query:    "SELECT mean(value) FROM cpu",
+		Database: "db0",
+		User:     "admin",
+		Duration: 2 * time.Second,
+		SeriesN:  2,
+		ShardsN:  1,
+		PointN:   100,
+		Plan:     []string{"EXPRESSION: mean(value::float)"},
+	}
+	if err := l.WriteEntry(entry); err != nil {
+		t.Fatal(err)
+	} else if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(l.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	entries, err := query.ReadSlowQueryEntries(f)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 {
+		t.Fatalf("unexpected entry count: %d", len(entries))
+	} else if !reflect.DeepEqual(entries[0], entry) {
+		t.Fatalf("unexpected entry: %#v", entries[0])
+	}
+
+	if err := l.WriteEntry(entry); err != query.ErrSlowQueryLogClosed {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure the log is rotated once it reaches its maximum size.
+func TestSlowQueryLog_Rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "query-slow-query-log-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := query.NewSlowQueryLog(filepath.Join(dir, "queries.log"))
+	l.MaxSize = 1
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := l.WriteEntry(&query.SlowQueryEntry{Query: "SELECT value FROM cpu"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := os.Stat(l.Path() + ".1"); err != nil {
+		t.Fatalf("expected rotated file: %s", err)
+	}
+	if fi, err := os.Stat(l.Path()); err != nil {
+		t.Fatal(err)
+	} else if fi.Size() != 0 {
+		t.Fatalf("unexpected active file size: %d", fi.Size())
+	}
+}
+
+// Ensure the log stays open when the active file cannot be moved aside.
+func TestSlowQueryLog_Rotate_RenameError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "query-slow-query-log-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := query.NewSlowQueryLog(filepath.Join(dir, "queries.log"))
+	l.MaxSize = 1
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// A directory that isn't empty can't be replaced by the active file.
+	if err := os.MkdirAll(filepath.Join(l.Path()+".1", "x"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.WriteEntry(&query.SlowQueryEntry{Query: "SELECT value FROM cpu"}); err == nil {
+		t.Fatal("expected rotation error")
+	}
+
+	// The rotation succeeds on the next write once the file can be moved.
+	if err := os.RemoveAll(l.Path() + ".1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.WriteEntry(&query.SlowQueryEntry{Query: "SELECT value FROM mem"}); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(l.Path() + ".1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if entries, err := query.ReadSlowQueryEntries(f); err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 2 {
+		t.Fatalf("unexpected entry count: %d", len(entries))
+	}
+}
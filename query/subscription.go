@@ -0,0 +1,59 @@
+package query
+
+import (
+	"github.com/influxdata/influxql"
+)
+
+// CreateSubscriptionStatement is a CREATE SUBSCRIPTION statement with a
+// WHERE clause selecting the points sent to the subscription:
+//
+//	CREATE SUBSCRIPTION s ON db.rp DESTINATIONS ALL '...' WHERE "measurement" =~ /^http_/ AND region = 'eu'
+//
+// The condition may reference the measurement name as "measurement", quoted
+// as MEASUREMENT is a keyword, or as _name as in the system sources, and any
+// tag key. A tag named measurement is referenced as "measurement"::tag.
+// Fields cannot be referenced. Statements without a WHERE clause are parsed
+// as an influxql.CreateSubscriptionStatement.
+type CreateSubscriptionStatement struct {
+	*influxql.CreateSubscriptionStatement
+
+	// Condition selects the points sent to the subscription.
+	Condition influxql.Expr
+}
+
+// String returns a string representation of the statement.
+func (s *CreateSubscriptionStatement) String() string {
+	return s.CreateSubscriptionStatement.String() + " WHERE " + s.Condition.String()
+}
+
+// IsSubscriptionMeasurementRef returns true if ref references the measurement
+// name in the condition of a subscription. Other references are tag keys.
+func IsSubscriptionMeasurementRef(ref *influxql.VarRef) bool {
+	return ref.Type == influxql.Unknown && (ref.Val == "measurement" || ref.Val == "_name")
+}
+
+func init() {
+	// Extend CREATE SUBSCRIPTION with an optional WHERE clause.
+	create := influxql.Language.Group(influxql.CREATE)
+	parse := create.Handlers[influxql.SUBSCRIPTION]
+	create.Handlers[influxql.SUBSCRIPTION] = func(p *influxql.Parser) (influxql.Statement, error) {
+		stmt, err := parse(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if tok, _, _ := p.ScanIgnoreWhitespace(); tok != influxql.WHERE {
+			p.Unscan()
+			return stmt, nil
+		}
+
+		cond, err := p.ParseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &CreateSubscriptionStatement{
+			CreateSubscriptionStatement: stmt.(*influxql.CreateSubscriptionStatement),
+			Condition:                   cond,
+		}, nil
+	}
+}
@@ -0,0 +1,71 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxql"
+)
+
+func TestCreateSubscriptionStatement_Parse(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		cond string
+	}{
+		{s: `CREATE SUBSCRIPTION s0 ON db0.rp0 DESTINATIONS ALL 'udp://h0:9093'`},
+		{
+			s:    `CREATE SUBSCRIPTION s0 ON db0.rp0 DESTINATIONS ANY 'udp://h0:9093', 'udp://h1:9093' WHERE "measurement" =~ /^http_/ AND region = 'eu'`,
+			cond: `"measurement" =~ /^http_/ AND region = 'eu'`,
+		},
+		{
+			s:    `CREATE SUBSCRIPTION s0 ON db0.rp0 DESTINATIONS ANY 'udp://h0:9093', 'udp://h1:9093' WHERE "measurement"::tag = 'http_requests'`,
+			cond: `"measurement"::tag = 'http_requests'`,
+		},
+	} {
+		q, err := influxql.ParseQuery(tt.s + "; SHOW SUBSCRIPTIONS")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.s, err)
+		} else if len(q.Statements) != 2 {
+			t.Fatalf("%s: unexpected statements: %s", tt.s, q)
+		}
+
+		switch stmt := q.Statements[0].(type) {
+		case *influxql.CreateSubscriptionStatement:
+			if tt.cond != "" {
+				t.Fatalf("%s: expected a condition", tt.s)
+			}
+		case *query.CreateSubscriptionStatement:
+			if got := stmt.Condition.String(); got != tt.cond {
+				t.Fatalf("%s: unexpected condition: %s", tt.s, got)
+			} else if stmt.Name != "s0" || stmt.Database != "db0" || stmt.RetentionPolicy != "rp0" || len(stmt.Destinations) != 2 {
+				t.Fatalf("%s: unexpected statement: %#v", tt.s, stmt.CreateSubscriptionStatement)
+			}
+		default:
+			t.Fatalf("%s: unexpected statement type: %T", tt.s, stmt)
+		}
+
+		if got := q.Statements[0].String(); got != tt.s {
+			t.Fatalf("unexpected string:\n got: %s\n exp: %s", got, tt.s)
+		}
+	}
+
+	if _, err := influxql.ParseStatement(`CREATE SUBSCRIPTION s0 ON db0.rp0 DESTINATIONS ALL 'udp://h0:9093' WHERE`); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestIsSubscriptionMeasurementRef(t *testing.T) {
+	for _, tt := range []struct {
+		ref influxql.VarRef
+		exp bool
+	}{
+		{ref: influxql.VarRef{Val: "measurement"}, exp: true},
+		{ref: influxql.VarRef{Val: "_name"}, exp: true},
+		{ref: influxql.VarRef{Val: "measurement", Type: influxql.Tag}},
+		{ref: influxql.VarRef{Val: "region"}},
+	} {
+		if got := query.IsSubscriptionMeasurementRef(&tt.ref); got != tt.exp {
+			t.Errorf("%s: got %v, exp %v", tt.ref.String(), got, tt.exp)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
@@ -25,6 +26,9 @@ const (
 	// KilledTask is set when the task is killed, but resources are still
 	// being used.
 	KilledTask
+
+	// QueuedTask is set when the task is waiting for a free query slot.
+	QueuedTask
 )
 
 func (t TaskStatus) String() string {
@@ -33,10 +37,48 @@ func (t TaskStatus) String() string {
 		return "running"
 	case KilledTask:
 		return "killed"
+	case QueuedTask:
+		return "queued"
 	}
 	panic(fmt.Sprintf("unknown task status: %d", int(t)))
 }
 
+// QueryPriority is the priority class used to order queries waiting for a
+// free query slot. Queries with a higher priority are started first.
+type QueryPriority int
+
+const (
+	// DefaultPriority resolves to the priority configured for the user
+	// running the query, or InteractivePriority if there is none.
+	DefaultPriority QueryPriority = iota
+
+	// BatchPriority is used for long running queries, such as exports,
+	// that should yield to other queries.
+	BatchPriority
+
+	// InteractivePriority is used for queries a user is waiting on, such
+	// as those issued by dashboards.
+	InteractivePriority
+
+	// ContinuousQueryPriority is used for continuous queries. These may
+	// also use the slots reserved for continuous queries.
+	ContinuousQueryPriority
+)
+
+func (p QueryPriority) String() string {
+	switch p {
+	case DefaultPriority:
+		return "default"
+	case BatchPriority:
+		return "batch"
+	case InteractivePriority:
+		return "interactive"
+	case ContinuousQueryPriority:
+		return "continuous_query"
+	}
+	panic(fmt.Sprintf("unknown query priority: %d", int(p)))
+}
+
 // TaskManager takes care of all aspects related to managing running queries.
 type TaskManager struct {
 	// Query execution timeout.
@@ -49,15 +91,37 @@ type TaskManager struct {
 	// Maximum number of concurrent queries.
 	MaxConcurrentQueries int
 
+	// Maximum number of queries waiting for a free query slot once
+	// MaxConcurrentQueries has been reached. If zero, queries are rejected
+	// immediately instead of being queued.
+	MaxQueuedQueries int
+
+	// Maximum time a query waits in the queue before it is rejected.
+	// If zero, a queued query waits until it is started or interrupted.
+	QueueTimeout time.Duration
+
+	// Number of the MaxConcurrentQueries slots that only continuous
+	// queries may use.
+	ReservedContinuousQueries int
+
+	// Priorities of the queries run by each user. Queries from users that
+	// are not listed run with InteractivePriority unless the query sets
+	// its own priority.
+	UserPriorities map[string]QueryPriority
+
 	// Logger to use for all logging.
 	// Defaults to discarding all log output.
 	Logger *zap.Logger
 
 	// Used for managing and tracking running queries.
 	queries  map[uint64]*QueryTask
+	queue    []*QueryTask // queued queries ordered by priority
 	nextID   uint64
 	mu       sync.RWMutex
 	shutdown bool
+
+	// Total time queries have spent waiting in the queue.
+	queueWaitDuration int64
 }
 
 // NewTaskManager creates a new TaskManager.
@@ -114,26 +178,38 @@ func (t *TaskManager) executeShowQueriesStatement(q *influxql.ShowQueriesStateme
 
 	values := make([][]interface{}, 0, len(t.queries))
 	for id, qi := range t.queries {
-		d := now.Sub(qi.startTime)
-
-		switch {
-		case d >= time.Second:
-			d = d - (d % time.Second)
-		case d >= time.Millisecond:
-			d = d - (d % time.Millisecond)
-		case d >= time.Microsecond:
-			d = d - (d % time.Microsecond)
+		var d time.Duration
+		wait := qi.waitTime
+		if qi.status == QueuedTask {
+			wait = now.Sub(qi.queueTime)
+		} else {
+			d = now.Sub(qi.startTime)
 		}
 
-		values = append(values, []interface{}{id, qi.query, qi.database, d.String(), qi.status.String()})
+		stats := qi.Stats()
+		values = append(values, []interface{}{id, qi.query, qi.database, truncateDuration(d).String(), qi.status.String(),
+			stats.BlocksN, stats.BlockBytes, stats.CursorTime.String(), qi.priority.String(), truncateDuration(wait).String()})
 	}
 
 	return []*models.Row{{
-		Columns: []string{"qid", "query", "database", "duration", "status"},
+		Columns: []string{"qid", "query", "database", "duration", "status", "blocks", "bytes", "cursor_time", "priority", "wait"},
 		Values:  values,
 	}}, nil
 }
 
+// truncateDuration reduces the precision of d for display.
+func truncateDuration(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		d = d - (d % time.Second)
+	case d >= time.Millisecond:
+		d = d - (d % time.Millisecond)
+	case d >= time.Microsecond:
+		d = d - (d % time.Microsecond)
+	}
+	return d
+}
+
 func (t *TaskManager) queryError(qid uint64, err error) {
 	t.mu.RLock()
 	query := t.queries[qid]
@@ -149,29 +225,54 @@ func (t *TaskManager) queryError(qid uint64, err error) {
 // This function also returns a channel that will be closed when this
 // query finishes running.
 //
+// If the maximum number of concurrent queries has been reached, the query
+// waits in the queue until a slot is available for its priority.
+//
 // After a query finishes running, the system is free to reuse a query id.
-func (t *TaskManager) AttachQuery(q *influxql.Query, database string, interrupt <-chan struct{}) (uint64, *QueryTask, error) {
+func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, interrupt <-chan struct{}) (uint64, *QueryTask, error) {
 	t.mu.Lock()
-	defer t.mu.Unlock()
-
 	if t.shutdown {
+		t.mu.Unlock()
 		return 0, nil, ErrQueryEngineShutdown
 	}
 
-	if t.MaxConcurrentQueries > 0 && len(t.queries) >= t.MaxConcurrentQueries {
-		return 0, nil, ErrMaxConcurrentQueriesLimitExceeded(len(t.queries), t.MaxConcurrentQueries)
-	}
-
+	now := time.Now()
 	qid := t.nextID
 	query := &QueryTask{
 		query:     q.String(),
-		database:  database,
-		status:    RunningTask,
-		startTime: time.Now(),
+		database:  opt.Database,
+		priority:  t.queryPriority(opt),
+		status:    QueuedTask,
+		queueTime: now,
+		startTime: now,
+		admitted:  make(chan struct{}),
 		closing:   make(chan struct{}),
 		monitorCh: make(chan error),
 	}
 	t.queries[qid] = query
+	t.enqueue(query)
+	t.admit()
+
+	if query.status == QueuedTask && len(t.queue) > t.MaxQueuedQueries {
+		// The queue is full, so the lowest priority query is rejected. This
+		// is the new query unless it outranks a query that is already queued.
+		evicted := t.queue[len(t.queue)-1]
+		t.queue = t.queue[:len(t.queue)-1]
+		n := len(t.queries) - len(t.queue) - 1
+		err := ErrMaxConcurrentQueriesLimitExceeded(n, t.MaxConcurrentQueries)
+		if evicted == query {
+			delete(t.queries, qid)
+			t.mu.Unlock()
+			return 0, nil, err
+		}
+		t.evict(evicted, err)
+	}
+	t.nextID++
+	t.mu.Unlock()
+
+	if err := t.waitForAdmission(qid, query, interrupt); err != nil {
+		return 0, nil, err
+	}
 
 	go t.waitForQuery(qid, query.closing, interrupt, query.monitorCh)
 	if t.LogQueriesAfter != 0 {
@@ -188,10 +289,149 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, database string, interrupt
 			return nil
 		})
 	}
-	t.nextID++
 	return qid, query, nil
 }
 
+// queryPriority returns the priority a query runs with.
+func (t *TaskManager) queryPriority(opt ExecutionOptions) QueryPriority {
+	if opt.Priority != DefaultPriority {
+		return opt.Priority
+	}
+	if user, ok := opt.Authorizer.(interface {
+		ID() string
+	}); ok {
+		if p, ok := t.UserPriorities[user.ID()]; ok && p != DefaultPriority {
+			return p
+		}
+	}
+	return InteractivePriority
+}
+
+// enqueue adds the query to the queue after all queries of the same or a
+// higher priority. Must be called under lock.
+func (t *TaskManager) enqueue(query *QueryTask) {
+	i := len(t.queue)
+	for i > 0 && t.queue[i-1].priority < query.priority {
+		i--
+	}
+	t.queue = append(t.queue, nil)
+	copy(t.queue[i+1:], t.queue[i:])
+	t.queue[i] = query
+}
+
+// dequeue removes the query from the queue. Returns false if the query was
+// not queued. Must be called under lock.
+func (t *TaskManager) dequeue(query *QueryTask) bool {
+	for i, q := range t.queue {
+		if q == query {
+			t.queue = append(t.queue[:i], t.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// evict removes a query that was taken off the queue from the TaskManager
+// and fails it with err. Must be called under lock.
+func (t *TaskManager) evict(query *QueryTask, err error) {
+	for id, q := range t.queries {
+		if q == query {
+			delete(t.queries, id)
+			break
+		}
+	}
+	query.setError(err)
+	query.close()
+}
+
+// admit starts queued queries, highest priority first, while there are
+// query slots available for them. Must be called under lock.
+func (t *TaskManager) admit() {
+	now := time.Now()
+	for i := 0; i < len(t.queue); {
+		query := t.queue[i]
+		if !t.hasSlot(query.priority) {
+			i++
+			continue
+		}
+
+		// Queries killed while queued are removed by their own goroutine.
+		query.mu.Lock()
+		if query.status != QueuedTask {
+			query.mu.Unlock()
+			i++
+			continue
+		}
+		query.status = RunningTask
+		query.mu.Unlock()
+
+		t.queue = append(t.queue[:i], t.queue[i+1:]...)
+		query.startTime = now
+		query.waitTime = now.Sub(query.queueTime)
+		atomic.AddInt64(&t.queueWaitDuration, int64(query.waitTime))
+		close(query.admitted)
+	}
+}
+
+// hasSlot returns true if a query with the given priority can be started.
+// Must be called under lock.
+func (t *TaskManager) hasSlot(priority QueryPriority) bool {
+	if t.MaxConcurrentQueries <= 0 {
+		return true
+	}
+
+	limit := t.MaxConcurrentQueries
+	if priority != ContinuousQueryPriority {
+		limit -= t.ReservedContinuousQueries
+	}
+	return len(t.queries)-len(t.queue) < limit
+}
+
+// waitForAdmission blocks until a queued query is started. If the query is
+// interrupted, killed or times out while queued, it is removed from the
+// TaskManager and an error is returned.
+func (t *TaskManager) waitForAdmission(qid uint64, query *QueryTask, interrupt <-chan struct{}) error {
+	var timerCh <-chan time.Time
+	if t.QueueTimeout != 0 {
+		timer := time.NewTimer(t.QueueTimeout)
+		timerCh = timer.C
+		defer timer.Stop()
+	}
+
+	var err error
+	select {
+	case <-query.admitted:
+		return nil
+	case <-interrupt:
+		err = ErrQueryInterrupted
+	case <-query.closing:
+		if err = query.Error(); err == nil {
+			err = ErrQueryInterrupted
+		}
+	case <-timerCh:
+		err = ErrQueryQueueTimeoutLimitExceeded
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.dequeue(query) {
+		query.close()
+		delete(t.queries, qid)
+		return err
+	}
+
+	// The query was either evicted from the queue by a query with a higher
+	// priority or started before it could be removed from the queue. A
+	// started query is left to finish as any other running query would.
+	select {
+	case <-query.admitted:
+		return nil
+	default:
+		return err
+	}
+}
+
 // KillQuery enters a query into the killed state and closes the channel
 // from the TaskManager. This method can be used to forcefully terminate a
 // running query.
@@ -219,9 +459,17 @@ func (t *TaskManager) DetachQuery(qid uint64) error {
 
 	query.close()
 	delete(t.queries, qid)
+	t.admit()
 	return nil
 }
 
+// QueuedQueries returns the number of queries waiting for a free query slot.
+func (t *TaskManager) QueuedQueries() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.queue)
+}
+
 // QueryInfo represents the information for a query.
 type QueryInfo struct {
 	ID       uint64        `json:"id"`
@@ -285,5 +533,6 @@ func (t *TaskManager) Close() error {
 		query.close()
 	}
 	t.queries = nil
+	t.queue = nil
 	return nil
 }
@@ -303,19 +303,15 @@ func (s *Service) ExecuteContinuousQuery(dbi *meta.DatabaseInfo, cqi *meta.Conti
 		cq.setIntoRP(dbi.DefaultRetentionPolicy)
 	}
 
-	// Get the group by interval.
-	interval, err := cq.q.GroupByInterval()
+	// Get the group by interval and offset. When windows overlap, a window
+	// completes every step so the query runs every step.
+	groupBy, err := query.GroupByInterval(cq.q)
 	if err != nil {
 		return false, err
-	} else if interval == 0 {
+	} else if groupBy.IsZero() {
 		return false, nil
 	}
-
-	// Get the group by offset.
-	offset, err := cq.q.GroupByOffset()
-	if err != nil {
-		return false, err
-	}
+	interval, offset := groupBy.Stride(), groupBy.Offset
 
 	// See if this query needs to be run.
 	run, nextRun, err := cq.shouldRunContinuousQuery(now, interval)
@@ -355,6 +351,13 @@ func (s *Service) ExecuteContinuousQuery(dbi *meta.DatabaseInfo, cqi *meta.Conti
 	// Calculate and set the time range for the query.
 	startTime := truncate(nextRun.Add(interval-resampleFor-offset-1), interval).Add(offset)
 	endTime := truncate(now.Add(interval-resampleEvery-offset), interval).Add(offset)
+
+	// Overlapping windows that end within the time range start before it.
+	// The windows that are not complete yet are calculated again by the
+	// following runs, as with RESAMPLE FOR.
+	if groupBy.Sliding() {
+		startTime = startTime.Add(-(groupBy.Duration - groupBy.Step))
+	}
 	if !endTime.After(startTime) {
 		// Exit early since there is no time interval.
 		return false, nil
@@ -420,6 +423,7 @@ func (s *Service) runContinuousQueryAndWriteResult(cq *ContinuousQuery) *query.R
 	// Execute the SELECT.
 	ch := s.QueryExecutor.ExecuteQuery(q, query.ExecutionOptions{
 		Database: cq.Database,
+		Priority: query.ContinuousQueryPriority,
 	}, closing)
 
 	// There is only one statement, so we will only ever receive one result
@@ -81,6 +81,10 @@ type Service struct {
 	PointsWriter interface {
 		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
 	}
+	// RejectedPoints records lines that fail to parse. It may be nil.
+	RejectedPoints interface {
+		WriteRejectedPoints(database string, rejected []tsdb.RejectedPoint) error
+	}
 	MetaClient interface {
 		CreateDatabaseWithRetentionPolicy(name string, spec *meta.RetentionPolicySpec) (*meta.DatabaseInfo, error)
 		CreateRetentionPolicy(database string, spec *meta.RetentionPolicySpec, makeDefault bool) (*meta.RetentionPolicyInfo, error)
@@ -432,8 +436,17 @@ func (s *Service) handleLine(line string) {
 				return
 			}
 		}
-		s.logger.Info(fmt.Sprintf("unable to parse line: %s: %s", line, err))
+		msg := fmt.Sprintf("unable to parse line: %s: %s", line, err)
+		s.logger.Info(msg)
 		atomic.AddInt64(&s.stats.PointsParseFail, 1)
+
+		if s.RejectedPoints != nil {
+			// The line isn't line protocol, so only the message is kept.
+			rejected := []tsdb.RejectedPoint{{RetentionPolicy: s.retentionPolicy, Reason: tsdb.RejectParseError, Message: msg}}
+			if err := s.RejectedPoints.WriteRejectedPoints(s.database, rejected); err != nil {
+				s.logger.Info(fmt.Sprintf("failed to record rejected line: %s", err))
+			}
+		}
 		return
 	}
 
@@ -14,6 +14,10 @@ const (
 
 	// DefaultMaxBodySize is the default maximum size of a client request body, in bytes. Specify 0 for no limit.
 	DefaultMaxBodySize = 25e6
+
+	// DefaultMaxRejectedLines is the default maximum number of rejected lines described in the
+	// response to a partial write. Specify 0 for no limit.
+	DefaultMaxRejectedLines = 100
 )
 
 // Config represents a configuration for a HTTP service.
@@ -34,6 +38,7 @@ type Config struct {
 	UnixSocketEnabled  bool   `toml:"unix-socket-enabled"`
 	BindSocket         string `toml:"bind-socket"`
 	MaxBodySize        int    `toml:"max-body-size"`
+	MaxRejectedLines   int    `toml:"max-rejected-lines"`
 }
 
 // NewConfig returns a new Config with default settings.
@@ -50,6 +55,7 @@ func NewConfig() Config {
 		UnixSocketEnabled: false,
 		BindSocket:        DefaultBindSocket,
 		MaxBodySize:       DefaultMaxBodySize,
+		MaxRejectedLines:  DefaultMaxRejectedLines,
 	}
 }
 
@@ -21,6 +21,7 @@ https-certificate = "/dev/null"
 unix-socket-enabled = true
 bind-socket = "/var/run/influxdb.sock"
 max-body-size = 100
+max-rejected-lines = 10
 `, &c); err != nil {
 		t.Fatal(err)
 	}
@@ -46,6 +47,8 @@ max-body-size = 100
 		t.Fatalf("unexpected bind unix socket: %v", c.BindSocket)
 	} else if c.MaxBodySize != 100 {
 		t.Fatalf("unexpected max-body-size: %v", c.MaxBodySize)
+	} else if c.MaxRejectedLines != 10 {
+		t.Fatalf("unexpected max-rejected-lines: %v", c.MaxRejectedLines)
 	}
 }
 
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/monitor"
 	"github.com/influxdata/influxdb/monitor/diagnostics"
+	"github.com/influxdata/influxdb/pkg/escape"
 	"github.com/influxdata/influxdb/prometheus"
 	"github.com/influxdata/influxdb/prometheus/remote"
 	"github.com/influxdata/influxdb/query"
@@ -107,6 +109,11 @@ type Handler struct {
 		WritePoints(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, user meta.User, points []models.Point) error
 	}
 
+	// RejectedPoints records lines that fail to parse. It may be nil.
+	RejectedPoints interface {
+		WriteRejectedPoints(database string, rejected []tsdb.RejectedPoint) error
+	}
+
 	Config    *Config
 	Logger    *zap.Logger
 	CLFLogger *log.Logger
@@ -418,6 +425,16 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta.U
 		NodeID:    nodeID,
 	}
 
+	// Clients such as exports may lower the priority of their queries.
+	switch priority := r.FormValue("priority"); priority {
+	case "":
+	case "batch":
+		opts.Priority = query.BatchPriority
+	default:
+		h.httpError(rw, fmt.Sprintf("invalid priority: %q", priority), http.StatusBadRequest)
+		return
+	}
+
 	if h.Config.AuthEnabled {
 		// The current user determines the authorized actions.
 		opts.Authorizer = user
@@ -584,6 +601,9 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta.U
 	if !chunked {
 		n, _ := rw.WriteResponse(resp)
 		atomic.AddInt64(&h.stats.QueryRequestBytesTransmitted, int64(n))
+	} else if c, ok := rw.(io.Closer); ok {
+		// End the chunked response for formats that have a trailer.
+		c.Close()
 	}
 }
 
@@ -684,14 +704,16 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta.U
 		h.Logger.Info(fmt.Sprintf("Write body received by handler: %s", buf.Bytes()))
 	}
 
-	points, parseError := models.ParsePointsWithPrecision(buf.Bytes(), time.Now().UTC(), r.URL.Query().Get("precision"))
+	points, lines, parseError := models.ParsePointsWithLines(buf.Bytes(), time.Now().UTC(), r.URL.Query().Get("precision"))
+	h.writeRejectedLines(database, r.URL.Query().Get("rp"), parseError)
+
 	// Not points parsed correctly so return the error now
 	if parseError != nil && len(points) == 0 {
 		if parseError.Error() == "EOF" {
 			h.writeHeader(w, http.StatusOK)
 			return
 		}
-		h.httpError(w, parseError.Error(), http.StatusBadRequest)
+		h.partialWriteError(w, parseError.Error(), h.rejectedLines(nil, nil, nil, parseError))
 		return
 	}
 
@@ -719,7 +741,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta.U
 	} else if werr, ok := err.(tsdb.PartialWriteError); ok {
 		atomic.AddInt64(&h.stats.PointsWrittenOK, int64(len(points)-werr.Dropped))
 		atomic.AddInt64(&h.stats.PointsWrittenDropped, int64(werr.Dropped))
-		h.httpError(w, werr.Error(), http.StatusBadRequest)
+		h.partialWriteError(w, werr.Error(), h.rejectedLines(points, lines, werr.Rejected, parseError))
 		return
 	} else if err != nil {
 		atomic.AddInt64(&h.stats.PointsWrittenFail, int64(len(points)))
@@ -730,7 +752,7 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta.U
 		atomic.AddInt64(&h.stats.PointsWrittenOK, int64(len(points)))
 		// The other points failed to parse which means the client sent invalid line protocol.  We return a 400
 		// response code as well as the lines that failed to parse.
-		h.httpError(w, tsdb.PartialWriteError{Reason: parseError.Error()}.Error(), http.StatusBadRequest)
+		h.partialWriteError(w, tsdb.PartialWriteError{Reason: parseError.Error()}.Error(), h.rejectedLines(nil, nil, nil, parseError))
 		return
 	}
 
@@ -780,6 +802,14 @@ func convertToEpoch(r *query.Result, epoch string) {
 			}
 		}
 	}
+
+	// The time column now holds integers.
+	if len(r.ColumnTypes) > 0 && r.ColumnTypes[0] == influxql.Time {
+		types := make([]influxql.DataType, len(r.ColumnTypes))
+		copy(types, r.ColumnTypes)
+		types[0] = influxql.Integer
+		r.ColumnTypes = types
+	}
 }
 
 // servePromWrite receives data in the Prometheus remote write protocol and writes it
@@ -902,7 +932,7 @@ func (h *Handler) servePromWrite(w http.ResponseWriter, r *http.Request, user me
 	} else if werr, ok := err.(tsdb.PartialWriteError); ok {
 		atomic.AddInt64(&h.stats.PointsWrittenOK, int64(len(points)-werr.Dropped))
 		atomic.AddInt64(&h.stats.PointsWrittenDropped, int64(werr.Dropped))
-		h.httpError(w, werr.Error(), http.StatusBadRequest)
+		h.partialWriteError(w, werr.Error(), h.rejectedLines(points, nil, werr.Rejected, nil))
 		return
 	} else if err != nil {
 		atomic.AddInt64(&h.stats.PointsWrittenFail, int64(len(points)))
@@ -1250,16 +1280,27 @@ func parseSystemDiagnostics(d *diagnostics.Diagnostics) (map[string]interface{},
 
 // httpError writes an error to the client in a standard format.
 func (h *Handler) httpError(w http.ResponseWriter, errmsg string, code int) {
+	h.httpErrorResponse(w, Response{Err: errors.New(errmsg)}, code)
+}
+
+// partialWriteError responds to a write request where some of the points
+// were rejected, describing each rejected line in the response body.
+func (h *Handler) partialWriteError(w http.ResponseWriter, errmsg string, rejected []RejectedLine) {
+	h.httpErrorResponse(w, Response{Err: errors.New(errmsg), Rejected: rejected}, http.StatusBadRequest)
+}
+
+// httpErrorResponse writes an error response containing the provided response body.
+func (h *Handler) httpErrorResponse(w http.ResponseWriter, response Response, code int) {
 	if code == http.StatusUnauthorized {
 		// If an unauthorized header will be sent back, add a WWW-Authenticate header
 		// as an authorization challenge.
 		w.Header().Set("WWW-Authenticate", fmt.Sprintf("Basic realm=\"%s\"", h.Config.Realm))
 	} else if code/100 != 2 {
+		errmsg := response.Err.Error()
 		sz := math.Min(float64(len(errmsg)), 1024.0)
 		w.Header().Set("X-InfluxDB-Error", errmsg[:int(sz)])
 	}
 
-	response := Response{Err: errors.New(errmsg)}
 	if rw, ok := w.(ResponseWriter); ok {
 		h.writeHeader(w, code)
 		rw.WriteResponse(response)
@@ -1556,14 +1597,18 @@ func (h *Handler) recovery(inner http.Handler, name string) http.Handler {
 type Response struct {
 	Results []*query.Result
 	Err     error
+
+	// Rejected describes the lines that were not written by a write request.
+	Rejected []RejectedLine
 }
 
 // MarshalJSON encodes a Response struct into JSON.
 func (r Response) MarshalJSON() ([]byte, error) {
 	// Define a struct that outputs "error" as a string.
 	var o struct {
-		Results []*query.Result `json:"results,omitempty"`
-		Err     string          `json:"error,omitempty"`
+		Results  []*query.Result `json:"results,omitempty"`
+		Err      string          `json:"error,omitempty"`
+		Rejected []RejectedLine  `json:"rejected,omitempty"`
 	}
 
 	// Copy fields to output struct.
@@ -1571,6 +1616,7 @@ func (r Response) MarshalJSON() ([]byte, error) {
 	if r.Err != nil {
 		o.Err = r.Err.Error()
 	}
+	o.Rejected = r.Rejected
 
 	return json.Marshal(&o)
 }
@@ -1578,8 +1624,9 @@ func (r Response) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON decodes the data into the Response struct.
 func (r *Response) UnmarshalJSON(b []byte) error {
 	var o struct {
-		Results []*query.Result `json:"results,omitempty"`
-		Err     string          `json:"error,omitempty"`
+		Results  []*query.Result `json:"results,omitempty"`
+		Err      string          `json:"error,omitempty"`
+		Rejected []RejectedLine  `json:"rejected,omitempty"`
 	}
 
 	err := json.Unmarshal(b, &o)
@@ -1590,9 +1637,92 @@ func (r *Response) UnmarshalJSON(b []byte) error {
 	if o.Err != "" {
 		r.Err = errors.New(o.Err)
 	}
+	r.Rejected = o.Rejected
 	return nil
 }
 
+// RejectedLine describes a single line of a write request that was not written.
+type RejectedLine struct {
+	// The 1-based line number within the request body. This is zero if the
+	// line could not be determined.
+	Line int `json:"line,omitempty"`
+
+	// The measurement the line was written to, if known.
+	Measurement string `json:"measurement,omitempty"`
+
+	// The category of the rejection, such as "parse error" or "field type conflict".
+	Reason string `json:"reason"`
+
+	// A description of the specific failure.
+	Err string `json:"error"`
+}
+
+// writeRejectedLines records the lines of a write request that failed to parse.
+func (h *Handler) writeRejectedLines(database, retentionPolicy string, parseError error) {
+	perr, ok := parseError.(*models.ParseError)
+	if !ok || h.RejectedPoints == nil {
+		return
+	}
+
+	rejected := make([]tsdb.RejectedPoint, len(perr.Lines))
+	for i, l := range perr.Lines {
+		rejected[i] = tsdb.RejectedPoint{
+			Line:            []byte(l.Text),
+			RetentionPolicy: retentionPolicy,
+			Reason:          tsdb.RejectParseError,
+			Message:         l.Error(),
+		}
+	}
+	if err := h.RejectedPoints.WriteRejectedPoints(database, rejected); err != nil {
+		h.Logger.Info(fmt.Sprintf("Failed to record rejected lines: %s", err))
+	}
+}
+
+// rejectedLines builds the list of rejected lines reported for a write request
+// from the lines that failed to parse and the points dropped by the points
+// writer. The list is ordered by line number and limited to the configured
+// maximum number of rejected lines.
+func (h *Handler) rejectedLines(points []models.Point, lines []int, rejected []tsdb.RejectedPoint, parseError error) []RejectedLine {
+	var a []RejectedLine
+	if perr, ok := parseError.(*models.ParseError); ok {
+		for _, l := range perr.Lines {
+			name, _ := models.ParseName([]byte(l.Text))
+			a = append(a, RejectedLine{
+				Line:        l.Line,
+				Measurement: string(escape.Unescape(name)),
+				Reason:      tsdb.RejectParseError,
+				Err:         l.Err.Error(),
+			})
+		}
+	}
+
+	if len(rejected) > 0 {
+		// Map each point back to the line it was parsed from.
+		var lineByPoint map[models.Point]int
+		if len(lines) == len(points) {
+			lineByPoint = make(map[models.Point]int, len(points))
+			for i, p := range points {
+				lineByPoint[p] = lines[i]
+			}
+		}
+
+		for _, r := range rejected {
+			line := RejectedLine{Reason: r.Reason, Err: r.Message}
+			if r.Point != nil {
+				line.Line = lineByPoint[r.Point]
+				line.Measurement = string(r.Point.Name())
+			}
+			a = append(a, line)
+		}
+	}
+
+	sort.SliceStable(a, func(i, j int) bool { return a[i].Line < a[j].Line })
+	if max := h.Config.MaxRejectedLines; max > 0 && len(a) > max {
+		a = a[:max]
+	}
+	return a
+}
+
 // Error returns the first error from any statement.
 // Returns nil if no errors occurred on any statements.
 func (r *Response) Error() error {
@@ -2,6 +2,7 @@ package httpd_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/httpd"
 	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/tsdb"
 	"github.com/influxdata/influxql"
 )
 
@@ -311,6 +313,34 @@ func TestHandler_Query_Chunked(t *testing.T) {
 	}
 }
 
+// Ensure the handler ends the Arrow stream of a chunked query.
+func TestHandler_Query_Chunked_Arrow(t *testing.T) {
+	h := NewHandler(false)
+	h.StatementExecutor.ExecuteStatementFn = func(stmt influxql.Statement, ctx query.ExecutionContext) error {
+		for i := 0; i < 2; i++ {
+			ctx.Results <- &query.Result{StatementID: 1, Series: models.Rows([]*models.Row{{
+				Name:    "cpu",
+				Columns: []string{"time", "value"},
+				Values:  [][]interface{}{{time.Unix(0, int64(i)), float64(i)}},
+			}})}
+		}
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	r := MustNewRequest("GET", "/query?db=foo&q=SELECT+*+FROM+bar&chunked=true&chunk_size=1", nil)
+	r.Header.Set("Accept", "application/vnd.apache.arrow.stream")
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	// Both chunks share a stream, which must be ended before the response is.
+	if got, want := arrowMessages(t, w.Body.Bytes()), []string{"metadata", "batch", "batch", "eos"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected messages: got %v, want %v", got, want)
+	}
+}
+
 // Ensure the handler can accept an async query.
 func TestHandler_Query_Async(t *testing.T) {
 	done := make(chan struct{})
@@ -817,6 +847,63 @@ func TestHandler_Write_NegativeMaxBodySize(t *testing.T) {
 	}
 }
 
+// Ensure a partial write describes each rejected line in the response body.
+func TestHandler_Write_PartialWrite_RejectedLines(t *testing.T) {
+	b := bytes.NewReader([]byte("cpu value=1 10\ncpu value= 20\nmem value=\"a\" 30\ncpu value=2 40"))
+	h := NewHandler(false)
+	h.MetaClient.DatabaseFn = func(name string) *meta.DatabaseInfo {
+		return &meta.DatabaseInfo{}
+	}
+	h.PointsWriter.WritePointsFn = func(_, _ string, _ models.ConsistencyLevel, _ meta.User, points []models.Point) error {
+		if len(points) != 3 {
+			t.Fatalf("unexpected number of points: %d", len(points))
+		}
+		return tsdb.PartialWriteError{
+			Reason:  "field type conflict",
+			Dropped: 1,
+			Rejected: []tsdb.RejectedPoint{{
+				Point:   points[1],
+				Key:     points[1].Key(),
+				Reason:  tsdb.RejectFieldTypeConflict,
+				Message: "field type conflict",
+			}},
+		}
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("POST", "/write?db=foo", b))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	var resp httpd.Response
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Err == nil {
+		t.Fatal("expected error")
+	}
+
+	exp := []httpd.RejectedLine{
+		{Line: 2, Measurement: "cpu", Reason: "parse error", Err: "missing field value"},
+		{Line: 3, Measurement: "mem", Reason: tsdb.RejectFieldTypeConflict, Err: "field type conflict"},
+	}
+	if !reflect.DeepEqual(resp.Rejected, exp) {
+		t.Fatalf("unexpected rejected lines:\n\tgot = %+v\n\texp = %+v", resp.Rejected, exp)
+	}
+
+	// Ensure the number of rejected lines reported is limited.
+	h.Config.MaxRejectedLines = 1
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("POST", "/write?db=foo", bytes.NewReader([]byte("cpu value=1 10\ncpu value= 20\nmem value=\"a\" 30\ncpu value=2 40"))))
+	resp = httpd.Response{}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(resp.Rejected, exp[:1]) {
+		t.Fatalf("unexpected rejected lines:\n\tgot = %+v\n\texp = %+v", resp.Rejected, exp[:1])
+	}
+}
+
 // Ensure X-Forwarded-For header writes the correct log message.
 func TestHandler_XForwardedFor(t *testing.T) {
 	var buf bytes.Buffer
@@ -3,12 +3,16 @@ package httpd
 import (
 	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/arrow"
+	"github.com/influxdata/influxql"
 	"github.com/tinylib/msgp/msgp"
 )
 
@@ -32,6 +36,9 @@ func NewResponseWriter(w http.ResponseWriter, r *http.Request) ResponseWriter {
 	case "application/x-msgpack":
 		w.Header().Add("Content-Type", "application/x-msgpack")
 		rw.formatter = &msgpackFormatter{Writer: w}
+	case arrow.ContentType:
+		w.Header().Add("Content-Type", arrow.ContentType)
+		rw.formatter = &arrowFormatter{statementID: -1, Chunked: r.URL.Query().Get("chunked") == "true", Writer: w}
 	case "application/json":
 		fallthrough
 	default:
@@ -59,6 +66,15 @@ func (w *responseWriter) WriteResponse(resp Response) (int, error) {
 	return w.formatter.WriteResponse(resp)
 }
 
+// Close ends the response, for formatters that need to write a trailer once
+// all of the chunks of a response have been written.
+func (w *responseWriter) Close() error {
+	if c, ok := w.formatter.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
 // Flush flushes the ResponseWriter if it has a Flush() method.
 func (w *responseWriter) Flush() {
 	if w, ok := w.ResponseWriter.(http.Flusher); ok {
@@ -294,3 +310,286 @@ func (f *msgpackFormatter) WriteResponse(resp Response) (n int, err error) {
 	}
 	return 0, nil
 }
+
+// arrowFormatter writes responses as Apache Arrow IPC streams.
+//
+// Each statement is written as a stream and each of its series as a record
+// batch with a column for the measurement name, a column for each tag and a
+// typed column for each of the series' columns. The column types come from
+// the result when the statement knows them and are otherwise inferred from
+// the values. A chunked response writes one record batch per chunk and the
+// last stream is ended by Close.
+//
+// A stream has a single schema, so the series of a statement must all have
+// the same columns. A series with different columns, such as one with other
+// tags, ends the stream with an error and the rest of the statement is
+// skipped.
+type arrowFormatter struct {
+	io.Writer
+	Chunked     bool
+	statementID int
+	w           *arrow.Writer
+
+	// rejected is set once a series of the current statement did not match
+	// the schema of its stream.
+	rejected bool
+}
+
+func (f *arrowFormatter) WriteResponse(resp Response) (n int, err error) {
+	if resp.Err != nil {
+		return 0, f.writeError(resp.Err)
+	}
+
+	for _, result := range resp.Results {
+		if result.Err != nil {
+			if err := f.writeError(result.Err); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		for _, row := range result.Series {
+			if f.rejected && result.StatementID == f.statementID {
+				continue
+			}
+
+			schema := f.schema(result.StatementID, result.ColumnTypes, row)
+			if f.w != nil && result.StatementID == f.statementID && !f.w.Schema().Equal(schema) {
+				f.rejected = true
+				if err := f.writeError(fmt.Errorf("series %s does not have the same columns as the earlier series of statement %d", row.Name, result.StatementID)); err != nil {
+					return 0, err
+				}
+				continue
+			}
+
+			if err := f.writeRow(result.StatementID, schema, row); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	// A response that is not chunked is written all at once, so the stream
+	// can be ended. Otherwise the next chunk may continue it and it is ended
+	// by Close.
+	if !f.Chunked {
+		return 0, f.closeStream()
+	}
+	return 0, nil
+}
+
+// Close ends the stream in progress, if any.
+func (f *arrowFormatter) Close() error {
+	return f.closeStream()
+}
+
+// writeError writes err as a stream with a single "error" column, ending any
+// stream in progress.
+func (f *arrowFormatter) writeError(err error) error {
+	if err := f.closeStream(); err != nil {
+		return err
+	}
+
+	col := arrow.NewColumn(arrow.String)
+	col.AppendString(err.Error())
+
+	w := arrow.NewWriter(f.Writer, arrow.Schema{{Name: "error", Type: arrow.String}})
+	if err := w.WriteRecordBatch([]*arrow.Column{col}); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// schema returns the schema of a record batch for row. The types of the
+// columns are taken from types if it has one for each column.
+func (f *arrowFormatter) schema(statementID int, types []influxql.DataType, row *models.Row) arrow.Schema {
+	tags := make([]string, 0, len(row.Tags))
+	for k := range row.Tags {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+	if len(types) != len(row.Columns) {
+		types = nil
+	}
+
+	schema := make(arrow.Schema, 0, 1+len(tags)+len(row.Columns))
+	schema = append(schema, arrow.Field{Name: "name", Type: arrow.String})
+	for _, k := range tags {
+		schema = append(schema, arrow.Field{Name: k, Type: arrow.String})
+	}
+	for i, name := range row.Columns {
+		var typ arrow.Type
+		if types != nil && types[i] != influxql.Unknown {
+			typ = arrowType(types[i])
+		} else {
+			typ = f.columnType(statementID, len(schema), name, row.Values, i)
+		}
+		schema = append(schema, arrow.Field{Name: name, Type: typ})
+	}
+	return schema
+}
+
+func (f *arrowFormatter) writeRow(statementID int, schema arrow.Schema, row *models.Row) error {
+	// Start a new stream for each statement.
+	if f.w == nil || statementID != f.statementID {
+		if err := f.closeStream(); err != nil {
+			return err
+		}
+		f.w = arrow.NewWriter(f.Writer, schema)
+		f.statementID = statementID
+		f.rejected = false
+	}
+
+	tags := len(schema) - 1 - len(row.Columns)
+	columns := make([]*arrow.Column, len(schema))
+	for i, field := range schema {
+		columns[i] = arrow.NewColumn(field.Type)
+	}
+	for _, values := range row.Values {
+		columns[0].AppendString(row.Name)
+		for i := 0; i < tags; i++ {
+			columns[1+i].AppendString(row.Tags[schema[1+i].Name])
+		}
+		for i, v := range values {
+			appendArrowValue(columns[1+tags+i], v)
+		}
+	}
+	return f.w.WriteRecordBatch(columns)
+}
+
+// columnType returns the type of the column at index i of the values. A
+// column with only null values keeps the type of the field at the same
+// position in the statement's stream so that chunks match its schema.
+func (f *arrowFormatter) columnType(statementID, pos int, name string, values [][]interface{}, i int) arrow.Type {
+	typ, ok := inferArrowType(values, i)
+	if !ok && f.w != nil && statementID == f.statementID {
+		if schema := f.w.Schema(); pos < len(schema) && schema[pos].Name == name {
+			return schema[pos].Type
+		}
+	}
+	return typ
+}
+
+// closeStream ends the stream in progress, if any.
+func (f *arrowFormatter) closeStream() error {
+	if f.w == nil {
+		return nil
+	}
+	w := f.w
+	f.w = nil
+	return w.Close()
+}
+
+// inferArrowType returns the type of the values in column i. Columns mixing
+// numeric types are returned as floats and columns mixing other types are
+// returned as strings. Returns false if the column only contains nulls.
+func inferArrowType(values [][]interface{}, i int) (arrow.Type, bool) {
+	var typ arrow.Type
+	var found bool
+	for _, row := range values {
+		var t arrow.Type
+		switch row[i].(type) {
+		case float64:
+			t = arrow.Float64
+		case int64:
+			t = arrow.Int64
+		case uint64:
+			t = arrow.Uint64
+		case string:
+			t = arrow.String
+		case bool:
+			t = arrow.Boolean
+		case time.Time:
+			t = arrow.Timestamp
+		default:
+			continue
+		}
+
+		if !found {
+			typ, found = t, true
+		} else if t != typ {
+			if isArrowNumeric(t) && isArrowNumeric(typ) {
+				typ = arrow.Float64
+			} else {
+				return arrow.String, true
+			}
+		}
+	}
+	if !found {
+		return arrow.String, false
+	}
+	return typ, true
+}
+
+// arrowType returns the Arrow type of an influxql type.
+func arrowType(typ influxql.DataType) arrow.Type {
+	switch typ {
+	case influxql.Float:
+		return arrow.Float64
+	case influxql.Integer:
+		return arrow.Int64
+	case influxql.Unsigned:
+		return arrow.Uint64
+	case influxql.Boolean:
+		return arrow.Boolean
+	case influxql.Time:
+		return arrow.Timestamp
+	default:
+		return arrow.String
+	}
+}
+
+func isArrowNumeric(typ arrow.Type) bool {
+	return typ == arrow.Float64 || typ == arrow.Int64 || typ == arrow.Uint64
+}
+
+// appendArrowValue appends v to col, converting it to the column type.
+func appendArrowValue(col *arrow.Column, v interface{}) {
+	switch col.Type() {
+	case arrow.Float64:
+		switch v := v.(type) {
+		case float64:
+			col.AppendFloat64(v)
+		case int64:
+			col.AppendFloat64(float64(v))
+		case uint64:
+			col.AppendFloat64(float64(v))
+		default:
+			col.AppendNull()
+		}
+	case arrow.Int64:
+		if v, ok := v.(int64); ok {
+			col.AppendInt64(v)
+		} else {
+			col.AppendNull()
+		}
+	case arrow.Uint64:
+		if v, ok := v.(uint64); ok {
+			col.AppendUint64(v)
+		} else {
+			col.AppendNull()
+		}
+	case arrow.Boolean:
+		if v, ok := v.(bool); ok {
+			col.AppendBool(v)
+		} else {
+			col.AppendNull()
+		}
+	case arrow.Timestamp:
+		if v, ok := v.(time.Time); ok {
+			col.AppendInt64(v.UnixNano())
+		} else {
+			col.AppendNull()
+		}
+	case arrow.String:
+		switch v := v.(type) {
+		case nil:
+			col.AppendNull()
+		case string:
+			col.AppendString(v)
+		case time.Time:
+			col.AppendString(v.UTC().Format(time.RFC3339Nano))
+		default:
+			col.AppendString(fmt.Sprint(v))
+		}
+	}
+}
@@ -2,19 +2,23 @@ package httpd_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/arrow"
 	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/httpd"
+	"github.com/influxdata/influxql"
 	"github.com/tinylib/msgp/msgp"
 )
 
@@ -154,3 +158,195 @@ func TestResponseWriter_MessagePack_Error(t *testing.T) {
 		t.Fatalf("unexpected output: %s != %s", have, want)
 	}
 }
+
+func TestResponseWriter_Arrow(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Accept", "application/vnd.apache.arrow.stream")
+	r := &http.Request{
+		Header: header,
+		URL:    &url.URL{},
+	}
+	w := httptest.NewRecorder()
+
+	writer := httpd.NewResponseWriter(w, r)
+	writer.WriteResponse(httpd.Response{
+		Results: []*query.Result{
+			{
+				StatementID: 0,
+				Series: []*models.Row{
+					{
+						Name:    "cpu",
+						Tags:    map[string]string{"host": "server01"},
+						Columns: []string{"time", "value"},
+						Values: [][]interface{}{
+							{time.Unix(0, 10), float64(2.5)},
+							{time.Unix(0, 20), int64(5)},
+							{time.Unix(0, 30), nil},
+						},
+					},
+					{
+						Name:    "cpu",
+						Tags:    map[string]string{"host": "server02"},
+						Columns: []string{"time", "value"},
+						Values: [][]interface{}{
+							{time.Unix(0, 10), float64(1)},
+						},
+					},
+				},
+			},
+			{
+				StatementID: 1,
+				Err:         fmt.Errorf("test error"),
+			},
+		},
+	})
+
+	if got, want := w.Header().Get("Content-Type"), "application/vnd.apache.arrow.stream"; got != want {
+		t.Fatalf("unexpected content type: got %s, want %s", got, want)
+	}
+
+	// Both series share a stream, which is followed by a stream for the error.
+	if got, want := arrowMessages(t, w.Body.Bytes()), []string{"metadata", "batch", "batch", "eos", "metadata", "batch", "eos"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected messages: got %v, want %v", got, want)
+	}
+}
+
+// Ensure the column types of a result are used instead of being inferred.
+func TestResponseWriter_Arrow_ColumnTypes(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Accept", "application/vnd.apache.arrow.stream")
+	r := &http.Request{
+		Header: header,
+		URL:    &url.URL{},
+	}
+	w := httptest.NewRecorder()
+
+	writer := httpd.NewResponseWriter(w, r)
+	writer.WriteResponse(httpd.Response{
+		Results: []*query.Result{{
+			StatementID: 0,
+			Series: []*models.Row{{
+				Name:    "cpu",
+				Columns: []string{"time", "value"},
+				Values: [][]interface{}{
+					{time.Unix(0, 10), nil},
+					{time.Unix(0, 20), nil},
+				},
+			}},
+			ColumnTypes: []influxql.DataType{influxql.Time, influxql.Integer},
+		}},
+	})
+
+	// The value column only has nulls, but is still an integer column.
+	var buf bytes.Buffer
+	aw := arrow.NewWriter(&buf, arrow.Schema{
+		{Name: "name", Type: arrow.String},
+		{Name: "time", Type: arrow.Timestamp},
+		{Name: "value", Type: arrow.Int64},
+	})
+	name, ts, value := arrow.NewColumn(arrow.String), arrow.NewColumn(arrow.Timestamp), arrow.NewColumn(arrow.Int64)
+	for _, v := range []int64{10, 20} {
+		name.AppendString("cpu")
+		ts.AppendInt64(v)
+		value.AppendNull()
+	}
+	if err := aw.WriteRecordBatch([]*arrow.Column{name, ts, value}); err != nil {
+		t.Fatal(err)
+	} else if err := aw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(w.Body.Bytes(), buf.Bytes()) {
+		t.Fatalf("unexpected stream:\n got: %x\nwant: %x", w.Body.Bytes(), buf.Bytes())
+	}
+}
+
+// Ensure a series with other columns than the earlier series of its
+// statement ends the stream with an error instead of starting a new one.
+func TestResponseWriter_Arrow_MixedSchema(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Accept", "application/vnd.apache.arrow.stream")
+	r := &http.Request{
+		Header: header,
+		URL:    &url.URL{},
+	}
+	w := httptest.NewRecorder()
+
+	writer := httpd.NewResponseWriter(w, r)
+	writer.WriteResponse(httpd.Response{
+		Results: []*query.Result{
+			{
+				StatementID: 0,
+				Series: []*models.Row{
+					{
+						Name:    "cpu",
+						Tags:    map[string]string{"host": "server01"},
+						Columns: []string{"time", "value"},
+						Values:  [][]interface{}{{time.Unix(0, 10), float64(1)}},
+					},
+					{
+						Name:    "mem",
+						Tags:    map[string]string{"region": "west"},
+						Columns: []string{"time", "value"},
+						Values:  [][]interface{}{{time.Unix(0, 10), float64(2)}},
+					},
+					{
+						Name:    "cpu",
+						Tags:    map[string]string{"host": "server02"},
+						Columns: []string{"time", "value"},
+						Values:  [][]interface{}{{time.Unix(0, 10), float64(3)}},
+					},
+				},
+			},
+			{
+				StatementID: 1,
+				Series: []*models.Row{{
+					Name:    "mem",
+					Tags:    map[string]string{"region": "west"},
+					Columns: []string{"time", "value"},
+					Values:  [][]interface{}{{time.Unix(0, 10), float64(2)}},
+				}},
+			},
+		},
+	})
+
+	// The rest of the first statement is skipped after the error, and the
+	// next statement has its own stream.
+	if got, want := arrowMessages(t, w.Body.Bytes()), []string{"metadata", "batch", "eos", "metadata", "batch", "eos", "metadata", "batch", "eos"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected messages: got %v, want %v", got, want)
+	}
+	if !bytes.Contains(w.Body.Bytes(), []byte("series mem does not have the same columns as the earlier series of statement 0")) {
+		t.Fatalf("missing schema error")
+	}
+}
+
+// arrowMessages returns the kind of each message in a sequence of Arrow
+// streams: "eos" for an end-of-stream marker, "batch" for a message with a
+// body and "metadata" for a message without one.
+func arrowMessages(t *testing.T, b []byte) []string {
+	var a []string
+	for len(b) > 0 {
+		if len(b) < 8 || binary.LittleEndian.Uint32(b) != 0xffffffff {
+			t.Fatalf("missing continuation marker")
+		}
+		n := int(binary.LittleEndian.Uint32(b[4:]))
+		if n == 0 {
+			a = append(a, "eos")
+			b = b[8:]
+			continue
+		}
+
+		// The body length is the only 8-byte field of the message table,
+		// so it directly follows the table's vtable offset.
+		meta := b[8 : 8+n]
+		pos := int(binary.LittleEndian.Uint32(meta))
+		bodyLen := int(binary.LittleEndian.Uint64(meta[pos+4:]))
+		if bodyLen > 0 {
+			a = append(a, "batch")
+		} else {
+			a = append(a, "metadata")
+		}
+		b = b[8+n+bodyLen:]
+	}
+	return a
+}
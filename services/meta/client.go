@@ -907,6 +907,47 @@ func (c *Client) CreateSubscription(database, rp, name, mode string, destination
 	return nil
 }
 
+// CreateSubscriptionWithFilter creates a subscription against the given database and retention policy
+// that only receives the points matching filter.
+func (c *Client) CreateSubscriptionWithFilter(database, rp, name, mode string, destinations []string, filter string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.CreateSubscription(database, rp, name, mode, destinations); err != nil {
+		return err
+	}
+
+	if err := data.SetSubscriptionFilter(database, rp, name, filter); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetSubscriptionFilter sets the filter of the named subscription on the given database and retention policy.
+func (c *Client) SetSubscriptionFilter(database, rp, name, filter string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetSubscriptionFilter(database, rp, name, filter); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DropSubscription removes the named subscription from the given database and retention policy.
 func (c *Client) DropSubscription(database, rp, name string) error {
 	c.mu.Lock()
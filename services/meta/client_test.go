@@ -870,6 +870,14 @@ func TestMetaClient_Subscriptions_Create(t *testing.T) {
 	if err := c.CreateSubscription("db0", "autogen", "sub4", "ALL", []string{"https://example.com:9092"}); err != nil {
 		t.Fatal(err)
 	}
+
+	// Create Kafka and NATS subscriptions.
+	if err := c.CreateSubscription("db0", "autogen", "sub5", "ALL", []string{"kafka://example.com:9092/metrics"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSubscription("db0", "autogen", "sub6", "ALL", []string{"nats://example.com:4222/metrics"}); err != nil {
+		t.Fatal(err)
+	}
 }
 
 func TestMetaClient_Subscriptions_Drop(t *testing.T) {
@@ -917,6 +925,91 @@ func TestMetaClient_Subscriptions_Drop(t *testing.T) {
 	}
 }
 
+func TestMetaClient_Subscriptions_SetFilter(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+	defer c.Close()
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSubscription("db0", "autogen", "sub0", "ALL", []string{"udp://example.com:9090"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Set a filter on the subscription.
+	if err := c.SetSubscriptionFilter("db0", "autogen", "sub0", `"measurement" =~ /^http_/ AND region = 'eu'`); err != nil {
+		t.Fatal(err)
+	}
+	rp, err := c.RetentionPolicy("db0", "autogen")
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := rp.Subscriptions[0].Filter, `"measurement" =~ /^http_/ AND region = 'eu'`; got != exp {
+		t.Fatalf("unexpected filter: got %s, exp %s", got, exp)
+	}
+
+	// Filters must only reference the measurement and tags.
+	err = c.SetSubscriptionFilter("db0", "autogen", "sub0", `count(value) > 1`)
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid subscription filter") {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The subscription must exist.
+	err = c.SetSubscriptionFilter("db0", "autogen", "foo", `region = 'eu'`)
+	if got, exp := err, meta.ErrSubscriptionNotFound; got == nil || got.Error() != exp.Error() {
+		t.Fatalf("got: %s, exp: %s", got, exp)
+	}
+
+	// Clear the filter.
+	if err := c.SetSubscriptionFilter("db0", "autogen", "sub0", ""); err != nil {
+		t.Fatal(err)
+	}
+	if rp, err := c.RetentionPolicy("db0", "autogen"); err != nil {
+		t.Fatal(err)
+	} else if rp.Subscriptions[0].Filter != "" {
+		t.Fatalf("unexpected filter: %s", rp.Subscriptions[0].Filter)
+	}
+}
+
+func TestMetaClient_CreateSubscriptionWithFilter(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+	defer c.Close()
+
+	if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSubscriptionWithFilter("db0", "autogen", "sub0", "ALL", []string{"udp://example.com:9090"}, `region = 'eu'`); err != nil {
+		t.Fatal(err)
+	}
+
+	rp, err := c.RetentionPolicy("db0", "autogen")
+	if err != nil {
+		t.Fatal(err)
+	} else if len(rp.Subscriptions) != 1 {
+		t.Fatalf("unexpected subscriptions: %#v", rp.Subscriptions)
+	} else if got, exp := rp.Subscriptions[0].Filter, `region = 'eu'`; got != exp {
+		t.Fatalf("unexpected filter: got %s, exp %s", got, exp)
+	}
+
+	// An invalid filter must not create the subscription.
+	for _, filter := range []string{`count(value) > 1`, `value::field > 1`, `value::float > 1`} {
+		err = c.CreateSubscriptionWithFilter("db0", "autogen", "sub1", "ALL", []string{"udp://example.com:9090"}, filter)
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid subscription filter") {
+			t.Fatalf("%s: unexpected error: %s", filter, err)
+		}
+	}
+	if rp, err := c.RetentionPolicy("db0", "autogen"); err != nil {
+		t.Fatal(err)
+	} else if len(rp.Subscriptions) != 1 {
+		t.Fatalf("unexpected subscriptions: %#v", rp.Subscriptions)
+	}
+}
+
 func TestMetaClient_Shards(t *testing.T) {
 	t.Parallel()
 
@@ -452,14 +452,16 @@ func (data *Data) DropContinuousQuery(database, name string) error {
 	return ErrContinuousQueryNotFound
 }
 
-// validateURL returns an error if the URL does not have a port or uses a scheme other than UDP or HTTP.
+// validateURL returns an error if the URL does not have a port or uses a scheme other than UDP, HTTP, Kafka or NATS.
 func validateURL(input string) error {
 	u, err := url.Parse(input)
 	if err != nil {
 		return ErrInvalidSubscriptionURL(input)
 	}
 
-	if u.Scheme != "udp" && u.Scheme != "http" && u.Scheme != "https" {
+	switch u.Scheme {
+	case "udp", "http", "https", "kafka", "nats":
+	default:
 		return ErrInvalidSubscriptionURL(input)
 	}
 
@@ -503,6 +505,59 @@ func (data *Data) CreateSubscription(database, rp, name, mode string, destinatio
 	return nil
 }
 
+// SetSubscriptionFilter sets the predicate points must match to be sent to a
+// subscription. The filter may reference the measurement name and any tag
+// key, as described by query.CreateSubscriptionStatement. An empty filter
+// sends every point.
+func (data *Data) SetSubscriptionFilter(database, rp, name, filter string) error {
+	if filter != "" {
+		expr, err := influxql.ParseExpr(filter)
+		if err != nil {
+			return ErrInvalidSubscriptionFilter(filter, err)
+		} else if err := validateSubscriptionFilter(expr); err != nil {
+			return ErrInvalidSubscriptionFilter(filter, err)
+		}
+		filter = expr.String()
+	}
+
+	rpi, err := data.RetentionPolicy(database, rp)
+	if err != nil {
+		return err
+	} else if rpi == nil {
+		return influxdb.ErrRetentionPolicyNotFound(rp)
+	}
+
+	for i := range rpi.Subscriptions {
+		if rpi.Subscriptions[i].Name == name {
+			rpi.Subscriptions[i].Filter = filter
+			return nil
+		}
+	}
+	return ErrSubscriptionNotFound
+}
+
+// validateSubscriptionFilter returns an error if the filter cannot be
+// evaluated against the measurement and tags of a point.
+func validateSubscriptionFilter(expr influxql.Expr) error {
+	var err error
+	influxql.WalkFunc(expr, func(n influxql.Node) {
+		if err != nil {
+			return
+		}
+		switch n := n.(type) {
+		case *influxql.Call:
+			err = fmt.Errorf("function calls are not allowed: %s", n)
+		case *influxql.Wildcard:
+			err = errors.New("wildcards are not allowed")
+		case *influxql.VarRef:
+			if n.Type != influxql.Unknown && n.Type != influxql.Tag {
+				err = fmt.Errorf("only the measurement and tags can be referenced: %s", n)
+			}
+		}
+	})
+	return err
+}
+
 // DropSubscription removes a subscription.
 func (data *Data) DropSubscription(database, rp, name string) error {
 	rpi, err := data.RetentionPolicy(database, rp)
@@ -1486,6 +1541,10 @@ type SubscriptionInfo struct {
 	Name         string
 	Mode         string
 	Destinations []string
+
+	// Filter is an InfluxQL expression points must match to be sent to
+	// the destinations. Empty if every point is sent.
+	Filter string
 }
 
 // marshal serializes to a protobuf representation.
@@ -1494,6 +1553,9 @@ func (si SubscriptionInfo) marshal() *internal.SubscriptionInfo {
 		Name: proto.String(si.Name),
 		Mode: proto.String(si.Mode),
 	}
+	if si.Filter != "" {
+		pb.Filter = proto.String(si.Filter)
+	}
 
 	pb.Destinations = make([]string, len(si.Destinations))
 	for i := range si.Destinations {
@@ -1506,6 +1568,7 @@ func (si SubscriptionInfo) marshal() *internal.SubscriptionInfo {
 func (si *SubscriptionInfo) unmarshal(pb *internal.SubscriptionInfo) {
 	si.Name = pb.GetName()
 	si.Mode = pb.GetMode()
+	si.Filter = pb.GetFilter()
 
 	if len(pb.GetDestinations()) > 0 {
 		si.Destinations = make([]string, len(pb.GetDestinations()))
@@ -100,6 +100,11 @@ func ErrInvalidSubscriptionURL(url string) error {
 	return fmt.Errorf("invalid subscription URL: %s", url)
 }
 
+// ErrInvalidSubscriptionFilter is returned when the subscription's filter is invalid.
+func ErrInvalidSubscriptionFilter(filter string, err error) error {
+	return fmt.Errorf("invalid subscription filter %q: %s", filter, err)
+}
+
 var (
 	// ErrUserExists is returned when creating an already existing user.
 	ErrUserExists = errors.New("user already exists")
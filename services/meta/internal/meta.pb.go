@@ -549,6 +549,7 @@ type SubscriptionInfo struct {
 	Name             *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
 	Mode             *string  `protobuf:"bytes,2,req,name=Mode" json:"Mode,omitempty"`
 	Destinations     []string `protobuf:"bytes,3,rep,name=Destinations" json:"Destinations,omitempty"`
+	Filter           *string  `protobuf:"bytes,4,opt,name=Filter" json:"Filter,omitempty"`
 	XXX_unrecognized []byte   `json:"-"`
 }
 
@@ -578,6 +579,13 @@ func (m *SubscriptionInfo) GetDestinations() []string {
 	return nil
 }
 
+func (m *SubscriptionInfo) GetFilter() string {
+	if m != nil && m.Filter != nil {
+		return *m.Filter
+	}
+	return ""
+}
+
 type ShardOwner struct {
 	NodeID           *uint64 `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
 	XXX_unrecognized []byte  `json:"-"`
@@ -1894,118 +1902,119 @@ func init() {
 func init() { proto.RegisterFile("internal/meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1808 bytes of a gzipped FileDescriptorProto
+	// 1815 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
 	0x11, 0x46, 0xcf, 0x3e, 0xb8, 0x5b, 0x7c, 0xaa, 0xf9, 0x1a, 0x4a, 0x14, 0xb3, 0x18, 0x08, 0xca,
-	0x22, 0x08, 0x98, 0x60, 0x03, 0xe8, 0x94, 0x97, 0xc4, 0x95, 0xc4, 0x85, 0xc0, 0x47, 0x66, 0xa9,
-	0x6b, 0x80, 0x11, 0xb7, 0x25, 0x6e, 0xb2, 0x3b, 0xb3, 0x99, 0x99, 0x95, 0xc4, 0x28, 0x4c, 0x18,
-	0x5f, 0x7c, 0xb5, 0x61, 0x18, 0x3e, 0xe8, 0x66, 0x1f, 0x7c, 0x34, 0x0c, 0x03, 0x06, 0x0c, 0x9f,
-	0x7c, 0xf7, 0x1f, 0xf0, 0x7f, 0xb0, 0xcf, 0xbe, 0x1a, 0xdd, 0x3d, 0x3d, 0xdd, 0x33, 0xd3, 0x3d,
-	0x24, 0x65, 0xf9, 0x36, 0x5d, 0x55, 0xdd, 0xf5, 0x55, 0x75, 0x75, 0x75, 0x55, 0x0f, 0x2c, 0x0f,
-	0xfd, 0x98, 0x84, 0xbe, 0x37, 0xfa, 0xdd, 0x98, 0xc4, 0xde, 0xf6, 0x24, 0x0c, 0xe2, 0x00, 0x57,
-	0xe9, 0xb7, 0xf3, 0x5e, 0x05, 0xaa, 0x5d, 0x2f, 0xf6, 0x30, 0x86, 0xea, 0x11, 0x09, 0xc7, 0x36,
-	0x6a, 0x59, 0xed, 0xaa, 0xcb, 0xbe, 0xf1, 0x0a, 0xd4, 0x7a, 0xfe, 0x80, 0xbc, 0xb4, 0x2d, 0x46,
-	0xe4, 0x03, 0xbc, 0x09, 0xcd, 0x9d, 0xd1, 0x34, 0x8a, 0x49, 0xd8, 0xeb, 0xda, 0x15, 0xc6, 0x91,
-	0x04, 0x7c, 0x0b, 0x6a, 0xfb, 0xc1, 0x80, 0x44, 0x76, 0xb5, 0x55, 0x69, 0xcf, 0x76, 0x16, 0xb6,
-	0x99, 0x4a, 0x4a, 0xea, 0xf9, 0x4f, 0x03, 0x97, 0x33, 0xf1, 0xef, 0xa1, 0x49, 0xb5, 0x3e, 0xf1,
-	0x22, 0x12, 0xd9, 0x35, 0x26, 0x89, 0xb9, 0xa4, 0x20, 0x33, 0x69, 0x29, 0x44, 0xd7, 0x7d, 0x1c,
-	0x91, 0x30, 0xb2, 0xeb, 0xea, 0xba, 0x94, 0xc4, 0xd7, 0x65, 0x4c, 0x8a, 0x6d, 0xcf, 0x7b, 0xc9,
-	0xb4, 0x75, 0xed, 0x19, 0x8e, 0x2d, 0x25, 0xe0, 0x36, 0x2c, 0xee, 0x79, 0x2f, 0xfb, 0x27, 0x5e,
-	0x38, 0x78, 0x18, 0x06, 0xd3, 0x49, 0xaf, 0x6b, 0x37, 0x98, 0x4c, 0x9e, 0x8c, 0xb7, 0x00, 0x04,
-	0xa9, 0xd7, 0xb5, 0x9b, 0x4c, 0x48, 0xa1, 0xe0, 0xdf, 0x72, 0xfc, 0xdc, 0x52, 0xd0, 0x5a, 0x2a,
-	0x05, 0xa8, 0xf4, 0x1e, 0x11, 0xd2, 0xb3, 0x7a, 0xe9, 0x54, 0xc0, 0xd9, 0x85, 0x86, 0x20, 0xe3,
-	0x05, 0xb0, 0x7a, 0xdd, 0x64, 0x4f, 0xac, 0x5e, 0x97, 0xee, 0xd2, 0x6e, 0x10, 0xc5, 0x6c, 0x43,
-	0x9a, 0x2e, 0xfb, 0xc6, 0x36, 0xcc, 0x1c, 0xed, 0x1c, 0x32, 0x72, 0xa5, 0x85, 0xda, 0x4d, 0x57,
-	0x0c, 0x9d, 0xef, 0x11, 0xcc, 0xa9, 0xfe, 0xa4, 0xd3, 0xf7, 0xbd, 0x31, 0x61, 0x0b, 0x36, 0x5d,
-	0xf6, 0x8d, 0xef, 0xc0, 0x5a, 0x97, 0x3c, 0xf5, 0xa6, 0xa3, 0xd8, 0x25, 0x31, 0xf1, 0xe3, 0x61,
-	0xe0, 0x1f, 0x06, 0xa3, 0xe1, 0xf1, 0x69, 0xa2, 0xc4, 0xc0, 0xc5, 0x0f, 0xe1, 0x5a, 0x96, 0x34,
-	0x24, 0x91, 0x5d, 0x61, 0xc6, 0x6d, 0x70, 0xe3, 0x72, 0x33, 0x98, 0x9d, 0xc5, 0x39, 0x74, 0xa1,
-	0x9d, 0xc0, 0x8f, 0x87, 0xfe, 0x34, 0x98, 0x46, 0x7f, 0x9b, 0x92, 0x70, 0x98, 0x46, 0x4f, 0xb2,
-	0x50, 0x96, 0x9d, 0x2c, 0x54, 0x98, 0xe3, 0xbc, 0x8f, 0x60, 0x39, 0xa7, 0xb3, 0x3f, 0x21, 0xc7,
-	0x8a, 0xd5, 0x28, 0xb5, 0xfa, 0x3a, 0x34, 0xba, 0xd3, 0xd0, 0xa3, 0x92, 0xb6, 0xd5, 0x42, 0xed,
-	0x8a, 0x9b, 0x8e, 0xf1, 0x36, 0x60, 0x19, 0x0c, 0xa9, 0x54, 0x85, 0x49, 0x69, 0x38, 0x74, 0x2d,
-	0x97, 0x4c, 0x46, 0xc3, 0x63, 0x6f, 0xdf, 0xae, 0xb6, 0x50, 0x7b, 0xde, 0x4d, 0xc7, 0xce, 0xbb,
-	0x56, 0x01, 0x93, 0x71, 0x27, 0xb2, 0x98, 0xac, 0x4b, 0x61, 0xb2, 0x2e, 0x85, 0xc9, 0x52, 0x31,
-	0xe1, 0x3b, 0x30, 0x2b, 0x67, 0x88, 0xe3, 0xb7, 0xc2, 0x5d, 0xad, 0x9c, 0x02, 0xea, 0x65, 0x55,
-	0x10, 0xff, 0x11, 0xe6, 0xfb, 0xd3, 0x27, 0xd1, 0x71, 0x38, 0x9c, 0x50, 0x1d, 0xe2, 0x28, 0xae,
-	0x25, 0x33, 0x15, 0x16, 0x9b, 0x9b, 0x15, 0x76, 0xbe, 0x41, 0xb0, 0x90, 0x5d, 0xbd, 0x10, 0xdd,
-	0x9b, 0xd0, 0xec, 0xc7, 0x5e, 0x18, 0x1f, 0x0d, 0xc7, 0x24, 0xf1, 0x80, 0x24, 0xd0, 0x38, 0xbf,
-	0xef, 0x0f, 0x18, 0x8f, 0xdb, 0x2d, 0x86, 0x74, 0x5e, 0x97, 0x8c, 0x48, 0x4c, 0x06, 0x77, 0x63,
-	0x66, 0x6d, 0xc5, 0x95, 0x04, 0xfc, 0x6b, 0xa8, 0x33, 0xbd, 0xc2, 0xd2, 0x45, 0xc5, 0x52, 0x06,
-	0x34, 0x61, 0xe3, 0x16, 0xcc, 0x1e, 0x85, 0x53, 0xff, 0xd8, 0xe3, 0x0b, 0xd5, 0xd9, 0x86, 0xab,
-	0x24, 0x87, 0x40, 0x33, 0x9d, 0x56, 0x40, 0xbf, 0x05, 0x8d, 0x83, 0x17, 0x3e, 0x4d, 0x82, 0x91,
-	0x6d, 0xb5, 0x2a, 0xed, 0xea, 0x3d, 0xcb, 0x46, 0x6e, 0x4a, 0xc3, 0x6d, 0xa8, 0xb3, 0x6f, 0x71,
-	0x4a, 0x96, 0x14, 0x1c, 0x8c, 0xe1, 0x26, 0x7c, 0xe7, 0xef, 0xb0, 0x94, 0xf7, 0xa6, 0x36, 0x60,
-	0x30, 0x54, 0xf7, 0x82, 0x01, 0x11, 0xd9, 0x80, 0x7e, 0x63, 0x07, 0xe6, 0xba, 0x24, 0x8a, 0x87,
-	0xbe, 0xc7, 0xf7, 0x88, 0xea, 0x6a, 0xba, 0x19, 0x9a, 0x73, 0x0b, 0x40, 0x6a, 0xc5, 0x6b, 0x50,
-	0x4f, 0x12, 0x26, 0xb7, 0x25, 0x19, 0x39, 0x7f, 0x81, 0x65, 0xcd, 0xc1, 0xd3, 0x02, 0x59, 0x81,
-	0x1a, 0x13, 0x48, 0x90, 0xf0, 0x81, 0x73, 0x06, 0x0d, 0x91, 0x9f, 0x4d, 0xf0, 0x77, 0xbd, 0xe8,
-	0x24, 0x4d, 0x66, 0x5e, 0x74, 0x42, 0x57, 0xba, 0x3b, 0x18, 0x0f, 0x79, 0x68, 0x37, 0x5c, 0x3e,
-	0xc0, 0x7f, 0x00, 0x38, 0x0c, 0x87, 0xcf, 0x87, 0x23, 0xf2, 0x2c, 0xcd, 0x0d, 0xcb, 0xf2, 0x06,
-	0x48, 0x79, 0xae, 0x22, 0xe6, 0xf4, 0x60, 0x3e, 0xc3, 0x64, 0xe7, 0x2b, 0xc9, 0x86, 0x09, 0x8e,
-	0x74, 0x4c, 0x43, 0x28, 0x15, 0x64, 0x80, 0x6a, 0xae, 0x24, 0x38, 0xdf, 0xd5, 0x61, 0x66, 0x27,
-	0x18, 0x8f, 0x3d, 0x7f, 0x80, 0x6f, 0x43, 0x35, 0x3e, 0x9d, 0xf0, 0x15, 0x16, 0xc4, 0xad, 0x95,
-	0x30, 0xb7, 0x8f, 0x4e, 0x27, 0xc4, 0x65, 0x7c, 0xe7, 0x75, 0x1d, 0xaa, 0x74, 0x88, 0x57, 0xe1,
-	0xda, 0x4e, 0x48, 0xbc, 0x98, 0x50, 0xbf, 0x26, 0x82, 0x4b, 0x88, 0x92, 0x79, 0x8c, 0xaa, 0x64,
-	0x0b, 0x6f, 0xc0, 0x2a, 0x97, 0x16, 0xd0, 0x04, 0xab, 0x82, 0xd7, 0x61, 0xb9, 0x1b, 0x06, 0x93,
-	0x3c, 0xa3, 0x8a, 0x5b, 0xb0, 0xc9, 0xe7, 0xe4, 0x32, 0x8d, 0x90, 0xa8, 0xe1, 0x2d, 0xb8, 0x4e,
-	0xa7, 0x1a, 0xf8, 0x75, 0x7c, 0x0b, 0x5a, 0x7d, 0x12, 0xeb, 0x33, 0xbd, 0x90, 0x9a, 0xa1, 0x7a,
-	0x1e, 0x4f, 0x06, 0x66, 0x3d, 0x0d, 0x7c, 0x03, 0xd6, 0x39, 0x12, 0x79, 0xd2, 0x05, 0xb3, 0x49,
-	0x99, 0xdc, 0xe2, 0x22, 0x13, 0xa4, 0x0d, 0xb9, 0x98, 0x13, 0x12, 0xb3, 0xc2, 0x06, 0x03, 0x7f,
-	0x4e, 0xfa, 0x99, 0xee, 0xba, 0x20, 0xcf, 0xe3, 0x65, 0x58, 0xa4, 0xd3, 0x54, 0xe2, 0x02, 0x95,
-	0xe5, 0x96, 0xa8, 0xe4, 0x45, 0xea, 0xe1, 0x3e, 0x89, 0xd3, 0x7d, 0x17, 0x8c, 0x25, 0x8c, 0x61,
-	0x81, 0xfa, 0xc7, 0x8b, 0x3d, 0x41, 0xbb, 0x86, 0x37, 0xc1, 0xee, 0x93, 0x98, 0x05, 0x68, 0x61,
-	0x06, 0x96, 0x1a, 0xd4, 0xed, 0x5d, 0xc6, 0x37, 0x61, 0x23, 0x71, 0x90, 0x72, 0xc0, 0x05, 0x7b,
-	0x95, 0xb9, 0x28, 0x0c, 0x26, 0x3a, 0xe6, 0x1a, 0x5d, 0xd2, 0x25, 0xe3, 0xe0, 0x39, 0x39, 0x24,
-	0x12, 0xf4, 0xba, 0x8c, 0x18, 0x51, 0x42, 0x08, 0x96, 0x9d, 0x0d, 0x26, 0x95, 0xb5, 0x41, 0x59,
-	0x1c, 0x5f, 0x9e, 0x75, 0x9d, 0xb2, 0xf8, 0x3e, 0xe5, 0x17, 0xbc, 0x21, 0x59, 0xf9, 0x59, 0x9b,
-	0x78, 0x0d, 0x70, 0x9f, 0xc4, 0xf9, 0x29, 0x37, 0xf1, 0x0a, 0x2c, 0x31, 0x93, 0xe8, 0x9e, 0x0b,
-	0xea, 0xd6, 0x6f, 0x1a, 0x8d, 0xc1, 0xd2, 0xf9, 0xf9, 0xf9, 0xb9, 0xe5, 0x9c, 0x69, 0x8e, 0x47,
-	0x5a, 0xe7, 0x20, 0xa5, 0xce, 0xc1, 0x50, 0x75, 0x3d, 0x7f, 0x90, 0x14, 0xa3, 0xec, 0xbb, 0xf3,
-	0x57, 0x98, 0x39, 0x4e, 0xa6, 0xcc, 0x67, 0x4e, 0xa2, 0x4d, 0x5a, 0xa8, 0x3d, 0xdb, 0x59, 0x4f,
-	0x88, 0x79, 0x05, 0xae, 0x98, 0xe6, 0xbc, 0xd2, 0x1c, 0xc3, 0x42, 0x6a, 0x5f, 0x81, 0xda, 0x83,
-	0x20, 0x3c, 0xe6, 0x99, 0xa1, 0xe1, 0xf2, 0x41, 0x89, 0xf2, 0xa7, 0xaa, 0xf2, 0xc2, 0xf2, 0x52,
-	0xf9, 0x97, 0xc8, 0x70, 0xda, 0xb5, 0xf9, 0x72, 0x07, 0x16, 0x8b, 0x25, 0x1a, 0x2a, 0xaf, 0xb7,
-	0xf2, 0x33, 0x3a, 0x5d, 0x23, 0xe8, 0x67, 0x6c, 0xad, 0x1b, 0xaa, 0xc7, 0x72, 0xa8, 0x24, 0xf0,
-	0xb1, 0x36, 0x15, 0xe9, 0x50, 0x77, 0xee, 0x19, 0x15, 0x9e, 0xa8, 0xe0, 0x35, 0xcb, 0x49, 0x75,
-	0xdf, 0xa2, 0xf2, 0x0c, 0x57, 0x9a, 0xda, 0xb5, 0x6e, 0xb3, 0xae, 0xe8, 0xb6, 0x47, 0x46, 0x2b,
-	0x86, 0xcc, 0x0a, 0x47, 0x75, 0x9b, 0x1e, 0xa4, 0x34, 0xe7, 0x23, 0x54, 0x96, 0x8e, 0x4b, 0x8d,
-	0x11, 0x1e, 0xb6, 0x14, 0x0f, 0xf7, 0x8c, 0xd8, 0xfe, 0xc1, 0xb0, 0xb5, 0xa4, 0x87, 0x2f, 0x42,
-	0xf6, 0x09, 0xba, 0xf8, 0x22, 0xb8, 0x32, 0xbe, 0x03, 0x23, 0xbe, 0x7f, 0x32, 0x7c, 0xb7, 0x93,
-	0x42, 0xe8, 0x02, 0xbd, 0x12, 0xe5, 0x0f, 0xa8, 0xfc, 0x22, 0xba, 0x2a, 0x42, 0x5a, 0x5a, 0xee,
-	0x93, 0x17, 0x8c, 0x9c, 0xb4, 0x50, 0xc9, 0x30, 0x53, 0x93, 0x57, 0x73, 0x7d, 0x82, 0x5a, 0x63,
-	0xd7, 0xb2, 0x75, 0x7f, 0x49, 0xbc, 0x8c, 0xd4, 0x78, 0x29, 0xb3, 0x42, 0xda, 0xfb, 0x05, 0x32,
-	0x5e, 0xab, 0xa5, 0xa6, 0xae, 0x41, 0x3d, 0xd3, 0xca, 0x25, 0x23, 0x5a, 0xec, 0xd0, 0xba, 0x39,
-	0x8a, 0xbd, 0xf1, 0x24, 0xa9, 0xa5, 0x25, 0xa1, 0xf3, 0xc0, 0x08, 0x7d, 0xcc, 0xa0, 0xdf, 0x54,
-	0x43, 0xbd, 0x00, 0x48, 0xa2, 0xfe, 0x0a, 0x19, 0xef, 0xfb, 0x37, 0x42, 0xed, 0xc0, 0x5c, 0xa6,
-	0x75, 0xe7, 0x4f, 0x0f, 0x19, 0x5a, 0x09, 0x76, 0x5f, 0xc5, 0x6e, 0x80, 0x25, 0xb1, 0x7f, 0x8e,
-	0xca, 0xcb, 0x91, 0x2b, 0x47, 0x58, 0x5a, 0x21, 0x57, 0x94, 0x0a, 0xb9, 0x24, 0x4a, 0x82, 0x62,
-	0x56, 0xd1, 0x23, 0x29, 0x66, 0x95, 0xb7, 0x83, 0xb8, 0x24, 0xab, 0x4c, 0xf2, 0x59, 0xe5, 0x22,
-	0x64, 0x1f, 0x20, 0x4d, 0x69, 0xf6, 0xf3, 0x5a, 0x82, 0x92, 0xcb, 0xf7, 0x5f, 0xc5, 0x9b, 0x5f,
-	0x51, 0x2b, 0x51, 0x91, 0x42, 0x61, 0xa8, 0xbd, 0xbf, 0xfe, 0x6c, 0x54, 0x14, 0x32, 0x45, 0xab,
-	0xd2, 0x0f, 0x5a, 0x35, 0x67, 0x9a, 0x52, 0xf3, 0xb2, 0xb6, 0x97, 0x58, 0x19, 0xa9, 0x56, 0x16,
-	0x14, 0x48, 0xf5, 0x9f, 0x21, 0x6d, 0x4d, 0x4b, 0xc3, 0x81, 0xca, 0xfb, 0x12, 0x45, 0x3a, 0xce,
-	0x84, 0x8a, 0x55, 0xd6, 0x28, 0x55, 0x72, 0x8d, 0x52, 0xc9, 0x65, 0x1f, 0xab, 0x97, 0xbd, 0x06,
-	0x90, 0x44, 0x1c, 0xe4, 0x6b, 0x6d, 0xbc, 0xc5, 0xdf, 0x28, 0x19, 0xce, 0xd9, 0x0e, 0xc8, 0x87,
-	0x42, 0x97, 0xd1, 0x3b, 0x7f, 0x32, 0x6a, 0x9d, 0x32, 0xad, 0x2b, 0xf2, 0x82, 0x91, 0xab, 0x4a,
-	0x85, 0x1f, 0x22, 0x73, 0x25, 0x5f, 0xea, 0xa7, 0x34, 0x32, 0x2d, 0x35, 0x32, 0x1f, 0x1a, 0xd1,
-	0x3c, 0x67, 0x68, 0xb6, 0x52, 0x34, 0x5a, 0x8d, 0x12, 0xd7, 0xa9, 0xa6, 0x85, 0xb8, 0xcc, 0x8b,
-	0x60, 0x49, 0xd4, 0xbc, 0x28, 0x46, 0x8d, 0xb6, 0x30, 0xfd, 0x11, 0x95, 0xf4, 0x29, 0xc6, 0xc7,
-	0x2b, 0x53, 0xcc, 0xb4, 0x8b, 0x15, 0x18, 0x4f, 0x83, 0x79, 0x72, 0xfa, 0xa2, 0x51, 0x2d, 0x79,
-	0xd1, 0xa8, 0x15, 0x5f, 0x34, 0x3a, 0xbb, 0x46, 0x8b, 0x4f, 0x99, 0xc5, 0xbf, 0xca, 0xdc, 0x59,
-	0x45, 0x93, 0xa4, 0xe5, 0x5f, 0x23, 0x63, 0x0b, 0xf6, 0xcb, 0xd9, 0x5d, 0x72, 0x6f, 0xfd, 0x3b,
-	0x73, 0x6f, 0xe9, 0x81, 0x65, 0x42, 0xa6, 0xd0, 0x22, 0xa6, 0x21, 0x83, 0x64, 0xc8, 0xdc, 0x1d,
-	0x0c, 0x42, 0x11, 0x32, 0xf4, 0xbb, 0x24, 0x64, 0x5e, 0xa9, 0x21, 0x53, 0x58, 0x5c, 0xaa, 0xfe,
-	0x14, 0x19, 0xfa, 0x50, 0xea, 0xa2, 0xdd, 0xa3, 0xa3, 0x43, 0xa6, 0x33, 0x39, 0x42, 0x62, 0x9c,
-	0x3c, 0x5e, 0x2b, 0x70, 0xc4, 0x30, 0x6d, 0xf7, 0x2a, 0x4a, 0xbb, 0x67, 0x6e, 0x5e, 0xfe, 0x53,
-	0x6c, 0x5e, 0x72, 0x30, 0x32, 0xd7, 0x91, 0xbe, 0x2d, 0x7e, 0x33, 0xa4, 0x25, 0xa8, 0xce, 0xf4,
-	0x2d, 0x95, 0x16, 0xd5, 0x6b, 0x64, 0xe8, 0xc8, 0xaf, 0xfe, 0x13, 0xc0, 0x52, 0x7e, 0x02, 0x94,
-	0xa0, 0xfb, 0xaf, 0x8a, 0x4e, 0xab, 0x5a, 0x6d, 0xf8, 0xf4, 0x6f, 0x02, 0x79, 0x70, 0x25, 0xea,
-	0xfe, 0xa7, 0xaa, 0xd3, 0x2e, 0x26, 0xd5, 0xf9, 0x86, 0x77, 0x86, 0x82, 0xba, 0xfb, 0x46, 0x75,
-	0xe7, 0xa8, 0xa8, 0xcf, 0x68, 0xde, 0x03, 0x5a, 0xca, 0x47, 0x93, 0xc0, 0x8f, 0x08, 0x55, 0x71,
-	0xf0, 0x88, 0xa9, 0x68, 0xb8, 0xd6, 0xc1, 0x23, 0x9a, 0xe5, 0xef, 0x87, 0x61, 0x10, 0xb2, 0x66,
-	0xbb, 0xe9, 0xf2, 0x81, 0xfc, 0x37, 0x56, 0x61, 0xe7, 0x8a, 0x0f, 0x9c, 0x8f, 0x91, 0xee, 0x15,
-	0xe4, 0x2d, 0x9e, 0x00, 0xf3, 0x05, 0xfb, 0x7f, 0x6e, 0xaf, 0x9d, 0xde, 0x2e, 0x46, 0xe7, 0x0e,
-	0x8a, 0x2f, 0x32, 0x05, 0xbf, 0x9a, 0xf3, 0xc1, 0x3b, 0x5c, 0xcf, 0x9a, 0x92, 0x91, 0x94, 0x85,
-	0x52, 0x2d, 0x3f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x04, 0x86, 0xd9, 0x75, 0x1c, 0x00, 0x00,
+	0x22, 0x08, 0x98, 0x60, 0x03, 0xe8, 0x94, 0x97, 0xc4, 0x15, 0xc5, 0x85, 0xc0, 0x47, 0x66, 0xa9,
+	0x1f, 0x30, 0xe2, 0xb6, 0xc4, 0x4d, 0x76, 0x67, 0x36, 0x33, 0xb3, 0x94, 0x18, 0x85, 0x09, 0x93,
+	0x4b, 0xae, 0x09, 0x82, 0x20, 0x07, 0xdd, 0xec, 0x83, 0x8f, 0x86, 0x61, 0xc0, 0x80, 0xe1, 0x93,
+	0xef, 0xfe, 0x03, 0xfe, 0x0f, 0xf6, 0xd9, 0x57, 0xa3, 0xbb, 0xa7, 0xa7, 0x7b, 0x66, 0xba, 0x87,
+	0xa4, 0x2c, 0xdf, 0xa6, 0xab, 0xaa, 0xbb, 0xbe, 0xaa, 0xae, 0xae, 0xae, 0xea, 0x81, 0xe5, 0xa1,
+	0x1f, 0x93, 0xd0, 0xf7, 0x46, 0xbf, 0x18, 0x93, 0xd8, 0xdb, 0x9e, 0x84, 0x41, 0x1c, 0xe0, 0x2a,
+	0xfd, 0x76, 0xfe, 0x5d, 0x81, 0x6a, 0xd7, 0x8b, 0x3d, 0x8c, 0xa1, 0x7a, 0x4c, 0xc2, 0xb1, 0x8d,
+	0x5a, 0x56, 0xbb, 0xea, 0xb2, 0x6f, 0xbc, 0x02, 0xb5, 0x9e, 0x3f, 0x20, 0xaf, 0x6d, 0x8b, 0x11,
+	0xf9, 0x00, 0x6f, 0x42, 0x73, 0x67, 0x34, 0x8d, 0x62, 0x12, 0xf6, 0xba, 0x76, 0x85, 0x71, 0x24,
+	0x01, 0xdf, 0x83, 0xda, 0x41, 0x30, 0x20, 0x91, 0x5d, 0x6d, 0x55, 0xda, 0xb3, 0x9d, 0x85, 0x6d,
+	0xa6, 0x92, 0x92, 0x7a, 0xfe, 0x8b, 0xc0, 0xe5, 0x4c, 0xfc, 0x4b, 0x68, 0x52, 0xad, 0xcf, 0xbd,
+	0x88, 0x44, 0x76, 0x8d, 0x49, 0x62, 0x2e, 0x29, 0xc8, 0x4c, 0x5a, 0x0a, 0xd1, 0x75, 0x9f, 0x45,
+	0x24, 0x8c, 0xec, 0xba, 0xba, 0x2e, 0x25, 0xf1, 0x75, 0x19, 0x93, 0x62, 0xdb, 0xf7, 0x5e, 0x33,
+	0x6d, 0x5d, 0x7b, 0x86, 0x63, 0x4b, 0x09, 0xb8, 0x0d, 0x8b, 0xfb, 0xde, 0xeb, 0xfe, 0xa9, 0x17,
+	0x0e, 0x9e, 0x84, 0xc1, 0x74, 0xd2, 0xeb, 0xda, 0x0d, 0x26, 0x93, 0x27, 0xe3, 0x2d, 0x00, 0x41,
+	0xea, 0x75, 0xed, 0x26, 0x13, 0x52, 0x28, 0xf8, 0xe7, 0x1c, 0x3f, 0xb7, 0x14, 0xb4, 0x96, 0x4a,
+	0x01, 0x2a, 0xbd, 0x4f, 0x84, 0xf4, 0xac, 0x5e, 0x3a, 0x15, 0x70, 0xf6, 0xa0, 0x21, 0xc8, 0x78,
+	0x01, 0xac, 0x5e, 0x37, 0xd9, 0x13, 0xab, 0xd7, 0xa5, 0xbb, 0xb4, 0x17, 0x44, 0x31, 0xdb, 0x90,
+	0xa6, 0xcb, 0xbe, 0xb1, 0x0d, 0x33, 0xc7, 0x3b, 0x47, 0x8c, 0x5c, 0x69, 0xa1, 0x76, 0xd3, 0x15,
+	0x43, 0xe7, 0x1b, 0x04, 0x73, 0xaa, 0x3f, 0xe9, 0xf4, 0x03, 0x6f, 0x4c, 0xd8, 0x82, 0x4d, 0x97,
+	0x7d, 0xe3, 0x07, 0xb0, 0xd6, 0x25, 0x2f, 0xbc, 0xe9, 0x28, 0x76, 0x49, 0x4c, 0xfc, 0x78, 0x18,
+	0xf8, 0x47, 0xc1, 0x68, 0x78, 0x72, 0x9e, 0x28, 0x31, 0x70, 0xf1, 0x13, 0xb8, 0x95, 0x25, 0x0d,
+	0x49, 0x64, 0x57, 0x98, 0x71, 0x1b, 0xdc, 0xb8, 0xdc, 0x0c, 0x66, 0x67, 0x71, 0x0e, 0x5d, 0x68,
+	0x27, 0xf0, 0xe3, 0xa1, 0x3f, 0x0d, 0xa6, 0xd1, 0x1f, 0xa6, 0x24, 0x1c, 0xa6, 0xd1, 0x93, 0x2c,
+	0x94, 0x65, 0x27, 0x0b, 0x15, 0xe6, 0x38, 0xff, 0x41, 0xb0, 0x9c, 0xd3, 0xd9, 0x9f, 0x90, 0x13,
+	0xc5, 0x6a, 0x94, 0x5a, 0x7d, 0x1b, 0x1a, 0xdd, 0x69, 0xe8, 0x51, 0x49, 0xdb, 0x6a, 0xa1, 0x76,
+	0xc5, 0x4d, 0xc7, 0x78, 0x1b, 0xb0, 0x0c, 0x86, 0x54, 0xaa, 0xc2, 0xa4, 0x34, 0x1c, 0xba, 0x96,
+	0x4b, 0x26, 0xa3, 0xe1, 0x89, 0x77, 0x60, 0x57, 0x5b, 0xa8, 0x3d, 0xef, 0xa6, 0x63, 0xe7, 0x5f,
+	0x56, 0x01, 0x93, 0x71, 0x27, 0xb2, 0x98, 0xac, 0x6b, 0x61, 0xb2, 0xae, 0x85, 0xc9, 0x52, 0x31,
+	0xe1, 0x07, 0x30, 0x2b, 0x67, 0x88, 0xe3, 0xb7, 0xc2, 0x5d, 0xad, 0x9c, 0x02, 0xea, 0x65, 0x55,
+	0x10, 0xff, 0x1a, 0xe6, 0xfb, 0xd3, 0xe7, 0xd1, 0x49, 0x38, 0x9c, 0x50, 0x1d, 0xe2, 0x28, 0xae,
+	0x25, 0x33, 0x15, 0x16, 0x9b, 0x9b, 0x15, 0x76, 0xbe, 0x44, 0xb0, 0x90, 0x5d, 0xbd, 0x10, 0xdd,
+	0x9b, 0xd0, 0xec, 0xc7, 0x5e, 0x18, 0x1f, 0x0f, 0xc7, 0x24, 0xf1, 0x80, 0x24, 0xd0, 0x38, 0x7f,
+	0xec, 0x0f, 0x18, 0x8f, 0xdb, 0x2d, 0x86, 0x74, 0x5e, 0x97, 0x8c, 0x48, 0x4c, 0x06, 0x0f, 0x63,
+	0x66, 0x6d, 0xc5, 0x95, 0x04, 0xfc, 0x53, 0xa8, 0x33, 0xbd, 0xc2, 0xd2, 0x45, 0xc5, 0x52, 0x06,
+	0x34, 0x61, 0xe3, 0x16, 0xcc, 0x1e, 0x87, 0x53, 0xff, 0xc4, 0xe3, 0x0b, 0xd5, 0xd9, 0x86, 0xab,
+	0x24, 0x87, 0x40, 0x33, 0x9d, 0x56, 0x40, 0xbf, 0x05, 0x8d, 0xc3, 0x57, 0x3e, 0x4d, 0x82, 0x91,
+	0x6d, 0xb5, 0x2a, 0xed, 0xea, 0x23, 0xcb, 0x46, 0x6e, 0x4a, 0xc3, 0x6d, 0xa8, 0xb3, 0x6f, 0x71,
+	0x4a, 0x96, 0x14, 0x1c, 0x8c, 0xe1, 0x26, 0x7c, 0xe7, 0x0c, 0x96, 0xf2, 0xde, 0xd4, 0x06, 0x0c,
+	0x86, 0xea, 0x7e, 0x30, 0x20, 0x22, 0x1b, 0xd0, 0x6f, 0xec, 0xc0, 0x5c, 0x97, 0x44, 0xf1, 0xd0,
+	0xf7, 0xf8, 0x1e, 0x51, 0x5d, 0x4d, 0x37, 0x43, 0xc3, 0x6b, 0x50, 0xdf, 0x1d, 0x8e, 0x62, 0x12,
+	0xb2, 0x70, 0x6d, 0xba, 0xc9, 0xc8, 0xb9, 0x07, 0x20, 0xd1, 0x50, 0xa9, 0x24, 0x91, 0x72, 0x1b,
+	0x93, 0x91, 0xf3, 0x3b, 0x58, 0xd6, 0x1c, 0x48, 0x2d, 0xc0, 0x15, 0xa8, 0x31, 0x81, 0x04, 0x21,
+	0x1f, 0x38, 0x17, 0xd0, 0x10, 0x79, 0xdb, 0x64, 0xd6, 0x9e, 0x17, 0x9d, 0xa6, 0x49, 0xce, 0x8b,
+	0x4e, 0xe9, 0x4a, 0x0f, 0x07, 0xe3, 0x21, 0x0f, 0xf9, 0x86, 0xcb, 0x07, 0xf8, 0x57, 0x00, 0x47,
+	0xe1, 0xf0, 0x6c, 0x38, 0x22, 0x2f, 0xd3, 0x9c, 0xb1, 0x2c, 0x6f, 0x86, 0x94, 0xe7, 0x2a, 0x62,
+	0x4e, 0x0f, 0xe6, 0x33, 0x4c, 0x76, 0xee, 0x92, 0x2c, 0x99, 0xe0, 0x48, 0xc7, 0x34, 0xb4, 0x52,
+	0x41, 0x06, 0xa8, 0xe6, 0x4a, 0x82, 0xf3, 0x75, 0x1d, 0x66, 0x76, 0x82, 0xf1, 0xd8, 0xf3, 0x07,
+	0xf8, 0x3e, 0x54, 0xe3, 0xf3, 0x09, 0x5f, 0x61, 0x41, 0xdc, 0x66, 0x09, 0x73, 0xfb, 0xf8, 0x7c,
+	0x42, 0x5c, 0xc6, 0x77, 0xde, 0xd6, 0xa1, 0x4a, 0x87, 0x78, 0x15, 0x6e, 0xed, 0x84, 0xc4, 0x8b,
+	0x09, 0xf5, 0x6b, 0x22, 0xb8, 0x84, 0x28, 0x99, 0xc7, 0xae, 0x4a, 0xb6, 0xf0, 0x06, 0xac, 0x72,
+	0x69, 0x01, 0x4d, 0xb0, 0x2a, 0x78, 0x1d, 0x96, 0xbb, 0x61, 0x30, 0xc9, 0x33, 0xaa, 0xb8, 0x05,
+	0x9b, 0x7c, 0x4e, 0x2e, 0x03, 0x09, 0x89, 0x1a, 0xde, 0x82, 0xdb, 0x74, 0xaa, 0x81, 0x5f, 0xc7,
+	0xf7, 0xa0, 0xd5, 0x27, 0xb1, 0xfe, 0x06, 0x10, 0x52, 0x33, 0x54, 0xcf, 0xb3, 0xc9, 0xc0, 0xac,
+	0xa7, 0x81, 0xef, 0xc0, 0x3a, 0x47, 0x22, 0x33, 0x80, 0x60, 0x36, 0x29, 0x93, 0x5b, 0x5c, 0x64,
+	0x82, 0xb4, 0x21, 0x17, 0x73, 0x42, 0x62, 0x56, 0xd8, 0x60, 0xe0, 0xcf, 0x49, 0x3f, 0xd3, 0x5d,
+	0x17, 0xe4, 0x79, 0xbc, 0x0c, 0x8b, 0x74, 0x9a, 0x4a, 0x5c, 0xa0, 0xb2, 0xdc, 0x12, 0x95, 0xbc,
+	0x48, 0x3d, 0xdc, 0x27, 0x71, 0xba, 0xef, 0x82, 0xb1, 0x84, 0x31, 0x2c, 0x50, 0xff, 0x78, 0xb1,
+	0x27, 0x68, 0xb7, 0xf0, 0x26, 0xd8, 0x7d, 0x12, 0xb3, 0x00, 0x2d, 0xcc, 0xc0, 0x52, 0x83, 0xba,
+	0xbd, 0xcb, 0xf8, 0x2e, 0x6c, 0x24, 0x0e, 0x52, 0x0e, 0xbe, 0x60, 0xaf, 0x32, 0x17, 0x85, 0xc1,
+	0x44, 0xc7, 0x5c, 0xa3, 0x4b, 0xba, 0x64, 0x1c, 0x9c, 0x91, 0x23, 0x22, 0x41, 0xaf, 0xcb, 0x88,
+	0x11, 0xa5, 0x85, 0x60, 0xd9, 0xd9, 0x60, 0x52, 0x59, 0x1b, 0x94, 0xc5, 0xf1, 0xe5, 0x59, 0xb7,
+	0x29, 0x8b, 0xef, 0x53, 0x7e, 0xc1, 0x3b, 0x92, 0x95, 0x9f, 0xb5, 0x89, 0xd7, 0x00, 0xf7, 0x49,
+	0x9c, 0x9f, 0x72, 0x17, 0xaf, 0xc0, 0x12, 0x33, 0x89, 0xee, 0xb9, 0xa0, 0x6e, 0xfd, 0xac, 0xd1,
+	0x18, 0x2c, 0x5d, 0x5e, 0x5e, 0x5e, 0x5a, 0xce, 0x85, 0xe6, 0x78, 0xa4, 0xf5, 0x0f, 0x52, 0xea,
+	0x1f, 0x0c, 0x55, 0xd7, 0xf3, 0x07, 0x49, 0x91, 0xca, 0xbe, 0x3b, 0xbf, 0x87, 0x99, 0x93, 0x64,
+	0xca, 0x7c, 0xe6, 0x24, 0xda, 0xa4, 0x85, 0xda, 0xb3, 0x9d, 0xf5, 0x84, 0x98, 0x57, 0xe0, 0x8a,
+	0x69, 0xce, 0x1b, 0xcd, 0x31, 0x2c, 0xa4, 0xfc, 0x15, 0xa8, 0xed, 0x06, 0xe1, 0x09, 0xcf, 0x0c,
+	0x0d, 0x97, 0x0f, 0x4a, 0x94, 0xbf, 0x50, 0x95, 0x17, 0x96, 0x97, 0xca, 0x3f, 0x43, 0x86, 0xd3,
+	0xae, 0xcd, 0x97, 0x3b, 0xb0, 0x58, 0x2c, 0xdd, 0x50, 0x79, 0x1d, 0x96, 0x9f, 0xd1, 0xe9, 0x1a,
+	0x41, 0xbf, 0x64, 0x6b, 0xdd, 0x51, 0x3d, 0x96, 0x43, 0x25, 0x81, 0x8f, 0xb5, 0xa9, 0x48, 0x87,
+	0xba, 0xf3, 0xc8, 0xa8, 0xf0, 0x54, 0x05, 0xaf, 0x59, 0x4e, 0xaa, 0xfb, 0x0a, 0x95, 0x67, 0xb8,
+	0xd2, 0xd4, 0xae, 0x75, 0x9b, 0x75, 0x43, 0xb7, 0x3d, 0x35, 0x5a, 0x31, 0x64, 0x56, 0x38, 0xaa,
+	0xdb, 0xf4, 0x20, 0xa5, 0x39, 0xff, 0x47, 0x65, 0xe9, 0xb8, 0xd4, 0x18, 0xe1, 0x61, 0x4b, 0xf1,
+	0x70, 0xcf, 0x88, 0xed, 0x8f, 0x0c, 0x5b, 0x4b, 0x7a, 0xf8, 0x2a, 0x64, 0x1f, 0xa2, 0xab, 0x2f,
+	0x82, 0x1b, 0xe3, 0x3b, 0x34, 0xe2, 0xfb, 0x13, 0xc3, 0x77, 0x9f, 0x13, 0xaf, 0xd2, 0x2b, 0x51,
+	0x7e, 0x8b, 0xca, 0x2f, 0xa2, 0x9b, 0x22, 0xa4, 0x25, 0xe7, 0x01, 0x79, 0xc5, 0xc8, 0x49, 0x6b,
+	0x95, 0x0c, 0x33, 0xb5, 0x7a, 0x35, 0xd7, 0x3f, 0xa8, 0xb5, 0x77, 0x2d, 0xdb, 0x0f, 0x94, 0xc4,
+	0xcb, 0x48, 0x8d, 0x97, 0x32, 0x2b, 0xa4, 0xbd, 0x9f, 0x22, 0xe3, 0xb5, 0x5a, 0x6a, 0xea, 0x1a,
+	0xd4, 0x33, 0x2d, 0x5e, 0x32, 0xa2, 0xc5, 0x0e, 0xad, 0xa7, 0xa3, 0xd8, 0x1b, 0x4f, 0x92, 0x1a,
+	0x5b, 0x12, 0x3a, 0xbb, 0x46, 0xe8, 0x63, 0x06, 0xfd, 0xae, 0x1a, 0xea, 0x05, 0x40, 0x12, 0xf5,
+	0xe7, 0xc8, 0x78, 0xdf, 0xbf, 0x13, 0x6a, 0x07, 0xe6, 0x32, 0x2d, 0x3d, 0x7f, 0x92, 0xc8, 0xd0,
+	0x4a, 0xb0, 0xfb, 0x2a, 0x76, 0x03, 0x2c, 0x89, 0xfd, 0x13, 0x54, 0x5e, 0x8e, 0xdc, 0x38, 0xc2,
+	0xd2, 0x0a, 0xb9, 0xa2, 0x54, 0xc8, 0x25, 0x51, 0x12, 0x14, 0xb3, 0x8a, 0x1e, 0x49, 0x31, 0xab,
+	0xbc, 0x1f, 0xc4, 0x25, 0x59, 0x65, 0x92, 0xcf, 0x2a, 0x57, 0x21, 0xfb, 0x2f, 0xd2, 0x94, 0x66,
+	0x3f, 0xac, 0x25, 0x28, 0xb9, 0x7c, 0xff, 0x5c, 0xbc, 0xf9, 0x15, 0xb5, 0x12, 0x15, 0x29, 0x14,
+	0x86, 0xda, 0xfb, 0xeb, 0xb7, 0x46, 0x45, 0x21, 0x53, 0xb4, 0x2a, 0xfd, 0xa0, 0x55, 0x73, 0xa1,
+	0x29, 0x35, 0xaf, 0x6b, 0x7b, 0x89, 0x95, 0x91, 0x6a, 0x65, 0x41, 0x81, 0x54, 0xff, 0x31, 0xd2,
+	0xd6, 0xb4, 0x34, 0x1c, 0xa8, 0xbc, 0x2f, 0x51, 0xa4, 0xe3, 0x4c, 0xa8, 0x58, 0x65, 0x8d, 0x52,
+	0x25, 0xd7, 0x28, 0x95, 0x5c, 0xf6, 0xb1, 0x7a, 0xd9, 0x6b, 0x00, 0x49, 0xc4, 0x41, 0xbe, 0xd6,
+	0xc6, 0x5b, 0xfc, 0xed, 0x92, 0xe1, 0x9c, 0xed, 0x80, 0x7c, 0x40, 0x74, 0x19, 0xbd, 0xf3, 0x1b,
+	0xa3, 0xd6, 0x69, 0x0b, 0x29, 0x6f, 0x1e, 0x99, 0x55, 0xa5, 0xc2, 0xff, 0x21, 0x73, 0x25, 0x5f,
+	0xea, 0xa7, 0x34, 0x32, 0x2d, 0x35, 0x32, 0x9f, 0x18, 0xd1, 0x9c, 0x31, 0x34, 0x5b, 0x29, 0x1a,
+	0xad, 0x46, 0x89, 0xeb, 0x5c, 0xd3, 0x42, 0x5c, 0xe7, 0xa5, 0xb0, 0x24, 0x6a, 0x5e, 0x15, 0xa3,
+	0x46, 0x5b, 0x98, 0x7e, 0x87, 0x4a, 0xfa, 0x14, 0xe3, 0xa3, 0x96, 0x29, 0x66, 0xda, 0xc5, 0x0a,
+	0x8c, 0xa7, 0xc1, 0x3c, 0x39, 0x7d, 0xe9, 0xa8, 0x96, 0xbc, 0x74, 0xd4, 0x8a, 0x2f, 0x1d, 0x9d,
+	0x3d, 0xa3, 0xc5, 0xe7, 0xcc, 0xe2, 0x9f, 0x64, 0xee, 0xac, 0xa2, 0x49, 0xd2, 0xf2, 0x2f, 0x90,
+	0xb1, 0x05, 0xfb, 0xf1, 0xec, 0x2e, 0xb9, 0xb7, 0xfe, 0x92, 0xb9, 0xb7, 0xf4, 0xc0, 0x32, 0x21,
+	0x53, 0x68, 0x11, 0xd3, 0x90, 0x41, 0x32, 0x64, 0x1e, 0x0e, 0x06, 0xa1, 0x08, 0x19, 0xfa, 0x5d,
+	0x12, 0x32, 0x6f, 0xd4, 0x90, 0x29, 0x2c, 0x2e, 0x55, 0x7f, 0x84, 0x0c, 0x7d, 0x28, 0x75, 0xd1,
+	0xde, 0xf1, 0xf1, 0x11, 0xd3, 0x99, 0x1c, 0x21, 0x31, 0x4e, 0x1e, 0xb5, 0x15, 0x38, 0x62, 0x98,
+	0xb6, 0x7b, 0x15, 0xa5, 0xdd, 0x33, 0x37, 0x2f, 0x7f, 0x2d, 0x36, 0x2f, 0x39, 0x18, 0x99, 0xeb,
+	0x48, 0xdf, 0x16, 0xbf, 0x1b, 0xd2, 0x12, 0x54, 0x17, 0xfa, 0x96, 0x4a, 0x8b, 0xea, 0x2d, 0x32,
+	0x74, 0xe4, 0x37, 0xff, 0x39, 0x60, 0x29, 0x3f, 0x07, 0x4a, 0xd0, 0xfd, 0x4d, 0x45, 0xa7, 0x55,
+	0xad, 0x36, 0x7c, 0xfa, 0x37, 0x81, 0x3c, 0xb8, 0x12, 0x75, 0x7f, 0x57, 0xd5, 0x69, 0x17, 0x93,
+	0xea, 0x7c, 0xc3, 0x3b, 0x43, 0x41, 0xdd, 0x63, 0xa3, 0xba, 0x4b, 0x54, 0xd4, 0x67, 0x34, 0x6f,
+	0x97, 0x96, 0xf2, 0xd1, 0x24, 0xf0, 0x23, 0x42, 0x55, 0x1c, 0x3e, 0x65, 0x2a, 0x1a, 0xae, 0x75,
+	0xf8, 0x94, 0x66, 0xf9, 0xc7, 0x61, 0x18, 0x84, 0xac, 0xd9, 0x6e, 0xba, 0x7c, 0x20, 0xff, 0x99,
+	0x55, 0xd8, 0xb9, 0xe2, 0x03, 0xe7, 0x03, 0xa4, 0x7b, 0x05, 0x79, 0x8f, 0x27, 0xc0, 0x7c, 0xc1,
+	0xfe, 0x83, 0xdb, 0x6b, 0xa7, 0xb7, 0x8b, 0xd1, 0xb9, 0x83, 0xe2, 0x8b, 0x4c, 0xc1, 0xaf, 0xe6,
+	0x7c, 0xf0, 0x4f, 0xae, 0x67, 0x4d, 0xc9, 0x48, 0xca, 0x42, 0xa9, 0x96, 0xef, 0x07, 0x00, 0x85,
+	0x40, 0x9c, 0x22, 0x8d, 0x1c, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/tcp"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -156,6 +157,79 @@ func (c *Client) UploadShard(shardID, newShardID uint64, destinationDatabase, re
 	return nil
 }
 
+// ImportShard attaches the TSM files to the shard covering the time range of
+// the request, creating the shard if needed, and returns the shard's ID. The
+// files are imported atomically: either all of them are attached or none are.
+func (c *Client) ImportShard(req *Request, files []string) (uint64, error) {
+	conn, err := tcp.Dial("tcp", c.host, MuxHeader)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte{byte(RequestShardImport)}); err != nil {
+		return 0, err
+	}
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return 0, fmt.Errorf("encode import request: %s", err)
+	}
+
+	// The server creates the shard and responds with its ID before any
+	// files are sent.
+	dec := json.NewDecoder(conn)
+	var resp ImportResponse
+	if err := dec.Decode(&resp); err != nil {
+		return 0, fmt.Errorf("read import response: %s", err)
+	} else if resp.Err != "" {
+		return 0, errors.New(resp.Err)
+	}
+	shardID := resp.ShardID
+
+	// Files are named relative to the shard so the server can locate them.
+	tw := tar.NewWriter(conn)
+	for _, path := range files {
+		if err := writeTarFile(tw, path, resp.Path+"/"+filepath.Base(path)); err != nil {
+			return shardID, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return shardID, err
+	}
+
+	if err := dec.Decode(&resp); err != nil {
+		return shardID, fmt.Errorf("read import response: %s", err)
+	} else if resp.Err != "" {
+		return shardID, errors.New(resp.Err)
+	}
+	return shardID, nil
+}
+
+// writeTarFile writes the file at path to tw under name.
+func writeTarFile(tw *tar.Writer, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	hdr, err := tar.FileInfoHeader(fi, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
 // MetastoreBackup returns a snapshot of the meta store.
 func (c *Client) MetastoreBackup() (*meta.Data, error) {
 	req := &Request{
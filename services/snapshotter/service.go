@@ -2,6 +2,7 @@
 package snapshotter // import "github.com/influxdata/influxdb/services/snapshotter"
 
 import (
+	"bufio"
 	"bytes"
 	"encoding"
 	"encoding/binary"
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -37,6 +39,7 @@ type Service struct {
 	MetaClient interface {
 		encoding.BinaryMarshaler
 		Database(name string) *meta.DatabaseInfo
+		CreateShardGroup(database, policy string, timestamp time.Time) (*meta.ShardGroupInfo, error)
 	}
 
 	TSDBStore interface {
@@ -46,6 +49,7 @@ type Service struct {
 		ShardRelativePath(id uint64) (string, error)
 		SetShardEnabled(shardID uint64, enabled bool) error
 		RestoreShard(id uint64, r io.Reader) error
+		ImportShard(id uint64, r io.Reader) error
 		CreateShard(database, retentionPolicy string, shardID uint64, enabled bool) error
 	}
 
@@ -149,6 +153,8 @@ func (s *Service) handleConn(conn net.Conn) error {
 		return s.writeRetentionPolicyInfo(conn, r.BackupDatabase, r.BackupRetentionPolicy)
 	case RequestMetaStoreUpdate:
 		return s.updateMetaStore(conn, bytes, r.BackupDatabase, r.RestoreDatabase, r.BackupRetentionPolicy, r.RestoreRetentionPolicy)
+	case RequestShardImport:
+		return s.importShard(conn, r)
 	default:
 		return fmt.Errorf("request type unknown: %v", r.Type)
 	}
@@ -176,6 +182,78 @@ func (s *Service) updateShardsLive(conn net.Conn) error {
 	return nil
 }
 
+// importShard attaches the TSM files uploaded on conn to the shard covering
+// the requested time range, creating its shard group if needed. The shard ID
+// is sent back before the files are read, so the client can name them, and
+// the result is sent back once they are imported.
+func (s *Service) importShard(conn net.Conn, r Request) error {
+	shardID, err := s.createImportShard(r)
+	if err != nil {
+		s.respondImport(conn, ImportResponse{Err: err.Error()})
+		return err
+	}
+	path, err := s.TSDBStore.ShardRelativePath(shardID)
+	if err != nil {
+		s.respondImport(conn, ImportResponse{Err: err.Error()})
+		return err
+	}
+	if err := s.respondImport(conn, ImportResponse{ShardID: shardID, Path: filepath.ToSlash(path)}); err != nil {
+		return err
+	}
+
+	// Skip the newline the client's JSON encoder may have written after the request.
+	br := bufio.NewReader(conn)
+	if b, err := br.Peek(1); err == nil && b[0] == '\n' {
+		br.Discard(1)
+	}
+
+	if err := s.TSDBStore.SetShardEnabled(shardID, false); err != nil {
+		s.respondImport(conn, ImportResponse{ShardID: shardID, Err: err.Error()})
+		return err
+	}
+	defer s.TSDBStore.SetShardEnabled(shardID, true)
+
+	if err := s.TSDBStore.ImportShard(shardID, br); err != nil {
+		s.respondImport(conn, ImportResponse{ShardID: shardID, Err: err.Error()})
+		return err
+	}
+	return s.respondImport(conn, ImportResponse{ShardID: shardID})
+}
+
+// createImportShard returns the ID of the local shard covering the time range
+// of an import request, creating the shard group and shard if needed.
+func (s *Service) createImportShard(r Request) (uint64, error) {
+	db, rp := r.RestoreDatabase, r.RestoreRetentionPolicy
+	dbi := s.MetaClient.Database(db)
+	if dbi == nil {
+		return 0, influxdb.ErrDatabaseNotFound(db)
+	}
+	if rp == "" {
+		rp = dbi.DefaultRetentionPolicy
+	}
+
+	sgi, err := s.MetaClient.CreateShardGroup(db, rp, r.ImportStart)
+	if err != nil {
+		return 0, err
+	} else if sgi == nil || len(sgi.Shards) == 0 {
+		return 0, fmt.Errorf("no shard group for %s in %s.%s", r.ImportStart.UTC().Format(time.RFC3339), db, rp)
+	} else if !r.ImportEnd.Before(sgi.EndTime) {
+		return 0, fmt.Errorf("time range %s - %s spans more than one shard group of %s.%s",
+			r.ImportStart.UTC().Format(time.RFC3339), r.ImportEnd.UTC().Format(time.RFC3339), db, rp)
+	}
+
+	shardID := sgi.Shards[0].ID
+	if err := s.TSDBStore.CreateShard(db, rp, shardID, true); err != nil {
+		return 0, err
+	}
+	return shardID, nil
+}
+
+// respondImport writes the response to an import request.
+func (s *Service) respondImport(conn net.Conn, resp ImportResponse) error {
+	return json.NewEncoder(conn).Encode(resp)
+}
+
 func (s *Service) updateMetaStore(conn net.Conn, bits []byte, backupDBName, restoreDBName, backupRPName, restoreRPName string) error {
 	md := meta.Data{}
 	err := md.UnmarshalBinary(bits)
@@ -446,6 +524,10 @@ const (
 	// RequestShardUpdate will initiate the upload of a shard data tar file
 	// and have the engine import the data.
 	RequestShardUpdate
+
+	// RequestShardImport represents a request to attach TSM files built
+	// offline to the shard covering their time range.
+	RequestShardImport
 )
 
 // Request represents a request for a specific backup or for information
@@ -460,9 +542,19 @@ type Request struct {
 	Since                  time.Time
 	ExportStart            time.Time
 	ExportEnd              time.Time
+	ImportStart            time.Time
+	ImportEnd              time.Time
 	UploadSize             int64
 }
 
+// ImportResponse is sent in reply to a shard import request, once the shard
+// is created and again once the files are imported.
+type ImportResponse struct {
+	ShardID uint64
+	Path    string `json:",omitempty"` // shard path relative to the data directory
+	Err     string `json:",omitempty"`
+}
+
 // Response contains the relative paths for all the shards on this server
 // that are in the requested database or retention policy.
 type Response struct {
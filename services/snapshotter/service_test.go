@@ -1,13 +1,16 @@
 package snapshotter_test
 
 import (
+	"archive/tar"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -413,6 +416,101 @@ func TestSnapshotter_InvalidRequest(t *testing.T) {
 	}
 }
 
+func TestSnapshotter_ImportShard(t *testing.T) {
+	s, l, err := NewTestService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	f, err := ioutil.TempFile("", "snapshotter-import-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("tsm data")
+	f.Close()
+
+	var imported bool
+	var tsdbStore internal.TSDBStoreMock
+	tsdbStore.CreateShardFn = func(database, policy string, shardID uint64, enabled bool) error {
+		if database != "db0" || policy != "rp0" || shardID != 2 {
+			t.Errorf("unexpected shard: %s.%s %d", database, policy, shardID)
+		}
+		return nil
+	}
+	tsdbStore.ShardRelativePathFn = func(id uint64) (string, error) {
+		return "db0/rp0/2", nil
+	}
+	tsdbStore.SetShardEnabledFn = func(shardID uint64, enabled bool) error { return nil }
+	tsdbStore.ImportShardFn = func(id uint64, r io.Reader) error {
+		tr := tar.NewReader(r)
+		hdr, err := tr.Next()
+		if err != nil {
+			return err
+		} else if got, want := hdr.Name, "db0/rp0/2/"+filepath.Base(f.Name()); got != want {
+			t.Errorf("unexpected file name: got=%s want=%s", got, want)
+		}
+		if b, err := ioutil.ReadAll(tr); err != nil {
+			return err
+		} else if got, want := string(b), "tsm data"; got != want {
+			t.Errorf("unexpected file contents: got=%q want=%q", got, want)
+		}
+		if _, err := tr.Next(); err != io.EOF {
+			t.Errorf("expected end of archive, got: %v", err)
+		}
+		imported = true
+		return nil
+	}
+
+	s.MetaClient = &MetaClient{Data: *data.Clone()}
+	s.TSDBStore = &tsdbStore
+	if err := s.Open(); err != nil {
+		t.Fatalf("unexpected open error: %s", err)
+	}
+	defer s.Close()
+
+	c := snapshotter.NewClient(l.Addr().String())
+	shardID, err := c.ImportShard(&snapshotter.Request{
+		RestoreDatabase:        "db0",
+		RestoreRetentionPolicy: "rp0",
+		ImportStart:            time.Unix(0, 0).Add(time.Hour),
+		ImportEnd:              time.Unix(0, 0).Add(2 * time.Hour),
+	}, []string{f.Name()})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if shardID != 2 {
+		t.Errorf("unexpected shard id: %d", shardID)
+	} else if !imported {
+		t.Error("expected files to be imported")
+	}
+}
+
+func TestSnapshotter_ImportShard_ErrSpansShardGroups(t *testing.T) {
+	s, l, err := NewTestService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	s.MetaClient = &MetaClient{Data: *data.Clone()}
+	s.TSDBStore = &internal.TSDBStoreMock{}
+	if err := s.Open(); err != nil {
+		t.Fatalf("unexpected open error: %s", err)
+	}
+	defer s.Close()
+
+	c := snapshotter.NewClient(l.Addr().String())
+	if _, err := c.ImportShard(&snapshotter.Request{
+		RestoreDatabase:        "db0",
+		RestoreRetentionPolicy: "rp0",
+		ImportStart:            time.Unix(0, 0),
+		ImportEnd:              time.Unix(0, 0).Add(48 * time.Hour),
+	}, nil); err == nil || !strings.Contains(err.Error(), "spans more than one shard group") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func NewTestService() (*snapshotter.Service, net.Listener, error) {
 	s := snapshotter.NewService()
 	s.WithLogger(logger.New(os.Stderr))
@@ -446,3 +544,10 @@ func (m *MetaClient) Database(name string) *meta.DatabaseInfo {
 	}
 	return nil
 }
+
+func (m *MetaClient) CreateShardGroup(database, policy string, timestamp time.Time) (*meta.ShardGroupInfo, error) {
+	if err := m.Data.CreateShardGroup(database, policy, timestamp); err != nil {
+		return nil, err
+	}
+	return m.Data.ShardGroupByTimestamp(database, policy, timestamp)
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 
 	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
 )
 
 // ********************
@@ -161,51 +162,172 @@ func (c *floatMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type floatSumBatchCursor struct {
-	tsdb.FloatBatchCursor
+// floatWindow holds the state of an aggregate window.
+type floatWindow struct {
+	start         int64 // start of the window, or time of the first point read if unwindowed
+	n             int64
+	sum           float64
+	min, max      float64
+	minT          int64
+	maxT          int64
+	first, last   float64
+	firstT, lastT int64
 }
 
-func (c *floatSumBatchCursor) Next() (key []int64, value []float64) {
-	ks, vs := c.FloatBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (w *floatWindow) add(t int64, v float64) {
+	w.n++
+	if w.n == 1 {
+		w.sum, w.min, w.max, w.minT, w.maxT = v, v, v, t, t
+		w.first, w.last, w.firstT, w.lastT = v, v, t, t
+		return
+	}
+	w.sum += v
+	if v < w.min || (v == w.min && t < w.minT) {
+		w.min, w.minT = v, t
+	}
+	if v > w.max || (v == w.max && t < w.maxT) {
+		w.max, w.maxT = v, t
+	}
+	if t < w.firstT {
+		w.first, w.firstT = v, t
 	}
+	if t > w.lastT {
+		w.last, w.lastT = v, t
+	}
+}
 
-	ts := ks[0]
-	var acc float64
+// floatWindowReader reads the windows of a cursor. A window of 0 reads all
+// values as a single window.
+type floatWindowReader struct {
+	cur    tsdb.FloatBatchCursor
+	window int64
+	ks     []int64
+	vs     []float64
+}
 
+func (r *floatWindowReader) next(w *floatWindow) bool {
+	if len(r.ks) == 0 {
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return false
+		}
+	}
+
+	*w = floatWindow{start: windowStart(r.ks[0], r.window)}
 	for {
-		for _, v := range vs {
-			acc += v
+		i := 0
+		for ; i < len(r.ks); i++ {
+			if r.window > 0 && windowStart(r.ks[i], r.window) != w.start {
+				break
+			}
+			w.add(r.ks[i], r.vs[i])
 		}
-		ks, vs = c.FloatBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []float64{acc}
+		if r.ks, r.vs = r.ks[i:], r.vs[i:]; len(r.ks) > 0 {
+			return true
+		}
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return true
 		}
 	}
 }
 
-type integerFloatCountBatchCursor struct {
+// floatWindowAggregateBatchCursor computes an aggregate that returns the
+// type of its input for each window.
+type floatWindowAggregateBatchCursor struct {
 	tsdb.FloatBatchCursor
+	r   floatWindowReader
+	agg Aggregate_AggregateType
+	t   []int64
+	v   []float64
 }
 
-func (c *integerFloatCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.FloatBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func newFloatWindowAggregateBatchCursor(cur tsdb.FloatBatchCursor, agg Aggregate_AggregateType, window int64) (*floatWindowAggregateBatchCursor, error) {
+	if err := validateAggregateType(agg, influxql.Float); err != nil {
+		return nil, err
 	}
+	return &floatWindowAggregateBatchCursor{
+		FloatBatchCursor: cur,
+		r:                floatWindowReader{cur: cur, window: window},
+		agg:              agg,
+	}, nil
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.FloatBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+func (c *floatWindowAggregateBatchCursor) Next() (key []int64, value []float64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w floatWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		var t int64
+		var v float64
+		switch c.agg {
+		case AggregateTypeSum:
+			t, v = w.start, w.sum
+		case AggregateTypeMin:
+			t, v = w.minT, w.min
+		case AggregateTypeMax:
+			t, v = w.maxT, w.max
+		case AggregateTypeFirst:
+			t, v = w.firstT, w.first
+		case AggregateTypeLast:
+			t, v = w.lastT, w.last
+		}
+
+		// Windowed selectors are returned at the start of their window.
+		if c.r.window > 0 {
+			t = w.start
 		}
+		c.t, c.v = append(c.t, t), append(c.v, v)
+	}
+	return c.t, c.v
+}
+
+type integerFloatWindowCountBatchCursor struct {
+	tsdb.FloatBatchCursor
+	r floatWindowReader
+	t []int64
+	v []int64
+}
+
+func newIntegerFloatWindowCountBatchCursor(cur tsdb.FloatBatchCursor, window int64) *integerFloatWindowCountBatchCursor {
+	return &integerFloatWindowCountBatchCursor{
+		FloatBatchCursor: cur,
+		r:                floatWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *integerFloatWindowCountBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w floatWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, w.n)
+	}
+	return c.t, c.v
+}
+
+type floatFloatWindowMeanBatchCursor struct {
+	tsdb.FloatBatchCursor
+	r floatWindowReader
+	t []int64
+	v []float64
+}
+
+func newFloatFloatWindowMeanBatchCursor(cur tsdb.FloatBatchCursor, window int64) *floatFloatWindowMeanBatchCursor {
+	return &floatFloatWindowMeanBatchCursor{
+		FloatBatchCursor: cur,
+		r:                floatWindowReader{cur: cur, window: window},
 	}
 }
 
+func (c *floatFloatWindowMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w floatWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, float64(w.sum)/float64(w.n))
+	}
+	return c.t, c.v
+}
+
 type floatEmptyBatchCursor struct{}
 
 var FloatEmptyBatchCursor tsdb.FloatBatchCursor = &floatEmptyBatchCursor{}
@@ -363,51 +485,172 @@ func (c *integerMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerSumBatchCursor struct {
-	tsdb.IntegerBatchCursor
+// integerWindow holds the state of an aggregate window.
+type integerWindow struct {
+	start         int64 // start of the window, or time of the first point read if unwindowed
+	n             int64
+	sum           int64
+	min, max      int64
+	minT          int64
+	maxT          int64
+	first, last   int64
+	firstT, lastT int64
 }
 
-func (c *integerSumBatchCursor) Next() (key []int64, value []int64) {
-	ks, vs := c.IntegerBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (w *integerWindow) add(t int64, v int64) {
+	w.n++
+	if w.n == 1 {
+		w.sum, w.min, w.max, w.minT, w.maxT = v, v, v, t, t
+		w.first, w.last, w.firstT, w.lastT = v, v, t, t
+		return
+	}
+	w.sum += v
+	if v < w.min || (v == w.min && t < w.minT) {
+		w.min, w.minT = v, t
 	}
+	if v > w.max || (v == w.max && t < w.maxT) {
+		w.max, w.maxT = v, t
+	}
+	if t < w.firstT {
+		w.first, w.firstT = v, t
+	}
+	if t > w.lastT {
+		w.last, w.lastT = v, t
+	}
+}
+
+// integerWindowReader reads the windows of a cursor. A window of 0 reads all
+// values as a single window.
+type integerWindowReader struct {
+	cur    tsdb.IntegerBatchCursor
+	window int64
+	ks     []int64
+	vs     []int64
+}
 
-	ts := ks[0]
-	var acc int64
+func (r *integerWindowReader) next(w *integerWindow) bool {
+	if len(r.ks) == 0 {
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return false
+		}
+	}
 
+	*w = integerWindow{start: windowStart(r.ks[0], r.window)}
 	for {
-		for _, v := range vs {
-			acc += v
+		i := 0
+		for ; i < len(r.ks); i++ {
+			if r.window > 0 && windowStart(r.ks[i], r.window) != w.start {
+				break
+			}
+			w.add(r.ks[i], r.vs[i])
 		}
-		ks, vs = c.IntegerBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+		if r.ks, r.vs = r.ks[i:], r.vs[i:]; len(r.ks) > 0 {
+			return true
+		}
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return true
 		}
 	}
 }
 
-type integerIntegerCountBatchCursor struct {
+// integerWindowAggregateBatchCursor computes an aggregate that returns the
+// type of its input for each window.
+type integerWindowAggregateBatchCursor struct {
 	tsdb.IntegerBatchCursor
+	r   integerWindowReader
+	agg Aggregate_AggregateType
+	t   []int64
+	v   []int64
 }
 
-func (c *integerIntegerCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.IntegerBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func newIntegerWindowAggregateBatchCursor(cur tsdb.IntegerBatchCursor, agg Aggregate_AggregateType, window int64) (*integerWindowAggregateBatchCursor, error) {
+	if err := validateAggregateType(agg, influxql.Integer); err != nil {
+		return nil, err
 	}
+	return &integerWindowAggregateBatchCursor{
+		IntegerBatchCursor: cur,
+		r:                  integerWindowReader{cur: cur, window: window},
+		agg:                agg,
+	}, nil
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.IntegerBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+func (c *integerWindowAggregateBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w integerWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		var t int64
+		var v int64
+		switch c.agg {
+		case AggregateTypeSum:
+			t, v = w.start, w.sum
+		case AggregateTypeMin:
+			t, v = w.minT, w.min
+		case AggregateTypeMax:
+			t, v = w.maxT, w.max
+		case AggregateTypeFirst:
+			t, v = w.firstT, w.first
+		case AggregateTypeLast:
+			t, v = w.lastT, w.last
 		}
+
+		// Windowed selectors are returned at the start of their window.
+		if c.r.window > 0 {
+			t = w.start
+		}
+		c.t, c.v = append(c.t, t), append(c.v, v)
+	}
+	return c.t, c.v
+}
+
+type integerIntegerWindowCountBatchCursor struct {
+	tsdb.IntegerBatchCursor
+	r integerWindowReader
+	t []int64
+	v []int64
+}
+
+func newIntegerIntegerWindowCountBatchCursor(cur tsdb.IntegerBatchCursor, window int64) *integerIntegerWindowCountBatchCursor {
+	return &integerIntegerWindowCountBatchCursor{
+		IntegerBatchCursor: cur,
+		r:                  integerWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *integerIntegerWindowCountBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w integerWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, w.n)
+	}
+	return c.t, c.v
+}
+
+type floatIntegerWindowMeanBatchCursor struct {
+	tsdb.IntegerBatchCursor
+	r integerWindowReader
+	t []int64
+	v []float64
+}
+
+func newFloatIntegerWindowMeanBatchCursor(cur tsdb.IntegerBatchCursor, window int64) *floatIntegerWindowMeanBatchCursor {
+	return &floatIntegerWindowMeanBatchCursor{
+		IntegerBatchCursor: cur,
+		r:                  integerWindowReader{cur: cur, window: window},
 	}
 }
 
+func (c *floatIntegerWindowMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w integerWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, float64(w.sum)/float64(w.n))
+	}
+	return c.t, c.v
+}
+
 type integerEmptyBatchCursor struct{}
 
 var IntegerEmptyBatchCursor tsdb.IntegerBatchCursor = &integerEmptyBatchCursor{}
@@ -565,49 +808,170 @@ func (c *unsignedMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type unsignedSumBatchCursor struct {
-	tsdb.UnsignedBatchCursor
+// unsignedWindow holds the state of an aggregate window.
+type unsignedWindow struct {
+	start         int64 // start of the window, or time of the first point read if unwindowed
+	n             int64
+	sum           uint64
+	min, max      uint64
+	minT          int64
+	maxT          int64
+	first, last   uint64
+	firstT, lastT int64
 }
 
-func (c *unsignedSumBatchCursor) Next() (key []int64, value []uint64) {
-	ks, vs := c.UnsignedBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (w *unsignedWindow) add(t int64, v uint64) {
+	w.n++
+	if w.n == 1 {
+		w.sum, w.min, w.max, w.minT, w.maxT = v, v, v, t, t
+		w.first, w.last, w.firstT, w.lastT = v, v, t, t
+		return
+	}
+	w.sum += v
+	if v < w.min || (v == w.min && t < w.minT) {
+		w.min, w.minT = v, t
+	}
+	if v > w.max || (v == w.max && t < w.maxT) {
+		w.max, w.maxT = v, t
+	}
+	if t < w.firstT {
+		w.first, w.firstT = v, t
 	}
+	if t > w.lastT {
+		w.last, w.lastT = v, t
+	}
+}
+
+// unsignedWindowReader reads the windows of a cursor. A window of 0 reads all
+// values as a single window.
+type unsignedWindowReader struct {
+	cur    tsdb.UnsignedBatchCursor
+	window int64
+	ks     []int64
+	vs     []uint64
+}
 
-	ts := ks[0]
-	var acc uint64
+func (r *unsignedWindowReader) next(w *unsignedWindow) bool {
+	if len(r.ks) == 0 {
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return false
+		}
+	}
 
+	*w = unsignedWindow{start: windowStart(r.ks[0], r.window)}
 	for {
-		for _, v := range vs {
-			acc += v
+		i := 0
+		for ; i < len(r.ks); i++ {
+			if r.window > 0 && windowStart(r.ks[i], r.window) != w.start {
+				break
+			}
+			w.add(r.ks[i], r.vs[i])
 		}
-		ks, vs = c.UnsignedBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []uint64{acc}
+		if r.ks, r.vs = r.ks[i:], r.vs[i:]; len(r.ks) > 0 {
+			return true
+		}
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return true
 		}
 	}
 }
 
-type integerUnsignedCountBatchCursor struct {
+// unsignedWindowAggregateBatchCursor computes an aggregate that returns the
+// type of its input for each window.
+type unsignedWindowAggregateBatchCursor struct {
 	tsdb.UnsignedBatchCursor
+	r   unsignedWindowReader
+	agg Aggregate_AggregateType
+	t   []int64
+	v   []uint64
 }
 
-func (c *integerUnsignedCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.UnsignedBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func newUnsignedWindowAggregateBatchCursor(cur tsdb.UnsignedBatchCursor, agg Aggregate_AggregateType, window int64) (*unsignedWindowAggregateBatchCursor, error) {
+	if err := validateAggregateType(agg, influxql.Unsigned); err != nil {
+		return nil, err
 	}
+	return &unsignedWindowAggregateBatchCursor{
+		UnsignedBatchCursor: cur,
+		r:                   unsignedWindowReader{cur: cur, window: window},
+		agg:                 agg,
+	}, nil
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.UnsignedBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+func (c *unsignedWindowAggregateBatchCursor) Next() (key []int64, value []uint64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w unsignedWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		var t int64
+		var v uint64
+		switch c.agg {
+		case AggregateTypeSum:
+			t, v = w.start, w.sum
+		case AggregateTypeMin:
+			t, v = w.minT, w.min
+		case AggregateTypeMax:
+			t, v = w.maxT, w.max
+		case AggregateTypeFirst:
+			t, v = w.firstT, w.first
+		case AggregateTypeLast:
+			t, v = w.lastT, w.last
+		}
+
+		// Windowed selectors are returned at the start of their window.
+		if c.r.window > 0 {
+			t = w.start
 		}
+		c.t, c.v = append(c.t, t), append(c.v, v)
+	}
+	return c.t, c.v
+}
+
+type integerUnsignedWindowCountBatchCursor struct {
+	tsdb.UnsignedBatchCursor
+	r unsignedWindowReader
+	t []int64
+	v []int64
+}
+
+func newIntegerUnsignedWindowCountBatchCursor(cur tsdb.UnsignedBatchCursor, window int64) *integerUnsignedWindowCountBatchCursor {
+	return &integerUnsignedWindowCountBatchCursor{
+		UnsignedBatchCursor: cur,
+		r:                   unsignedWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *integerUnsignedWindowCountBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w unsignedWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, w.n)
+	}
+	return c.t, c.v
+}
+
+type floatUnsignedWindowMeanBatchCursor struct {
+	tsdb.UnsignedBatchCursor
+	r unsignedWindowReader
+	t []int64
+	v []float64
+}
+
+func newFloatUnsignedWindowMeanBatchCursor(cur tsdb.UnsignedBatchCursor, window int64) *floatUnsignedWindowMeanBatchCursor {
+	return &floatUnsignedWindowMeanBatchCursor{
+		UnsignedBatchCursor: cur,
+		r:                   unsignedWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *floatUnsignedWindowMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w unsignedWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, float64(w.sum)/float64(w.n))
 	}
+	return c.t, c.v
 }
 
 type unsignedEmptyBatchCursor struct{}
@@ -767,25 +1131,128 @@ func (c *stringMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerStringCountBatchCursor struct {
-	tsdb.StringBatchCursor
+// stringWindow holds the state of an aggregate window.
+type stringWindow struct {
+	start         int64 // start of the window, or time of the first point read if unwindowed
+	n             int64
+	first, last   string
+	firstT, lastT int64
 }
 
-func (c *integerStringCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.StringBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (w *stringWindow) add(t int64, v string) {
+	w.n++
+	if w.n == 1 {
+		w.first, w.last, w.firstT, w.lastT = v, v, t, t
+		return
+	}
+	if t < w.firstT {
+		w.first, w.firstT = v, t
+	}
+	if t > w.lastT {
+		w.last, w.lastT = v, t
 	}
+}
+
+// stringWindowReader reads the windows of a cursor. A window of 0 reads all
+// values as a single window.
+type stringWindowReader struct {
+	cur    tsdb.StringBatchCursor
+	window int64
+	ks     []int64
+	vs     []string
+}
 
-	ts := ks[0]
-	var acc int64
+func (r *stringWindowReader) next(w *stringWindow) bool {
+	if len(r.ks) == 0 {
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return false
+		}
+	}
+
+	*w = stringWindow{start: windowStart(r.ks[0], r.window)}
 	for {
-		acc += int64(len(ks))
-		ks, _ = c.StringBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+		i := 0
+		for ; i < len(r.ks); i++ {
+			if r.window > 0 && windowStart(r.ks[i], r.window) != w.start {
+				break
+			}
+			w.add(r.ks[i], r.vs[i])
+		}
+		if r.ks, r.vs = r.ks[i:], r.vs[i:]; len(r.ks) > 0 {
+			return true
+		}
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return true
+		}
+	}
+}
+
+// stringWindowAggregateBatchCursor computes an aggregate that returns the
+// type of its input for each window.
+type stringWindowAggregateBatchCursor struct {
+	tsdb.StringBatchCursor
+	r   stringWindowReader
+	agg Aggregate_AggregateType
+	t   []int64
+	v   []string
+}
+
+func newStringWindowAggregateBatchCursor(cur tsdb.StringBatchCursor, agg Aggregate_AggregateType, window int64) (*stringWindowAggregateBatchCursor, error) {
+	if err := validateAggregateType(agg, influxql.String); err != nil {
+		return nil, err
+	}
+	return &stringWindowAggregateBatchCursor{
+		StringBatchCursor: cur,
+		r:                 stringWindowReader{cur: cur, window: window},
+		agg:               agg,
+	}, nil
+}
+
+func (c *stringWindowAggregateBatchCursor) Next() (key []int64, value []string) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w stringWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		var t int64
+		var v string
+		switch c.agg {
+		case AggregateTypeFirst:
+			t, v = w.firstT, w.first
+		case AggregateTypeLast:
+			t, v = w.lastT, w.last
 		}
+
+		// Windowed selectors are returned at the start of their window.
+		if c.r.window > 0 {
+			t = w.start
+		}
+		c.t, c.v = append(c.t, t), append(c.v, v)
+	}
+	return c.t, c.v
+}
+
+type integerStringWindowCountBatchCursor struct {
+	tsdb.StringBatchCursor
+	r stringWindowReader
+	t []int64
+	v []int64
+}
+
+func newIntegerStringWindowCountBatchCursor(cur tsdb.StringBatchCursor, window int64) *integerStringWindowCountBatchCursor {
+	return &integerStringWindowCountBatchCursor{
+		StringBatchCursor: cur,
+		r:                 stringWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *integerStringWindowCountBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w stringWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, w.n)
 	}
+	return c.t, c.v
 }
 
 type stringEmptyBatchCursor struct{}
@@ -945,25 +1412,128 @@ func (c *booleanMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerBooleanCountBatchCursor struct {
-	tsdb.BooleanBatchCursor
+// booleanWindow holds the state of an aggregate window.
+type booleanWindow struct {
+	start         int64 // start of the window, or time of the first point read if unwindowed
+	n             int64
+	first, last   bool
+	firstT, lastT int64
+}
+
+func (w *booleanWindow) add(t int64, v bool) {
+	w.n++
+	if w.n == 1 {
+		w.first, w.last, w.firstT, w.lastT = v, v, t, t
+		return
+	}
+	if t < w.firstT {
+		w.first, w.firstT = v, t
+	}
+	if t > w.lastT {
+		w.last, w.lastT = v, t
+	}
 }
 
-func (c *integerBooleanCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.BooleanBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+// booleanWindowReader reads the windows of a cursor. A window of 0 reads all
+// values as a single window.
+type booleanWindowReader struct {
+	cur    tsdb.BooleanBatchCursor
+	window int64
+	ks     []int64
+	vs     []bool
+}
+
+func (r *booleanWindowReader) next(w *booleanWindow) bool {
+	if len(r.ks) == 0 {
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return false
+		}
 	}
 
-	ts := ks[0]
-	var acc int64
+	*w = booleanWindow{start: windowStart(r.ks[0], r.window)}
 	for {
-		acc += int64(len(ks))
-		ks, _ = c.BooleanBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+		i := 0
+		for ; i < len(r.ks); i++ {
+			if r.window > 0 && windowStart(r.ks[i], r.window) != w.start {
+				break
+			}
+			w.add(r.ks[i], r.vs[i])
+		}
+		if r.ks, r.vs = r.ks[i:], r.vs[i:]; len(r.ks) > 0 {
+			return true
 		}
+		if r.ks, r.vs = r.cur.Next(); len(r.ks) == 0 {
+			return true
+		}
+	}
+}
+
+// booleanWindowAggregateBatchCursor computes an aggregate that returns the
+// type of its input for each window.
+type booleanWindowAggregateBatchCursor struct {
+	tsdb.BooleanBatchCursor
+	r   booleanWindowReader
+	agg Aggregate_AggregateType
+	t   []int64
+	v   []bool
+}
+
+func newBooleanWindowAggregateBatchCursor(cur tsdb.BooleanBatchCursor, agg Aggregate_AggregateType, window int64) (*booleanWindowAggregateBatchCursor, error) {
+	if err := validateAggregateType(agg, influxql.Boolean); err != nil {
+		return nil, err
+	}
+	return &booleanWindowAggregateBatchCursor{
+		BooleanBatchCursor: cur,
+		r:                  booleanWindowReader{cur: cur, window: window},
+		agg:                agg,
+	}, nil
+}
+
+func (c *booleanWindowAggregateBatchCursor) Next() (key []int64, value []bool) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w booleanWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		var t int64
+		var v bool
+		switch c.agg {
+		case AggregateTypeFirst:
+			t, v = w.firstT, w.first
+		case AggregateTypeLast:
+			t, v = w.lastT, w.last
+		}
+
+		// Windowed selectors are returned at the start of their window.
+		if c.r.window > 0 {
+			t = w.start
+		}
+		c.t, c.v = append(c.t, t), append(c.v, v)
+	}
+	return c.t, c.v
+}
+
+type integerBooleanWindowCountBatchCursor struct {
+	tsdb.BooleanBatchCursor
+	r booleanWindowReader
+	t []int64
+	v []int64
+}
+
+func newIntegerBooleanWindowCountBatchCursor(cur tsdb.BooleanBatchCursor, window int64) *integerBooleanWindowCountBatchCursor {
+	return &integerBooleanWindowCountBatchCursor{
+		BooleanBatchCursor: cur,
+		r:                  booleanWindowReader{cur: cur, window: window},
+	}
+}
+
+func (c *integerBooleanWindowCountBatchCursor) Next() (key []int64, value []int64) {
+	c.t, c.v = c.t[:0], c.v[:0]
+
+	var w booleanWindow
+	for len(c.t) < tsdb.DefaultMaxPointsPerBlock && c.r.next(&w) {
+		c.t, c.v = append(c.t, w.start), append(c.v, w.n)
 	}
+	return c.t, c.v
 }
 
 type booleanEmptyBatchCursor struct{}
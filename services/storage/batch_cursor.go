@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
 )
 
 type singleValue struct {
@@ -15,53 +16,97 @@ func (v *singleValue) Value(key string) (interface{}, bool) {
 	return v.v, true
 }
 
-func newAggregateBatchCursor(ctx context.Context, agg *Aggregate, cursor tsdb.Cursor) tsdb.Cursor {
+// newAggregateBatchCursor returns a cursor computing the aggregate of each
+// window of cursor. A window of 0 aggregates all values into a single value.
+// Returns an error and closes cursor if the aggregate does not apply to the
+// type of cursor.
+func newAggregateBatchCursor(ctx context.Context, agg *Aggregate, window int64, cursor tsdb.Cursor) (tsdb.Cursor, error) {
 	if cursor == nil {
-		return nil
+		return nil, nil
 	}
 
+	var cur tsdb.Cursor
+	var err error
 	switch agg.Type {
-	case AggregateTypeSum:
-		return newSumBatchCursor(cursor)
 	case AggregateTypeCount:
-		return newCountBatchCursor(cursor)
+		return newCountBatchCursor(cursor, window), nil
+	case AggregateTypeMean:
+		cur, err = newMeanBatchCursor(cursor, window)
 	default:
-		// TODO(sgc): should be validated higher up
-		panic("invalid aggregate")
+		cur, err = newWindowAggregateBatchCursor(cursor, agg.Type, window)
+	}
+
+	if err != nil {
+		cursor.Close()
+		return nil, err
 	}
+	return cur, nil
 }
 
-func newSumBatchCursor(cur tsdb.Cursor) tsdb.Cursor {
+func newWindowAggregateBatchCursor(cur tsdb.Cursor, agg Aggregate_AggregateType, window int64) (tsdb.Cursor, error) {
 	switch cur := cur.(type) {
 	case tsdb.FloatBatchCursor:
-		return &floatSumBatchCursor{FloatBatchCursor: cur}
+		return newFloatWindowAggregateBatchCursor(cur, agg, window)
 	case tsdb.IntegerBatchCursor:
-		return &integerSumBatchCursor{IntegerBatchCursor: cur}
+		return newIntegerWindowAggregateBatchCursor(cur, agg, window)
 	case tsdb.UnsignedBatchCursor:
-		return &unsignedSumBatchCursor{UnsignedBatchCursor: cur}
+		return newUnsignedWindowAggregateBatchCursor(cur, agg, window)
+	case tsdb.StringBatchCursor:
+		return newStringWindowAggregateBatchCursor(cur, agg, window)
+	case tsdb.BooleanBatchCursor:
+		return newBooleanWindowAggregateBatchCursor(cur, agg, window)
 	default:
-		// TODO(sgc): propagate an error instead?
-		return nil
+		panic(fmt.Sprintf("unreachable: %T", cur))
 	}
 }
 
-func newCountBatchCursor(cur tsdb.Cursor) tsdb.Cursor {
+func newCountBatchCursor(cur tsdb.Cursor, window int64) tsdb.Cursor {
 	switch cur := cur.(type) {
 	case tsdb.FloatBatchCursor:
-		return &integerFloatCountBatchCursor{FloatBatchCursor: cur}
+		return newIntegerFloatWindowCountBatchCursor(cur, window)
 	case tsdb.IntegerBatchCursor:
-		return &integerIntegerCountBatchCursor{IntegerBatchCursor: cur}
+		return newIntegerIntegerWindowCountBatchCursor(cur, window)
 	case tsdb.UnsignedBatchCursor:
-		return &integerUnsignedCountBatchCursor{UnsignedBatchCursor: cur}
+		return newIntegerUnsignedWindowCountBatchCursor(cur, window)
 	case tsdb.StringBatchCursor:
-		return &integerStringCountBatchCursor{StringBatchCursor: cur}
+		return newIntegerStringWindowCountBatchCursor(cur, window)
 	case tsdb.BooleanBatchCursor:
-		return &integerBooleanCountBatchCursor{BooleanBatchCursor: cur}
+		return newIntegerBooleanWindowCountBatchCursor(cur, window)
 	default:
 		panic(fmt.Sprintf("unreachable: %T", cur))
 	}
 }
 
+func newMeanBatchCursor(cur tsdb.Cursor, window int64) (tsdb.Cursor, error) {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return newFloatFloatWindowMeanBatchCursor(cur, window), nil
+	case tsdb.IntegerBatchCursor:
+		return newFloatIntegerWindowMeanBatchCursor(cur, window), nil
+	case tsdb.UnsignedBatchCursor:
+		return newFloatUnsignedWindowMeanBatchCursor(cur, window), nil
+	case tsdb.StringBatchCursor:
+		return nil, validateAggregateType(AggregateTypeMean, influxql.String)
+	case tsdb.BooleanBatchCursor:
+		return nil, validateAggregateType(AggregateTypeMean, influxql.Boolean)
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+// windowStart returns the start of the window of the given duration that
+// contains t. Windows are aligned to the epoch. A window of 0 returns t.
+func windowStart(t, window int64) int64 {
+	if window <= 0 {
+		return t
+	}
+	start := t - t%window
+	if t%window < 0 {
+		start -= window
+	}
+	return start
+}
+
 func newMultiShardBatchCursor(ctx context.Context, row seriesRow, rr *readRequest) tsdb.Cursor {
 	req := &tsdb.CursorRequest{
 		Measurement: row.measurement,
@@ -75,6 +120,7 @@ func newMultiShardBatchCursor(ctx context.Context, row seriesRow, rr *readReques
 	var cond expression
 	if row.valueCond != nil {
 		cond = &astExpr{row.valueCond}
+		req.Condition = row.valueCond
 	}
 
 	var shard *tsdb.Shard
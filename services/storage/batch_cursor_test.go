@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+func TestWindowStart(t *testing.T) {
+	for _, tt := range []struct {
+		t, window, exp int64
+	}{
+		{t: 25, window: 0, exp: 25},
+		{t: 25, window: 10, exp: 20},
+		{t: 20, window: 10, exp: 20},
+		{t: -5, window: 10, exp: -10},
+		{t: -10, window: 10, exp: -10},
+	} {
+		if got := windowStart(tt.t, tt.window); got != tt.exp {
+			t.Errorf("windowStart(%d, %d) = %d, exp %d", tt.t, tt.window, got, tt.exp)
+		}
+	}
+}
+
+func TestAggregateBatchCursor_Window(t *testing.T) {
+	// Points span three windows of 10 and two batches.
+	newCursor := func() tsdb.FloatBatchCursor {
+		return &floatSliceBatchCursor{
+			t: [][]int64{{1, 4, 12}, {15, 18, 31}},
+			v: [][]float64{{3, 1, 5}, {2, 8, 4}},
+		}
+	}
+
+	for _, tt := range []struct {
+		agg    Aggregate_AggregateType
+		window int64
+		expT   []int64
+		expV   interface{}
+	}{
+		{agg: AggregateTypeSum, window: 10, expT: []int64{0, 10, 30}, expV: []float64{4, 15, 4}},
+		{agg: AggregateTypeMin, window: 10, expT: []int64{0, 10, 30}, expV: []float64{1, 2, 4}},
+		{agg: AggregateTypeMax, window: 10, expT: []int64{0, 10, 30}, expV: []float64{3, 8, 4}},
+		{agg: AggregateTypeFirst, window: 10, expT: []int64{0, 10, 30}, expV: []float64{3, 5, 4}},
+		{agg: AggregateTypeLast, window: 10, expT: []int64{0, 10, 30}, expV: []float64{1, 8, 4}},
+		{agg: AggregateTypeCount, window: 10, expT: []int64{0, 10, 30}, expV: []int64{2, 3, 1}},
+		{agg: AggregateTypeMean, window: 10, expT: []int64{0, 10, 30}, expV: []float64{2, 5, 4}},
+		{agg: AggregateTypeSum, expT: []int64{1}, expV: []float64{23}},
+		{agg: AggregateTypeMax, expT: []int64{18}, expV: []float64{8}},
+		{agg: AggregateTypeLast, expT: []int64{31}, expV: []float64{4}},
+		{agg: AggregateTypeCount, expT: []int64{1}, expV: []int64{6}},
+	} {
+		cur, err := newAggregateBatchCursor(context.Background(), &Aggregate{Type: tt.agg}, tt.window, newCursor())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.agg, err)
+		}
+
+		var gotT []int64
+		var gotV interface{}
+		switch cur := cur.(type) {
+		case tsdb.FloatBatchCursor:
+			var vs []float64
+			for {
+				k, v := cur.Next()
+				if len(k) == 0 {
+					break
+				}
+				gotT, vs = append(gotT, k...), append(vs, v...)
+			}
+			gotV = vs
+		case tsdb.IntegerBatchCursor:
+			var vs []int64
+			for {
+				k, v := cur.Next()
+				if len(k) == 0 {
+					break
+				}
+				gotT, vs = append(gotT, k...), append(vs, v...)
+			}
+			gotV = vs
+		default:
+			t.Fatalf("%s: unexpected cursor %T", tt.agg, cur)
+		}
+
+		if !cmp.Equal(gotT, tt.expT) || !cmp.Equal(gotV, tt.expV) {
+			t.Errorf("%s window %d: unexpected result: %v %v", tt.agg, tt.window, gotT, gotV)
+		}
+	}
+}
+
+func TestAggregateBatchCursor_UnsupportedType(t *testing.T) {
+	for _, tt := range []struct {
+		agg Aggregate_AggregateType
+		err string
+	}{
+		{agg: AggregateTypeSum, err: "aggregate sum does not apply to string fields"},
+		{agg: AggregateTypeMean, err: "aggregate mean does not apply to string fields"},
+	} {
+		cur := &stringSliceBatchCursor{}
+		if c, err := newAggregateBatchCursor(context.Background(), &Aggregate{Type: tt.agg}, 0, cur); c != nil {
+			t.Fatalf("%s: unexpected cursor %T", tt.agg, c)
+		} else if err == nil || err.Error() != tt.err {
+			t.Fatalf("%s: unexpected error: %v", tt.agg, err)
+		}
+		if !cur.closed {
+			t.Fatalf("%s: expected cursor to be closed", tt.agg)
+		}
+	}
+}
+
+// floatSliceBatchCursor returns one batch of points per call to Next.
+type floatSliceBatchCursor struct {
+	t [][]int64
+	v [][]float64
+}
+
+func (c *floatSliceBatchCursor) Close()            {}
+func (c *floatSliceBatchCursor) SeriesKey() string { return "" }
+func (c *floatSliceBatchCursor) Err() error        { return nil }
+
+func (c *floatSliceBatchCursor) Next() ([]int64, []float64) {
+	if len(c.t) == 0 {
+		return nil, nil
+	}
+	t, v := c.t[0], c.v[0]
+	c.t, c.v = c.t[1:], c.v[1:]
+	return t, v
+}
+
+type stringSliceBatchCursor struct {
+	closed bool
+}
+
+func (c *stringSliceBatchCursor) Close()                    { c.closed = true }
+func (c *stringSliceBatchCursor) SeriesKey() string         { return "" }
+func (c *stringSliceBatchCursor) Err() error                { return nil }
+func (c *stringSliceBatchCursor) Next() ([]int64, []string) { return nil, nil }
@@ -14,14 +14,24 @@ type Config struct {
 	Enabled     bool   `toml:"enabled"`
 	LogEnabled  bool   `toml:"log-enabled"` // verbose logging
 	BindAddress string `toml:"bind-address"`
+
+	// WriteEnabled allows clients to write points with the Write RPC.
+	WriteEnabled bool `toml:"write-enabled"`
+
+	// Requests carry the credentials of their user when authentication is
+	// enabled, so the listener can be served over TLS.
+	HTTPSEnabled     bool   `toml:"https-enabled"`
+	HTTPSCertificate string `toml:"https-certificate"`
+	HTTPSPrivateKey  string `toml:"https-private-key"`
 }
 
 // NewConfig returns a new Config with default settings.
 func NewConfig() Config {
 	return Config{
-		Enabled:     false,
-		LogEnabled:  true,
-		BindAddress: DefaultBindAddress,
+		Enabled:          false,
+		LogEnabled:       true,
+		BindAddress:      DefaultBindAddress,
+		HTTPSCertificate: "/etc/ssl/influxdb.pem",
 	}
 }
 
@@ -34,8 +44,10 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	}
 
 	return diagnostics.RowFromMap(map[string]interface{}{
-		"enabled":      true,
-		"log-enabled":  c.LogEnabled,
-		"bind-address": c.BindAddress,
+		"enabled":       true,
+		"log-enabled":   c.LogEnabled,
+		"bind-address":  c.BindAddress,
+		"write-enabled": c.WriteEnabled,
+		"https-enabled": c.HTTPSEnabled,
 	}), nil
 }
@@ -0,0 +1,35 @@
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/influxdata/influxdb/services/storage"
+)
+
+func TestConfig_Parse(t *testing.T) {
+	// Parse configuration.
+	c := storage.NewConfig()
+	if _, err := toml.Decode(`
+enabled = true
+bind-address = ":8082"
+https-enabled = true
+https-certificate = "/dev/null"
+https-private-key = "/dev/zero"
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validate configuration.
+	if c.Enabled != true {
+		t.Fatalf("unexpected enabled: %v", c.Enabled)
+	} else if c.BindAddress != ":8082" {
+		t.Fatalf("unexpected bind address: %s", c.BindAddress)
+	} else if c.HTTPSEnabled != true {
+		t.Fatalf("unexpected https enabled: %v", c.HTTPSEnabled)
+	} else if c.HTTPSCertificate != "/dev/null" {
+		t.Fatalf("unexpected https certificate: %v", c.HTTPSCertificate)
+	} else if c.HTTPSPrivateKey != "/dev/zero" {
+		t.Fatalf("unexpected https private key: %v", c.HTTPSPrivateKey)
+	}
+}
@@ -2,23 +2,33 @@ package storage
 
 import (
 	"context"
+	"strings"
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/tsdb"
 )
 
+var aggregateKey = []byte("_aggregate")
+
 type readRequest struct {
 	ctx        context.Context
 	start, end int64
 	asc        bool
 	limit      uint64
-	aggregate  *Aggregate
+	aggregates []*Aggregate
+	window     int64
+
+	// tagAggregates adds the aggregate of each series to its tags.
+	tagAggregates bool
 }
 
 type ResultSet struct {
 	req readRequest
 	cur seriesCursor
 	row seriesRow
+	ok  bool // row holds a series
+	agg int  // index of the current aggregate
+	err error
 }
 
 func (r *ResultSet) Close() {
@@ -27,24 +37,54 @@ func (r *ResultSet) Close() {
 }
 
 func (r *ResultSet) Next() bool {
+	if r.err != nil {
+		return false
+	}
+
+	// Return each aggregate of the current row before moving to the next.
+	if r.ok && r.agg+1 < len(r.req.aggregates) {
+		r.agg++
+		return true
+	}
+
 	row := r.cur.Next()
 	if row == nil {
+		r.ok = false
 		return false
 	}
 
 	r.row = *row
+	r.ok = true
+	r.agg = 0
 
 	return true
 }
 
+// Cursor returns the cursor of the current series, or nil if the series has
+// no data or the cursor cannot be created. Err returns the reason for the
+// latter.
 func (r *ResultSet) Cursor() tsdb.Cursor {
 	cur := newMultiShardBatchCursor(r.req.ctx, r.row, &r.req)
-	if r.req.aggregate != nil {
-		cur = newAggregateBatchCursor(r.req.ctx, r.req.aggregate, cur)
+	if len(r.req.aggregates) > 0 {
+		cur, r.err = newAggregateBatchCursor(r.req.ctx, r.req.aggregates[r.agg], r.req.window, cur)
 	}
 	return cur
 }
 
+// Err returns the error that stopped the result set, if any.
+func (r *ResultSet) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.cur.Err()
+}
+
 func (r *ResultSet) Tags() models.Tags {
-	return r.row.tags
+	if !r.req.tagAggregates {
+		return r.row.tags
+	}
+
+	tags := r.row.tags.Clone()
+	tags.Set(aggregateKey, []byte(strings.ToLower(r.req.aggregates[r.agg].Type.String())))
+	return tags
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"strings"
 
@@ -41,6 +42,165 @@ func (r *rpcService) Hints(context.Context, *types.Empty) (*HintsResponse, error
 	return nil, errors.New("not implemented")
 }
 
+func (r *rpcService) TagKeys(req *MetadataRequest, stream Storage_TagKeysServer) error {
+	r.logMetadataRequest("tag_keys", req.Database, req.TimestampRange, req.Predicate, req.Limit)
+
+	keys, err := r.Store.TagKeys(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.TagKeys failed", zap.Error(err))
+		return err
+	}
+	return sendStringValues(stream, keys)
+}
+
+func (r *rpcService) TagValues(req *TagValuesRequest, stream Storage_TagValuesServer) error {
+	r.logMetadataRequest("tag_values", req.Database, req.TimestampRange, req.Predicate, req.Limit, zap.String("tag_key", req.TagKey))
+
+	values, err := r.Store.TagValues(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.TagValues failed", zap.Error(err))
+		return err
+	}
+	return sendStringValues(stream, values)
+}
+
+func (r *rpcService) MeasurementNames(req *MetadataRequest, stream Storage_MeasurementNamesServer) error {
+	r.logMetadataRequest("measurement_names", req.Database, req.TimestampRange, req.Predicate, req.Limit)
+
+	names, err := r.Store.MeasurementNames(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.MeasurementNames failed", zap.Error(err))
+		return err
+	}
+	return sendStringValues(stream, names)
+}
+
+func (r *rpcService) MeasurementFields(req *MetadataRequest, stream Storage_MeasurementFieldsServer) error {
+	r.logMetadataRequest("measurement_fields", req.Database, req.TimestampRange, req.Predicate, req.Limit)
+
+	measurements, err := r.Store.MeasurementFields(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.MeasurementFields failed", zap.Error(err))
+		return err
+	}
+
+	for _, m := range measurements {
+		fields := m.Fields
+		for len(fields) > 0 {
+			n := len(fields)
+			if n > batchSize {
+				n = batchSize
+			}
+			if err := stream.Send(&MeasurementFieldsResponse{Measurement: m.Measurement, Fields: fields[:n]}); err != nil {
+				return err
+			}
+			fields = fields[n:]
+		}
+	}
+	return nil
+}
+
+func (r *rpcService) Write(stream Storage_WriteServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := stream.Send(r.write(req)); err != nil {
+			return err
+		}
+	}
+}
+
+// write writes the points of the request and returns its acknowledgement.
+func (r *rpcService) write(req *WriteRequest) *WriteResponse {
+	n, err := r.Store.Write(context.Background(), req)
+	if r.loggingEnabled {
+		r.Logger.Info("request",
+			zap.String("method", "write"),
+			zap.String("database", req.Database),
+			zap.Int("points", n),
+			zap.Error(err),
+		)
+	}
+
+	resp := &WriteResponse{PointsWritten: uint64(n)}
+	if err == nil {
+		return resp
+	}
+
+	resp.Error = err.Error()
+	if werr, ok := err.(tsdb.PartialWriteError); ok {
+		dropped := werr.Dropped
+		if dropped > n {
+			dropped = n
+		}
+		resp.PointsWritten, resp.PointsDropped = uint64(n-dropped), uint64(dropped)
+		for _, p := range werr.Rejected {
+			rp := WriteResponse_RejectedPoint{Key: string(p.Key), Reason: p.Reason, Message: p.Message}
+			if rp.Key == "" && p.Point != nil {
+				rp.Key = string(p.Point.Key())
+			}
+			resp.Rejected = append(resp.Rejected, rp)
+		}
+		return resp
+	}
+
+	resp.PointsWritten, resp.PointsDropped = 0, uint64(n)
+	return resp
+}
+
+func (r *rpcService) Explain(ctx context.Context, req *ExplainRequest) (*ExplainResponse, error) {
+	if req.ReadRequest == nil {
+		return nil, errors.New("explain requires a read request")
+	}
+
+	rreq := req.ReadRequest
+	r.logMetadataRequest("explain", rreq.Database, rreq.TimestampRange, rreq.Predicate, rreq.SeriesLimit)
+
+	resp, err := r.Store.Explain(ctx, rreq)
+	if err != nil {
+		r.Logger.Error("Store.Explain failed", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *rpcService) logMetadataRequest(method, database string, tr TimestampRange, pred *Predicate, limit uint64, fields ...zap.Field) {
+	if !r.loggingEnabled {
+		return
+	}
+
+	r.Logger.Info("request", append([]zap.Field{
+		zap.String("method", method),
+		zap.String("database", database),
+		zap.String("predicate", truncateString(PredicateToExprString(pred))),
+		zap.Int64("start", tr.Start),
+		zap.Int64("end", tr.End),
+		zap.Uint64("limit", limit),
+	}, fields...)...)
+}
+
+// sendStringValues streams the values in batches.
+func sendStringValues(stream interface {
+	Send(*StringValuesResponse) error
+}, values []string) error {
+	for len(values) > 0 {
+		n := len(values)
+		if n > batchSize {
+			n = batchSize
+		}
+		if err := stream.Send(&StringValuesResponse{Values: values[:n]}); err != nil {
+			return err
+		}
+		values = values[n:]
+	}
+	return nil
+}
+
 func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 	// TODO(sgc): implement frameWriter that handles the details of streaming frames
 	var err error
@@ -64,10 +224,14 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 	// TODO(sgc): this should be available via a generic API, such as tsdb.Store
 	ctx = tsm1.NewContextWithMetricsGroup(ctx)
 
-	var agg Aggregate_AggregateType
+	aggs := make([]string, 0, len(req.Aggregates)+1)
 	if req.Aggregate != nil {
-		agg = req.Aggregate.Type
+		aggs = append(aggs, req.Aggregate.Type.String())
+	}
+	for _, a := range req.Aggregates {
+		aggs = append(aggs, a.Type.String())
 	}
+	agg := strings.Join(aggs, ",")
 	pred := truncateString(PredicateToExprString(req.Predicate))
 	groupKeys := truncateString(strings.Join(req.Grouping, ","))
 	span.
@@ -79,7 +243,8 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 		SetTag("end", req.TimestampRange.End).
 		SetTag("desc", req.Descending).
 		SetTag("group_keys", groupKeys).
-		SetTag("aggregate", agg.String())
+		SetTag("aggregate", agg).
+		SetTag("window", req.Window)
 
 	if r.loggingEnabled {
 		r.Logger.Info("request",
@@ -92,7 +257,8 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 			zap.Int64("end", req.TimestampRange.End),
 			zap.Bool("desc", req.Descending),
 			zap.String("group_keys", groupKeys),
-			zap.String("aggregate", agg.String()),
+			zap.String("aggregate", agg),
+			zap.Int64("window", req.Window),
 		)
 	}
 
@@ -120,6 +286,9 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 	for rs.Next() {
 		cur := rs.Cursor()
 		if cur == nil {
+			if err := rs.Err(); err != nil {
+				return err
+			}
 			// no data for series key + field combination
 			continue
 		}
@@ -3,6 +3,7 @@ package storage
 import (
 	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/tsdb"
 	"go.uber.org/zap"
@@ -13,14 +14,25 @@ type Service struct {
 	addr           string
 	yarpc          *yarpcServer
 	loggingEnabled bool
+	writeEnabled   bool
+	https          bool
+	cert           string
+	key            string
 	logger         *zap.Logger
 
 	Store      *Store
 	TSDBStore  *tsdb.Store
 	MetaClient interface {
+		Authenticate(username, password string) (meta.User, error)
 		Database(name string) *meta.DatabaseInfo
 		ShardGroupsByTimeRange(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error)
 	}
+	PointsWriter interface {
+		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+	}
+
+	// AuthEnabled requires the metadata and write requests to authenticate.
+	AuthEnabled bool
 }
 
 // NewService returns a new instance of Service.
@@ -28,8 +40,15 @@ func NewService(c Config) *Service {
 	s := &Service{
 		addr:           c.BindAddress,
 		loggingEnabled: c.LogEnabled,
+		writeEnabled:   c.WriteEnabled,
+		https:          c.HTTPSEnabled,
+		cert:           c.HTTPSCertificate,
+		key:            c.HTTPSPrivateKey,
 		logger:         zap.NewNop(),
 	}
+	if s.key == "" {
+		s.key = s.cert
+	}
 
 	return s
 }
@@ -42,15 +61,24 @@ func (s *Service) WithLogger(log *zap.Logger) {
 // Open starts the service.
 func (s *Service) Open() error {
 	s.logger.Info("Starting storage service")
+	if s.AuthEnabled && !s.https {
+		s.logger.Warn("Authentication is enabled without HTTPS, credentials are sent in plain text")
+	}
 
 	store := NewStore()
 	store.TSDBStore = s.TSDBStore
 	store.MetaClient = s.MetaClient
+	store.PointsWriter = s.PointsWriter
+	store.AuthEnabled = s.AuthEnabled
+	store.WriteEnabled = s.writeEnabled
 	store.Logger = s.logger
 
 	yarpc := &yarpcServer{
 		addr:           s.addr,
 		loggingEnabled: s.loggingEnabled,
+		https:          s.https,
+		cert:           s.cert,
+		key:            s.key,
 		logger:         s.logger,
 		store:          store,
 	}
@@ -16,6 +16,14 @@ It has these top-level messages:
 	CapabilitiesResponse
 	HintsResponse
 	TimestampRange
+	MetadataRequest
+	TagValuesRequest
+	StringValuesResponse
+	MeasurementFieldsResponse
+	WriteRequest
+	WriteResponse
+	ExplainRequest
+	ExplainResponse
 	Node
 	Predicate
 */
@@ -55,6 +63,22 @@ type StorageClient interface {
 	// Capabilities returns a map of keys and values identifying the capabilities supported by the storage engine
 	Capabilities(ctx context.Context, in *google_protobuf1.Empty) (*CapabilitiesResponse, error)
 	Hints(ctx context.Context, in *google_protobuf1.Empty) (*HintsResponse, error)
+	// TagKeys returns the distinct tag keys of the series matching the request.
+	TagKeys(ctx context.Context, in *MetadataRequest) (Storage_TagKeysClient, error)
+	// TagValues returns the distinct values of a tag key of the series matching the request.
+	TagValues(ctx context.Context, in *TagValuesRequest) (Storage_TagValuesClient, error)
+	// MeasurementNames returns the names of the measurements of the series matching the request.
+	MeasurementNames(ctx context.Context, in *MetadataRequest) (Storage_MeasurementNamesClient, error)
+	// MeasurementFields returns the field keys and types of the measurements matching the request.
+	MeasurementFields(ctx context.Context, in *MetadataRequest) (Storage_MeasurementFieldsClient, error)
+	// Write writes the points of each WriteRequest and replies to each, in order, with a
+	// single WriteResponse covering all of its frames. Writes must be enabled on the server.
+	//
+	// Write is a bidirectional stream rather than a client stream so that each request is
+	// acknowledged once it is written instead of when the client closes the stream.
+	Write(ctx context.Context) (Storage_WriteClient, error)
+	// Explain describes the costs associated with executing a given Read request
+	Explain(ctx context.Context, in *ExplainRequest) (*ExplainResponse, error)
 }
 
 type storageClient struct {
@@ -112,6 +136,162 @@ func (c *storageClient) Hints(ctx context.Context, in *google_protobuf1.Empty) (
 	return out, nil
 }
 
+func (c *storageClient) TagKeys(ctx context.Context, in *MetadataRequest) (Storage_TagKeysClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[1], c.cc, 0x0003)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageTagKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_TagKeysClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageTagKeysClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageTagKeysClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) TagValues(ctx context.Context, in *TagValuesRequest) (Storage_TagValuesClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[2], c.cc, 0x0004)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageTagValuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_TagValuesClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageTagValuesClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageTagValuesClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) MeasurementNames(ctx context.Context, in *MetadataRequest) (Storage_MeasurementNamesClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[3], c.cc, 0x0005)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageMeasurementNamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_MeasurementNamesClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageMeasurementNamesClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageMeasurementNamesClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) MeasurementFields(ctx context.Context, in *MetadataRequest) (Storage_MeasurementFieldsClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[4], c.cc, 0x0006)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageMeasurementFieldsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_MeasurementFieldsClient interface {
+	Recv() (*MeasurementFieldsResponse, error)
+	yarpc.ClientStream
+}
+
+type storageMeasurementFieldsClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageMeasurementFieldsClient) Recv() (*MeasurementFieldsResponse, error) {
+	m := new(MeasurementFieldsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) Write(ctx context.Context) (Storage_WriteClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[5], c.cc, 0x0007)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageWriteClient{stream}
+	return x, nil
+}
+
+type Storage_WriteClient interface {
+	Send(*WriteRequest) error
+	Recv() (*WriteResponse, error)
+	yarpc.ClientStream
+}
+
+type storageWriteClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageWriteClient) Send(m *WriteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storageWriteClient) Recv() (*WriteResponse, error) {
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) Explain(ctx context.Context, in *ExplainRequest) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := yarpc.Invoke(ctx, 0x0008, in, out, c.cc)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Storage service
 
 type StorageServer interface {
@@ -120,6 +300,22 @@ type StorageServer interface {
 	// Capabilities returns a map of keys and values identifying the capabilities supported by the storage engine
 	Capabilities(context.Context, *google_protobuf1.Empty) (*CapabilitiesResponse, error)
 	Hints(context.Context, *google_protobuf1.Empty) (*HintsResponse, error)
+	// TagKeys returns the distinct tag keys of the series matching the request.
+	TagKeys(*MetadataRequest, Storage_TagKeysServer) error
+	// TagValues returns the distinct values of a tag key of the series matching the request.
+	TagValues(*TagValuesRequest, Storage_TagValuesServer) error
+	// MeasurementNames returns the names of the measurements of the series matching the request.
+	MeasurementNames(*MetadataRequest, Storage_MeasurementNamesServer) error
+	// MeasurementFields returns the field keys and types of the measurements matching the request.
+	MeasurementFields(*MetadataRequest, Storage_MeasurementFieldsServer) error
+	// Write writes the points of each WriteRequest and replies to each, in order, with a
+	// single WriteResponse covering all of its frames. Writes must be enabled on the server.
+	//
+	// Write is a bidirectional stream rather than a client stream so that each request is
+	// acknowledged once it is written instead of when the client closes the stream.
+	Write(Storage_WriteServer) error
+	// Explain describes the costs associated with executing a given Read request
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
 }
 
 func RegisterStorageServer(s *yarpc.Server, srv StorageServer) {
@@ -163,6 +359,124 @@ func _Storage_Hints_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return srv.(StorageServer).Hints(ctx, in)
 }
 
+func _Storage_TagKeys_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(MetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).TagKeys(m, &storageTagKeysServer{stream})
+}
+
+type Storage_TagKeysServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageTagKeysServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageTagKeysServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_TagValues_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(TagValuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).TagValues(m, &storageTagValuesServer{stream})
+}
+
+type Storage_TagValuesServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageTagValuesServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageTagValuesServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_MeasurementNames_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(MetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).MeasurementNames(m, &storageMeasurementNamesServer{stream})
+}
+
+type Storage_MeasurementNamesServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageMeasurementNamesServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageMeasurementNamesServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_MeasurementFields_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(MetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).MeasurementFields(m, &storageMeasurementFieldsServer{stream})
+}
+
+type Storage_MeasurementFieldsServer interface {
+	Send(*MeasurementFieldsResponse) error
+	yarpc.ServerStream
+}
+
+type storageMeasurementFieldsServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageMeasurementFieldsServer) Send(m *MeasurementFieldsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_Write_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	return srv.(StorageServer).Write(&storageWriteServer{stream})
+}
+
+type Storage_WriteServer interface {
+	Send(*WriteResponse) error
+	Recv() (*WriteRequest, error)
+	yarpc.ServerStream
+}
+
+type storageWriteServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageWriteServer) Send(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storageWriteServer) Recv() (*WriteRequest, error) {
+	m := new(WriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Storage_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(StorageServer).Explain(ctx, in)
+}
+
 var _Storage_serviceDesc = yarpc.ServiceDesc{
 	ServiceName: "storage.Storage",
 	Index:       0,
@@ -178,6 +492,11 @@ var _Storage_serviceDesc = yarpc.ServiceDesc{
 			Index:      2,
 			Handler:    _Storage_Hints_Handler,
 		},
+		{
+			MethodName: "Explain",
+			Index:      8,
+			Handler:    _Storage_Explain_Handler,
+		},
 	},
 	Streams: []yarpc.StreamDesc{
 		{
@@ -186,6 +505,37 @@ var _Storage_serviceDesc = yarpc.ServiceDesc{
 			Handler:       _Storage_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TagKeys",
+			Index:         3,
+			Handler:       _Storage_TagKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TagValues",
+			Index:         4,
+			Handler:       _Storage_TagValues_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MeasurementNames",
+			Index:         5,
+			Handler:       _Storage_MeasurementNames_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MeasurementFields",
+			Index:         6,
+			Handler:       _Storage_MeasurementFields_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Write",
+			Index:         7,
+			Handler:       _Storage_Write_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "storage.proto",
 }
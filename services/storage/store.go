@@ -3,13 +3,16 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
 	"go.uber.org/zap"
 )
 
@@ -17,11 +20,23 @@ type Store struct {
 	TSDBStore *tsdb.Store
 
 	MetaClient interface {
+		Authenticate(username, password string) (meta.User, error)
 		Database(name string) *meta.DatabaseInfo
 		ShardGroupsByTimeRange(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error)
 	}
 
+	PointsWriter interface {
+		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+	}
+
 	Logger *zap.Logger
+
+	// AuthEnabled requires the metadata and write requests to authenticate as
+	// a user with access to the database.
+	AuthEnabled bool
+
+	// WriteEnabled allows points to be written.
+	WriteEnabled bool
 }
 
 func NewStore() *Store {
@@ -34,8 +49,351 @@ func (s *Store) WithLogger(log *zap.Logger) {
 }
 
 func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error) {
+	if err := validateAggregates(req); err != nil {
+		return nil, err
+	}
+
+	start, end := timeRange(req.TimestampRange)
+	shardIDs, err := s.shardIDs(req.Database, start, end, req.Descending)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shardIDs) == 0 {
+		return nil, nil
+	}
+
+	var cur seriesCursor
+	if ic, err := newIndexSeriesCursor(ctx, req, s.TSDBStore.Shards(shardIDs)); err != nil {
+		return nil, err
+	} else if ic == nil {
+		return nil, nil
+	} else {
+		cur = ic
+	}
+
+	if len(req.Grouping) > 0 {
+		cur = newGroupSeriesCursor(ctx, cur, req.Grouping)
+	}
+
+	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
+		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+	}
+
+	rr := readRequest{
+		ctx:           ctx,
+		start:         start,
+		end:           end,
+		asc:           !req.Descending,
+		limit:         req.PointsLimit,
+		aggregates:    requestAggregates(req),
+		window:        req.Window,
+		tagAggregates: len(req.Aggregates) > 0,
+	}
+
+	return &ResultSet{req: rr, cur: cur}, nil
+}
+
+// Explain returns the shards and series the read request would read and an
+// estimate of the TSM files, blocks and cached values read for them. The
+// series are read from the index, and the estimate is computed from the time
+// and key ranges of the TSM files without reading their indexes. Each
+// aggregate of the request reads the series again.
+func (s *Store) Explain(ctx context.Context, req *ReadRequest) (*ExplainResponse, error) {
+	if err := validateAggregates(req); err != nil {
+		return nil, err
+	}
+
+	start, end := timeRange(req.TimestampRange)
+	shardIDs, err := s.shardIDs(req.Database, start, end, req.Descending)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ExplainResponse{}
+	if len(shardIDs) == 0 {
+		return resp, nil
+	}
+
+	shards := s.TSDBStore.Shards(shardIDs)
+	for _, sh := range shards {
+		resp.ShardIDs = append(resp.ShardIDs, sh.ID())
+	}
+
+	var cur seriesCursor
+	if ic, err := newIndexSeriesCursor(ctx, req, shards); err != nil {
+		return nil, err
+	} else if ic == nil {
+		return resp, nil
+	} else {
+		cur = ic
+	}
+
+	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
+		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+	}
+	defer cur.Close()
+
+	var cost query.IteratorCost
+	for row := cur.Next(); row != nil; row = cur.Next() {
+		resp.NumSeries++
+		for _, sh := range row.shards {
+			c, err := sh.EstimateSeriesCost(row.key, row.field, start, end)
+			if err != nil {
+				return nil, err
+			}
+			cost = cost.Combine(c)
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	reads := int64(1)
+	if n := len(requestAggregates(req)); n > 1 {
+		reads = int64(n)
+	}
+	resp.NumFiles = cost.NumFiles * reads
+	resp.BlocksRead = cost.BlocksRead * reads
+	resp.BlockSize = cost.BlockSize * reads
+	resp.CachedValues = cost.CachedValues * reads
+	return resp, nil
+}
+
+// Write writes the points of the request and returns the number of points in
+// the request. The database may specify a retention policy as "database/rp".
+func (s *Store) Write(ctx context.Context, req *WriteRequest) (int, error) {
+	if !s.WriteEnabled || s.PointsWriter == nil {
+		return 0, errors.New("writes are not enabled")
+	}
+
 	database, rp := req.Database, ""
+	if p := strings.IndexByte(database, '/'); p > -1 {
+		database, rp = database[:p], database[p+1:]
+	}
+
+	if _, err := s.authorize(database, req.Username, req.Password, influxql.WritePrivilege); err != nil {
+		return 0, err
+	}
+
+	points, err := pointsFromFrames(req.Frames)
+	if err != nil {
+		return 0, err
+	} else if len(points) == 0 {
+		return 0, nil
+	}
+
+	return len(points), s.PointsWriter.WritePointsPrivileged(database, rp, models.ConsistencyLevelOne, points)
+}
+
+// TagKeys returns the sorted, distinct tag keys of the series matching the
+// request.
+func (s *Store) TagKeys(ctx context.Context, req *MetadataRequest) ([]string, error) {
+	auth, err := s.authorize(req.Database, req.Username, req.Password, influxql.ReadPrivilege)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := timeRange(req.TimestampRange)
+	shardIDs, err := s.shardIDs(req.Database, start, end, false)
+	if err != nil || len(shardIDs) == 0 {
+		return nil, err
+	}
+
+	cond, err := metadataCondition(req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+	cond = timeCondition(cond, req.TimestampRange)
+
+	tagKeys, err := s.TSDBStore.TagKeys(auth, shardIDs, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{})
+	for _, m := range tagKeys {
+		for _, key := range m.Keys {
+			set[key] = struct{}{}
+		}
+	}
+	return limitStrings(sortedStrings(set), req.Limit), nil
+}
+
+// TagValues returns the sorted, distinct values of the tag key of the series
+// matching the request.
+func (s *Store) TagValues(ctx context.Context, req *TagValuesRequest) ([]string, error) {
+	if req.TagKey == "" {
+		return nil, errors.New("tag key required")
+	}
+
+	auth, err := s.authorize(req.Database, req.Username, req.Password, influxql.ReadPrivilege)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := timeRange(req.TimestampRange)
+	shardIDs, err := s.shardIDs(req.Database, start, end, false)
+	if err != nil || len(shardIDs) == 0 {
+		return nil, err
+	}
+
+	cond, err := metadataCondition(req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyCond influxql.Expr = &influxql.BinaryExpr{
+		Op:  influxql.EQ,
+		LHS: &influxql.VarRef{Val: "_tagKey"},
+		RHS: &influxql.StringLiteral{Val: req.TagKey},
+	}
+	if cond != nil {
+		keyCond = &influxql.BinaryExpr{Op: influxql.AND, LHS: keyCond, RHS: &influxql.ParenExpr{Expr: cond}}
+	}
+	keyCond = timeCondition(keyCond, req.TimestampRange)
+
+	tagValues, err := s.TSDBStore.TagValues(auth, shardIDs, keyCond)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[string]struct{})
+	for _, m := range tagValues {
+		for _, kv := range m.Values {
+			set[kv.Value] = struct{}{}
+		}
+	}
+	return limitStrings(sortedStrings(set), req.Limit), nil
+}
+
+// MeasurementNames returns the sorted names of the measurements of the series
+// matching the request.
+func (s *Store) MeasurementNames(ctx context.Context, req *MetadataRequest) ([]string, error) {
+	auth, err := s.authorize(req.Database, req.Username, req.Password, influxql.ReadPrivilege)
+	if err != nil {
+		return nil, err
+	}
+
+	shards, cond, err := s.metadataShards(req)
+	if err != nil || len(shards) == 0 {
+		return nil, err
+	}
+
+	names, err := tsdb.Shards(shards).MeasurementNamesByExpr(auth, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([]string, 0, len(names))
+	for _, name := range names {
+		a = append(a, string(name))
+	}
+	return limitStrings(a, req.Limit), nil
+}
 
+// MeasurementFields returns the field keys and types of each measurement
+// matching the request. Measurements are sorted by name and their fields by
+// key. A field with different types in different shards is returned with the
+// type a query would select. The limit applies to the number of fields.
+func (s *Store) MeasurementFields(ctx context.Context, req *MetadataRequest) ([]MeasurementFieldsResponse, error) {
+	auth, err := s.authorize(req.Database, req.Username, req.Password, influxql.ReadPrivilege)
+	if err != nil {
+		return nil, err
+	}
+
+	shards, cond, err := s.metadataShards(req)
+	if err != nil || len(shards) == 0 {
+		return nil, err
+	}
+
+	sg := tsdb.Shards(shards)
+	names, err := sg.MeasurementNamesByExpr(auth, cond)
+	if err != nil || len(names) == 0 {
+		return nil, err
+	}
+
+	var a []MeasurementFieldsResponse
+	n := uint64(0)
+	for _, name := range names {
+		fields, _, err := sg.FieldDimensions([]string{string(name)})
+		if err != nil {
+			return nil, err
+		}
+
+		resp := MeasurementFieldsResponse{Measurement: string(name)}
+		for key, typ := range fields {
+			var dt ReadResponse_DataType
+			switch typ {
+			case influxql.Float:
+				dt = DataTypeFloat
+			case influxql.Integer:
+				dt = DataTypeInteger
+			case influxql.Unsigned:
+				dt = DataTypeUnsigned
+			case influxql.Boolean:
+				dt = DataTypeBoolean
+			case influxql.String:
+				dt = DataTypeString
+			default:
+				continue
+			}
+			resp.Fields = append(resp.Fields, MeasurementFieldsResponse_Field{Key: key, Type: dt})
+		}
+		if len(resp.Fields) == 0 {
+			continue
+		}
+		sort.Slice(resp.Fields, func(i, j int) bool { return resp.Fields[i].Key < resp.Fields[j].Key })
+
+		if req.Limit > 0 && n+uint64(len(resp.Fields)) >= req.Limit {
+			resp.Fields = resp.Fields[:req.Limit-n]
+			return append(a, resp), nil
+		}
+		n += uint64(len(resp.Fields))
+		a = append(a, resp)
+	}
+	return a, nil
+}
+
+// authorize authenticates the user of a request and returns an error if the
+// user does not have the privilege on the database. Returns
+// query.OpenAuthorizer if authentication is disabled.
+func (s *Store) authorize(database, username, password string, p influxql.Privilege) (query.Authorizer, error) {
+	if !s.AuthEnabled {
+		return query.OpenAuthorizer, nil
+	} else if username == "" {
+		return nil, errors.New("username required")
+	}
+
+	user, err := s.MetaClient.Authenticate(username, password)
+	if err != nil {
+		return nil, err
+	} else if !user.AuthorizeDatabase(p, database) {
+		return nil, fmt.Errorf("%s not authorized for %s on database %s", username, p, database)
+	}
+	return user, nil
+}
+
+// metadataShards returns the shards and index condition of a metadata request.
+func (s *Store) metadataShards(req *MetadataRequest) ([]*tsdb.Shard, influxql.Expr, error) {
+	start, end := timeRange(req.TimestampRange)
+	shardIDs, err := s.shardIDs(req.Database, start, end, false)
+	if err != nil || len(shardIDs) == 0 {
+		return nil, nil, err
+	}
+
+	cond, err := metadataCondition(req.Predicate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.TSDBStore.Shards(shardIDs), timeCondition(cond, req.TimestampRange), nil
+}
+
+// shardIDs returns the IDs of the shards of the database overlapping the time
+// range, ordered by time. The database may specify a retention policy as
+// "database/rp", otherwise the default retention policy is used.
+func (s *Store) shardIDs(database string, start, end int64, desc bool) ([]uint64, error) {
+	rp := ""
 	if p := strings.IndexByte(database, '/'); p > -1 {
 		database, rp = database[:p], database[p+1:]
 	}
@@ -54,15 +412,6 @@ func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error)
 		return nil, errors.New("invalid retention policy")
 	}
 
-	var start, end = models.MinNanoTime, models.MaxNanoTime
-	if req.TimestampRange.Start > 0 {
-		start = req.TimestampRange.Start
-	}
-
-	if req.TimestampRange.End > 0 {
-		end = req.TimestampRange.End
-	}
-
 	groups, err := s.MetaClient.ShardGroupsByTimeRange(database, rp, time.Unix(0, start), time.Unix(0, end))
 	if err != nil {
 		return nil, err
@@ -72,7 +421,7 @@ func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error)
 		return nil, nil
 	}
 
-	if req.Descending {
+	if desc {
 		sort.Sort(sort.Reverse(meta.ShardGroupInfos(groups)))
 	} else {
 		sort.Sort(meta.ShardGroupInfos(groups))
@@ -84,33 +433,129 @@ func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error)
 			shardIDs = append(shardIDs, si.ID)
 		}
 	}
+	return shardIDs, nil
+}
 
-	var cur seriesCursor
-	if ic, err := newIndexSeriesCursor(ctx, req, s.TSDBStore.Shards(shardIDs)); err != nil {
-		return nil, err
-	} else if ic == nil {
+// timeRange returns the bounds of the time range. An unset bound is
+// unbounded.
+func timeRange(tr TimestampRange) (start, end int64) {
+	start, end = models.MinNanoTime, models.MaxNanoTime
+	if tr.Start > 0 {
+		start = tr.Start
+	}
+
+	if tr.End > 0 {
+		end = tr.End
+	}
+	return start, end
+}
+
+// timeCondition returns cond restricted to the set bounds of the time range,
+// so the store only returns the metadata of series with points in the range.
+func timeCondition(cond influxql.Expr, tr TimestampRange) influxql.Expr {
+	var bounds []influxql.Expr
+	if tr.Start > 0 {
+		bounds = append(bounds, &influxql.BinaryExpr{
+			Op:  influxql.GTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.TimeLiteral{Val: time.Unix(0, tr.Start).UTC()},
+		})
+	}
+	if tr.End > 0 {
+		bounds = append(bounds, &influxql.BinaryExpr{
+			Op:  influxql.LTE,
+			LHS: &influxql.VarRef{Val: "time"},
+			RHS: &influxql.TimeLiteral{Val: time.Unix(0, tr.End).UTC()},
+		})
+	}
+
+	for _, b := range bounds {
+		if cond == nil {
+			cond = b
+			continue
+		}
+		cond = &influxql.BinaryExpr{Op: influxql.AND, LHS: cond, RHS: b}
+	}
+	return cond
+}
+
+// metadataCondition returns the index condition of the predicate. Fields are
+// not indexed, so references to field keys and values are removed.
+func metadataCondition(p *Predicate) (influxql.Expr, error) {
+	root := p.GetRoot()
+	if root == nil {
 		return nil, nil
-	} else {
-		cur = ic
 	}
 
-	if len(req.Grouping) > 0 {
-		cur = newGroupSeriesCursor(ctx, cur, req.Grouping)
+	cond, err := NodeToExpr(root)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
-		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+	if cond != nil && HasFieldKeyOrValue(cond) {
+		cond = influxql.Reduce(RewriteExprRemoveFieldKeyAndValue(cond), nil)
+		if isBooleanLiteral(cond) {
+			cond = nil
+		}
+	}
+	return cond, nil
+}
+
+func sortedStrings(set map[string]struct{}) []string {
+	a := make([]string, 0, len(set))
+	for s := range set {
+		a = append(a, s)
+	}
+	sort.Strings(a)
+	return a
+}
+
+// limitStrings returns at most limit values. A limit of 0 returns all values.
+func limitStrings(a []string, limit uint64) []string {
+	if limit > 0 && uint64(len(a)) > limit {
+		return a[:limit]
 	}
+	return a
+}
 
-	return &ResultSet{
-		req: readRequest{
-			ctx:       ctx,
-			start:     start,
-			end:       end,
-			asc:       !req.Descending,
-			limit:     req.PointsLimit,
-			aggregate: req.Aggregate,
-		},
-		cur: cur,
-	}, nil
+// validateAggregates returns an error if the request has an unknown aggregate
+// or an invalid window.
+func validateAggregates(req *ReadRequest) error {
+	aggs := requestAggregates(req)
+	for _, agg := range aggs {
+		if _, ok := Aggregate_AggregateType_name[int32(agg.Type)]; !ok || agg.Type == AggregateTypeNone {
+			return fmt.Errorf("invalid aggregate: %s", agg.Type)
+		}
+	}
+
+	if req.Window < 0 {
+		return errors.New("window must be positive")
+	} else if req.Window > 0 && len(aggs) == 0 {
+		return errors.New("window requires an aggregate")
+	}
+	return nil
+}
+
+// validateAggregateType returns an error if the aggregate does not apply to
+// fields of the type. Only count, first and last apply to strings and
+// booleans.
+func validateAggregateType(agg Aggregate_AggregateType, typ influxql.DataType) error {
+	switch agg {
+	case AggregateTypeCount, AggregateTypeFirst, AggregateTypeLast:
+		return nil
+	case AggregateTypeSum, AggregateTypeMean, AggregateTypeMin, AggregateTypeMax:
+		if typ == influxql.Float || typ == influxql.Integer || typ == influxql.Unsigned {
+			return nil
+		}
+	}
+	return fmt.Errorf("aggregate %s does not apply to %s fields", strings.ToLower(agg.String()), typ)
+}
+
+// requestAggregates returns the aggregates of the request, falling back to the
+// deprecated single aggregate.
+func requestAggregates(req *ReadRequest) []*Aggregate {
+	if len(req.Aggregates) == 0 && req.Aggregate != nil && req.Aggregate.Type != AggregateTypeNone {
+		return []*Aggregate{req.Aggregate}
+	}
+	return req.Aggregates
 }
@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+func TestMetadataCondition(t *testing.T) {
+	pred := &Predicate{Root: &Node{
+		NodeType: NodeTypeLogicalExpression,
+		Value:    &Node_Logical_{Logical: LogicalAnd},
+		Children: []*Node{
+			{
+				NodeType: NodeTypeComparisonExpression,
+				Value:    &Node_Comparison_{Comparison: ComparisonEqual},
+				Children: []*Node{
+					{NodeType: NodeTypeTagRef, Value: &Node_TagRefValue{TagRefValue: "_measurement"}},
+					{NodeType: NodeTypeLiteral, Value: &Node_StringValue{StringValue: "cpu"}},
+				},
+			},
+			{
+				NodeType: NodeTypeComparisonExpression,
+				Value:    &Node_Comparison_{Comparison: ComparisonEqual},
+				Children: []*Node{
+					{NodeType: NodeTypeTagRef, Value: &Node_TagRefValue{TagRefValue: "_field"}},
+					{NodeType: NodeTypeLiteral, Value: &Node_StringValue{StringValue: "usage"}},
+				},
+			},
+		},
+	}}
+
+	cond, err := metadataCondition(pred)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := cond.String(), `_name = 'cpu'`; got != exp {
+		t.Fatalf("unexpected condition: got %s, exp %s", got, exp)
+	}
+
+	if cond, err := metadataCondition(nil); err != nil || cond != nil {
+		t.Fatalf("unexpected condition: %v, %v", cond, err)
+	}
+}
+
+func TestLimitStrings(t *testing.T) {
+	a := []string{"a", "b", "c"}
+	if got := limitStrings(a, 0); len(got) != 3 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+	if got := limitStrings(a, 2); len(got) != 2 || got[1] != "b" {
+		t.Fatalf("unexpected values: %v", got)
+	}
+	if got := limitStrings(a, 5); len(got) != 3 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+}
+
+func TestRequestAggregates(t *testing.T) {
+	legacy := &Aggregate{Type: AggregateTypeSum}
+	if got := requestAggregates(&ReadRequest{Aggregate: legacy}); len(got) != 1 || got[0] != legacy {
+		t.Fatalf("unexpected aggregates: %v", got)
+	}
+
+	aggs := []*Aggregate{{Type: AggregateTypeMin}, {Type: AggregateTypeMax}}
+	if got := requestAggregates(&ReadRequest{Aggregate: legacy, Aggregates: aggs}); len(got) != 2 {
+		t.Fatalf("unexpected aggregates: %v", got)
+	}
+
+	if got := requestAggregates(&ReadRequest{Aggregate: &Aggregate{Type: AggregateTypeNone}}); len(got) != 0 {
+		t.Fatalf("unexpected aggregates: %v", got)
+	}
+}
+
+func TestStore_Authorize(t *testing.T) {
+	s := NewStore()
+	s.MetaClient = &authMetaClient{users: map[string]*meta.UserInfo{
+		"reader": {Name: "reader", Privileges: map[string]influxql.Privilege{"db0": influxql.ReadPrivilege}},
+		"admin":  {Name: "admin", Admin: true},
+	}}
+
+	// Requests are not authenticated if authentication is disabled.
+	if auth, err := s.authorize("db0", "", "", influxql.WritePrivilege); err != nil || auth != query.OpenAuthorizer {
+		t.Fatalf("unexpected authorizer: %v, %v", auth, err)
+	}
+
+	s.AuthEnabled = true
+	for _, tt := range []struct {
+		user string
+		db   string
+		p    influxql.Privilege
+		err  string
+	}{
+		{user: "reader", db: "db0", p: influxql.ReadPrivilege},
+		{user: "admin", db: "db1", p: influxql.WritePrivilege},
+		{user: "reader", db: "db0", p: influxql.WritePrivilege, err: "reader not authorized for WRITE on database db0"},
+		{user: "reader", db: "db1", p: influxql.ReadPrivilege, err: "reader not authorized for READ on database db1"},
+		{user: "nobody", db: "db0", p: influxql.ReadPrivilege, err: "authentication failed"},
+		{user: "", db: "db0", p: influxql.ReadPrivilege, err: "username required"},
+	} {
+		auth, err := s.authorize(tt.db, tt.user, "pw", tt.p)
+		if tt.err == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.user, err)
+			} else if auth.(meta.User).ID() != tt.user {
+				t.Fatalf("%s: unexpected authorizer: %v", tt.user, auth)
+			}
+		} else if err == nil || err.Error() != tt.err {
+			t.Fatalf("%s: unexpected error: %v", tt.user, err)
+		}
+	}
+}
+
+// authMetaClient authenticates the users it holds with any password.
+type authMetaClient struct {
+	users map[string]*meta.UserInfo
+}
+
+func (c *authMetaClient) Authenticate(username, password string) (meta.User, error) {
+	if u, ok := c.users[username]; ok {
+		return u, nil
+	}
+	return nil, meta.ErrAuthenticate
+}
+
+func (c *authMetaClient) Database(name string) *meta.DatabaseInfo { return nil }
+
+func (c *authMetaClient) ShardGroupsByTimeRange(database, policy string, min, max time.Time) ([]meta.ShardGroupInfo, error) {
+	return nil, nil
+}
+
+// Ensure Explain counts the shards and series read and estimates the files,
+// blocks and cached values read for them.
+func TestStore_Explain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "storage-explain-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ts := tsdb.NewStore(filepath.Join(dir, "data"))
+	ts.EngineOptions.Config.WALDir = filepath.Join(dir, "wal")
+	if err := ts.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	// Shard 1 holds a block for each of two series in a TSM file and a value
+	// of the first series in the cache. Shard 2 holds a block of the first
+	// series.
+	for _, sh := range []struct {
+		id     uint64
+		points string
+		cached string
+	}{
+		{id: 1, points: "cpu,host=a v=1 10\ncpu,host=b v=1 10", cached: "cpu,host=a v=2 20"},
+		{id: 2, points: "cpu,host=a v=1 30"},
+	} {
+		points, err := models.ParsePointsString(sh.points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cached, err := models.ParsePointsString(sh.cached)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ts.CreateShard("db0", "rp0", sh.id, true); err != nil {
+			t.Fatal(err)
+		} else if err := ts.WriteToShard(sh.id, points); err != nil {
+			t.Fatal(err)
+		} else if _, err := ts.CreateShardSnapshot(sh.id); err != nil {
+			t.Fatal(err)
+		} else if err := ts.WriteToShard(sh.id, cached); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := NewStore()
+	s.TSDBStore = ts
+	s.MetaClient = &shardMetaClient{shardIDs: []uint64{1, 2}}
+
+	for _, tt := range []struct {
+		name string
+		req  *ReadRequest
+		exp  *ExplainResponse
+	}{
+		{
+			name: "raw",
+			req:  &ReadRequest{Database: "db0"},
+			exp:  &ExplainResponse{ShardIDs: []uint64{1, 2}, NumSeries: 2, NumFiles: 3, BlocksRead: 3, CachedValues: 1},
+		},
+		{
+			name: "aggregates",
+			req:  &ReadRequest{Database: "db0", Aggregates: []*Aggregate{{Type: AggregateTypeCount}, {Type: AggregateTypeSum}}},
+			exp:  &ExplainResponse{ShardIDs: []uint64{1, 2}, NumSeries: 2, NumFiles: 6, BlocksRead: 6, CachedValues: 2},
+		},
+		{
+			name: "series limit",
+			req:  &ReadRequest{Database: "db0", SeriesLimit: 1},
+			exp:  &ExplainResponse{ShardIDs: []uint64{1, 2}, NumSeries: 1, NumFiles: 2, BlocksRead: 2, CachedValues: 1},
+		},
+	} {
+		resp, err := s.Explain(context.Background(), tt.req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+
+		if resp.BlockSize <= 0 {
+			t.Fatalf("%s: unexpected block size: %d", tt.name, resp.BlockSize)
+		}
+		resp.BlockSize = 0
+		if !reflect.DeepEqual(resp, tt.exp) {
+			t.Fatalf("%s: unexpected response:\ngot %+v\nexp %+v", tt.name, resp, tt.exp)
+		}
+	}
+}
+
+// shardMetaClient returns a shard group for each of its shards in the default
+// retention policy of any database.
+type shardMetaClient struct {
+	shardIDs []uint64
+}
+
+func (c *shardMetaClient) Authenticate(username, password string) (meta.User, error) {
+	return nil, meta.ErrAuthenticate
+}
+
+func (c *shardMetaClient) Database(name string) *meta.DatabaseInfo {
+	return &meta.DatabaseInfo{
+		Name:                   name,
+		DefaultRetentionPolicy: "rp0",
+		RetentionPolicies:      []meta.RetentionPolicyInfo{{Name: "rp0"}},
+	}
+}
+
+func (c *shardMetaClient) ShardGroupsByTimeRange(database, policy string, min, max time.Time) ([]meta.ShardGroupInfo, error) {
+	var groups []meta.ShardGroupInfo
+	for i, id := range c.shardIDs {
+		groups = append(groups, meta.ShardGroupInfo{
+			ID:        id,
+			StartTime: time.Unix(0, int64(i)),
+			EndTime:   time.Unix(0, int64(i+1)),
+			Shards:    []meta.ShardInfo{{ID: id}},
+		})
+	}
+	return groups, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// frameDecoder converts the frames of a write request to points.
+type frameDecoder struct {
+	points []models.Point
+
+	// The series of the points frames that follow a series frame.
+	name  string
+	field string
+	tags  models.Tags
+	typ   ReadResponse_DataType
+	ok    bool
+}
+
+// pointsFromFrames returns the points of the frames. Each series frame must
+// have _measurement and _field tags and be followed by points frames of its
+// data type.
+func pointsFromFrames(frames []ReadResponse_Frame) ([]models.Point, error) {
+	var d frameDecoder
+	for i := range frames {
+		var err error
+		switch f := frames[i].Data.(type) {
+		case *ReadResponse_Frame_Series:
+			err = d.series(f.Series)
+		case *ReadResponse_Frame_FloatPoints:
+			vs := f.FloatPoints.Values
+			err = d.values(DataTypeFloat, f.FloatPoints.Timestamps, len(vs), func(j int) interface{} { return vs[j] })
+		case *ReadResponse_Frame_IntegerPoints:
+			vs := f.IntegerPoints.Values
+			err = d.values(DataTypeInteger, f.IntegerPoints.Timestamps, len(vs), func(j int) interface{} { return vs[j] })
+		case *ReadResponse_Frame_UnsignedPoints:
+			vs := f.UnsignedPoints.Values
+			err = d.values(DataTypeUnsigned, f.UnsignedPoints.Timestamps, len(vs), func(j int) interface{} { return vs[j] })
+		case *ReadResponse_Frame_BooleanPoints:
+			vs := f.BooleanPoints.Values
+			err = d.values(DataTypeBoolean, f.BooleanPoints.Timestamps, len(vs), func(j int) interface{} { return vs[j] })
+		case *ReadResponse_Frame_StringPoints:
+			vs := f.StringPoints.Values
+			err = d.values(DataTypeString, f.StringPoints.Timestamps, len(vs), func(j int) interface{} { return vs[j] })
+		default:
+			err = errors.New("empty frame")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("frame %d: %s", i, err)
+		}
+	}
+	return d.points, nil
+}
+
+func (d *frameDecoder) series(f *ReadResponse_SeriesFrame) error {
+	d.name, d.field, d.tags, d.typ, d.ok = "", "", nil, f.DataType, false
+	for _, t := range f.Tags {
+		switch {
+		case bytes.Equal(t.Key, measurementKey):
+			d.name = string(t.Value)
+		case bytes.Equal(t.Key, fieldKey):
+			d.field = string(t.Value)
+		default:
+			d.tags = append(d.tags, models.NewTag(t.Key, t.Value))
+		}
+	}
+
+	if d.name == "" {
+		return errors.New("series has no _measurement tag")
+	} else if d.field == "" {
+		return errors.New("series has no _field tag")
+	}
+	sort.Sort(d.tags)
+	d.ok = true
+	return nil
+}
+
+func (d *frameDecoder) values(typ ReadResponse_DataType, ts []int64, n int, value func(i int) interface{}) error {
+	if !d.ok {
+		return errors.New("points frame does not follow a series frame")
+	} else if typ != d.typ {
+		return fmt.Errorf("%s points frame in %s series", typ, d.typ)
+	} else if len(ts) != n {
+		return fmt.Errorf("points frame has %d timestamps and %d values", len(ts), n)
+	}
+
+	for i, t := range ts {
+		pt, err := models.NewPoint(d.name, d.tags, models.Fields{d.field: value(i)}, time.Unix(0, t))
+		if err != nil {
+			return err
+		}
+		d.points = append(d.points, pt)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+	"go.uber.org/zap"
+)
+
+func TestPointsFromFrames(t *testing.T) {
+	series := func(typ ReadResponse_DataType, tags ...string) ReadResponse_Frame {
+		f := &ReadResponse_SeriesFrame{DataType: typ}
+		for i := 0; i < len(tags); i += 2 {
+			f.Tags = append(f.Tags, Tag{Key: []byte(tags[i]), Value: []byte(tags[i+1])})
+		}
+		return ReadResponse_Frame{Data: &ReadResponse_Frame_Series{Series: f}}
+	}
+
+	frames := []ReadResponse_Frame{
+		series(DataTypeFloat, "_measurement", "cpu", "region", "west", "_field", "usage", "host", "a"),
+		{Data: &ReadResponse_Frame_FloatPoints{FloatPoints: &ReadResponse_FloatPointsFrame{Timestamps: []int64{10, 20}, Values: []float64{1.5, 2}}}},
+		series(DataTypeString, "_measurement", "log", "_field", "msg"),
+		{Data: &ReadResponse_Frame_StringPoints{StringPoints: &ReadResponse_StringPointsFrame{Timestamps: []int64{30}, Values: []string{"ok"}}}},
+	}
+
+	points, err := pointsFromFrames(frames)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []string{
+		"cpu,host=a,region=west usage=1.5 10",
+		"cpu,host=a,region=west usage=2 20",
+		`log msg="ok" 30`,
+	}
+	if len(points) != len(exp) {
+		t.Fatalf("unexpected points: %v", points)
+	}
+	for i, p := range points {
+		if got := p.String(); got != exp[i] {
+			t.Errorf("unexpected point %d: got %s, exp %s", i, got, exp[i])
+		}
+	}
+}
+
+func TestPointsFromFrames_Invalid(t *testing.T) {
+	points := ReadResponse_Frame{Data: &ReadResponse_Frame_IntegerPoints{IntegerPoints: &ReadResponse_IntegerPointsFrame{Timestamps: []int64{10}, Values: []int64{1}}}}
+
+	for _, tt := range []struct {
+		name   string
+		frames []ReadResponse_Frame
+		err    string
+	}{
+		{
+			name:   "no series",
+			frames: []ReadResponse_Frame{points},
+			err:    "frame 0: points frame does not follow a series frame",
+		},
+		{
+			name: "no field",
+			frames: []ReadResponse_Frame{
+				{Data: &ReadResponse_Frame_Series{Series: &ReadResponse_SeriesFrame{Tags: []Tag{{Key: []byte("_measurement"), Value: []byte("cpu")}}}}},
+			},
+			err: "frame 0: series has no _field tag",
+		},
+		{
+			name: "type mismatch",
+			frames: []ReadResponse_Frame{
+				{Data: &ReadResponse_Frame_Series{Series: &ReadResponse_SeriesFrame{
+					DataType: DataTypeFloat,
+					Tags:     []Tag{{Key: []byte("_measurement"), Value: []byte("cpu")}, {Key: []byte("_field"), Value: []byte("v")}},
+				}}},
+				points,
+			},
+			err: "frame 1: INTEGER points frame in FLOAT series",
+		},
+	} {
+		if _, err := pointsFromFrames(tt.frames); err == nil || err.Error() != tt.err {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+	}
+}
+
+func TestStore_Write(t *testing.T) {
+	var written []models.Point
+	s := NewStore()
+	s.MetaClient = &authMetaClient{users: map[string]*meta.UserInfo{
+		"reader": {Name: "reader", Privileges: map[string]influxql.Privilege{"db0": influxql.ReadPrivilege}},
+		"writer": {Name: "writer", Privileges: map[string]influxql.Privilege{"db0": influxql.WritePrivilege}},
+	}}
+	s.PointsWriter = pointsWriterFunc(func(database, rp string, points []models.Point) error {
+		if database != "db0" || rp != "rp0" {
+			t.Fatalf("unexpected destination: %s/%s", database, rp)
+		}
+		written = append(written, points...)
+		return nil
+	})
+
+	req := &WriteRequest{
+		Database: "db0/rp0",
+		Frames: []ReadResponse_Frame{
+			{Data: &ReadResponse_Frame_Series{Series: &ReadResponse_SeriesFrame{
+				DataType: DataTypeFloat,
+				Tags:     []Tag{{Key: []byte("_measurement"), Value: []byte("cpu")}, {Key: []byte("_field"), Value: []byte("v")}},
+			}}},
+			{Data: &ReadResponse_Frame_FloatPoints{FloatPoints: &ReadResponse_FloatPointsFrame{Timestamps: []int64{10}, Values: []float64{1}}}},
+		},
+	}
+
+	// Writes are disabled by default.
+	if _, err := s.Write(context.Background(), req); err == nil || err.Error() != "writes are not enabled" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s.WriteEnabled, s.AuthEnabled = true, true
+	req.Username = "reader"
+	if _, err := s.Write(context.Background(), req); err == nil || err.Error() != "reader not authorized for WRITE on database db0" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req.Username = "writer"
+	if n, err := s.Write(context.Background(), req); err != nil {
+		t.Fatal(err)
+	} else if n != 1 || len(written) != 1 {
+		t.Fatalf("unexpected points written: %d, %v", n, written)
+	}
+}
+
+func TestRPCService_Write(t *testing.T) {
+	series := func(name string) ReadResponse_Frame {
+		return ReadResponse_Frame{Data: &ReadResponse_Frame_Series{Series: &ReadResponse_SeriesFrame{
+			DataType: DataTypeFloat,
+			Tags:     []Tag{{Key: []byte("_measurement"), Value: []byte(name)}, {Key: []byte("_field"), Value: []byte("v")}},
+		}}}
+	}
+	points := ReadResponse_Frame{Data: &ReadResponse_Frame_FloatPoints{FloatPoints: &ReadResponse_FloatPointsFrame{
+		Timestamps: []int64{10, 20},
+		Values:     []float64{1, 2},
+	}}}
+
+	for _, tt := range []struct {
+		name string
+		err  error
+		exp  *WriteResponse
+	}{
+		{
+			name: "Success",
+			exp:  &WriteResponse{PointsWritten: 4},
+		},
+		{
+			name: "PartialWrite",
+			err: tsdb.PartialWriteError{
+				Reason:   "field type conflict",
+				Dropped:  2,
+				Rejected: []tsdb.RejectedPoint{{Key: []byte("mem"), Reason: "conflict", Message: "field type conflict"}},
+			},
+			exp: &WriteResponse{
+				PointsWritten: 2,
+				PointsDropped: 2,
+				Error:         "partial write: field type conflict dropped=2",
+				Rejected:      []WriteResponse_RejectedPoint{{Key: "mem", Reason: "conflict", Message: "field type conflict"}},
+			},
+		},
+		{
+			// A write failing outright doesn't acknowledge any point.
+			name: "Error",
+			err:  errors.New("timeout"),
+			exp:  &WriteResponse{PointsDropped: 4, Error: "timeout"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore()
+			s.WriteEnabled = true
+			s.PointsWriter = pointsWriterFunc(func(database, rp string, points []models.Point) error {
+				return tt.err
+			})
+			r := &rpcService{Store: s, Logger: zap.NewNop()}
+
+			resp := r.write(&WriteRequest{
+				Database: "db0",
+				Frames:   []ReadResponse_Frame{series("cpu"), points, series("mem"), points},
+			})
+			if !reflect.DeepEqual(resp, tt.exp) {
+				t.Fatalf("unexpected response:\n\ngot=%+v\nexp=%+v", resp, tt.exp)
+			}
+		})
+	}
+}
+
+// pointsWriterFunc is a PointsWriter calling a function.
+type pointsWriterFunc func(database, rp string, points []models.Point) error
+
+func (fn pointsWriterFunc) WritePointsPrivileged(database, rp string, _ models.ConsistencyLevel, points []models.Point) error {
+	return fn(database, rp, points)
+}
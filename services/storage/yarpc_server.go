@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"crypto/tls"
 	"net"
 
 	"github.com/influxdata/yarpc"
@@ -10,13 +11,16 @@ import (
 type yarpcServer struct {
 	addr           string
 	loggingEnabled bool
+	https          bool
+	cert           string
+	key            string
 	rpc            *yarpc.Server
 	store          *Store
 	logger         *zap.Logger
 }
 
 func (s *yarpcServer) Open() error {
-	listener, err := net.Listen("tcp", s.addr)
+	listener, err := s.listen()
 	if err != nil {
 		return err
 	}
@@ -28,6 +32,21 @@ func (s *yarpcServer) Open() error {
 	return nil
 }
 
+// listen opens the listener, serving TLS if HTTPS is enabled.
+func (s *yarpcServer) listen() (net.Listener, error) {
+	if !s.https {
+		return net.Listen("tcp", s.addr)
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.cert, s.key)
+	if err != nil {
+		return nil, err
+	}
+	return tls.Listen("tcp", s.addr, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	})
+}
+
 func (s *yarpcServer) Close() error {
 	s.rpc.Stop()
 	return nil
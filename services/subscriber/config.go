@@ -20,6 +20,22 @@ const (
 
 	// DefaultWriteBufferSize is the default write buffer size for a Config.
 	DefaultWriteBufferSize = 1000
+
+	// DefaultQueueMaxSize is the default maximum size of the on-disk queue
+	// of each subscription destination.
+	DefaultQueueMaxSize = 1024 * 1024 * 1024 // 1GB
+
+	// DefaultRetryInterval is the default initial delay before retrying a
+	// write to a subscription destination with an on-disk queue.
+	DefaultRetryInterval = time.Second
+
+	// DefaultRetryMaxInterval is the default maximum delay between retries.
+	DefaultRetryMaxInterval = time.Minute
+
+	// DefaultRetryMaxAttempts is the default number of attempts to deliver a
+	// queued write before it is dropped. Queued writes are retried until
+	// they are delivered or dropped by the queue's size and age limits.
+	DefaultRetryMaxAttempts = 0
 )
 
 // Config represents a configuration of the subscriber service.
@@ -42,6 +58,27 @@ type Config struct {
 
 	// The number of in-flight writes buffered in the write channel.
 	WriteBufferSize int `toml:"write-buffer-size"`
+
+	// The directory of the on-disk queues of subscription destinations. If
+	// set, writes are queued on disk and retried until they are delivered
+	// instead of being dropped when a destination is slow or down.
+	QueueDir string `toml:"queue-dir"`
+
+	// The maximum size of the queue of each destination. The oldest writes
+	// are dropped once it is exceeded. A value of 0 disables the limit.
+	QueueMaxSize toml.Size `toml:"queue-max-size"`
+
+	// The maximum age of a queued write. Older writes are dropped instead of
+	// being delivered. A value of 0 disables the limit.
+	QueueMaxAge toml.Duration `toml:"queue-max-age"`
+
+	// The initial and maximum delay between attempts to deliver a queued write.
+	RetryInterval    toml.Duration `toml:"retry-interval"`
+	RetryMaxInterval toml.Duration `toml:"retry-max-interval"`
+
+	// The maximum number of attempts to deliver a queued write before it is
+	// dropped. A value of 0 disables the limit.
+	RetryMaxAttempts int `toml:"retry-max-attempts"`
 }
 
 // NewConfig returns a new instance of a subscriber config.
@@ -53,6 +90,10 @@ func NewConfig() Config {
 		CaCerts:            "",
 		WriteConcurrency:   DefaultWriteConcurrency,
 		WriteBufferSize:    DefaultWriteBufferSize,
+		QueueMaxSize:       toml.Size(DefaultQueueMaxSize),
+		RetryInterval:      toml.Duration(DefaultRetryInterval),
+		RetryMaxInterval:   toml.Duration(DefaultRetryMaxInterval),
+		RetryMaxAttempts:   DefaultRetryMaxAttempts,
 	}
 }
 
@@ -78,6 +119,20 @@ func (c Config) Validate() error {
 		return errors.New("write-concurrency must be greater than 0")
 	}
 
+	if c.QueueDir != "" {
+		if c.RetryInterval <= 0 {
+			return errors.New("retry-interval must be greater than 0")
+		}
+
+		if c.RetryMaxInterval < c.RetryInterval {
+			return errors.New("retry-max-interval must be at least retry-interval")
+		}
+
+		if c.RetryMaxAttempts < 0 {
+			return errors.New("retry-max-attempts must be non-negative")
+		}
+	}
+
 	return nil
 }
 
@@ -95,9 +150,13 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	}
 
 	return diagnostics.RowFromMap(map[string]interface{}{
-		"enabled":           true,
-		"http-timeout":      c.HTTPTimeout,
-		"write-concurrency": c.WriteConcurrency,
-		"write-buffer-size": c.WriteBufferSize,
+		"enabled":            true,
+		"http-timeout":       c.HTTPTimeout,
+		"write-concurrency":  c.WriteConcurrency,
+		"write-buffer-size":  c.WriteBufferSize,
+		"queue-dir":          c.QueueDir,
+		"queue-max-size":     c.QueueMaxSize,
+		"queue-max-age":      c.QueueMaxAge,
+		"retry-max-attempts": c.RetryMaxAttempts,
 	}), nil
 }
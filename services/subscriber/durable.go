@@ -0,0 +1,307 @@
+package subscriber
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+	"github.com/influxdata/influxdb/models"
+	"go.uber.org/zap"
+)
+
+// Statistics for durable subscription destinations.
+const (
+	statPointsQueued    = "pointsQueued"
+	statPointsDelivered = "pointsDelivered"
+	statPointsDropped   = "pointsDropped"
+	statWriteRetries    = "writeRetries"
+	statQueueBytes      = "queueBytes"
+	statQueueLag        = "queueLagNs"
+)
+
+// errDurableWriterClosed is returned when a durable writer is closed while
+// delivering a write.
+var errDurableWriterClosed = errors.New("durable writer closed")
+
+// permanentError is implemented by errors of writes that fail the same way
+// every time they are retried.
+type permanentError interface {
+	Permanent() bool
+}
+
+// isPermanentError returns true if retrying the write that failed with err
+// cannot succeed.
+func isPermanentError(err error) bool {
+	p, ok := err.(permanentError)
+	return ok && p.Permanent()
+}
+
+// durableWriter is a PointsWriter that stores writes in an on-disk queue and
+// delivers them to a destination in the background. Writes are retried with
+// an exponential backoff until they succeed, so every point is delivered at
+// least once unless the queue's size or age limits or the maximum number of
+// attempts drop it first. Writes that fail with a permanent error are
+// dropped without being retried.
+type durableWriter struct {
+	w     PointsWriter
+	queue *queue
+
+	// Maximum age of a queued write. Older writes are dropped instead of
+	// being delivered. A value of 0 disables the limit.
+	maxAge time.Duration
+
+	// Initial and maximum delay between attempts to deliver a write.
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+
+	// Maximum number of attempts to deliver a write before it is dropped.
+	// A value of 0 disables the limit.
+	maxAttempts int
+
+	// Set while the last attempt to deliver a write failed.
+	failing int32
+
+	logger  *zap.Logger
+	closing chan struct{}
+	wg      sync.WaitGroup
+
+	// Time the write at the front of the queue was queued, in nanoseconds.
+	// Zero if the queue is empty.
+	headTime int64
+
+	stats durableWriterStats
+}
+
+type durableWriterStats struct {
+	PointsQueued    int64
+	PointsDelivered int64
+	PointsDropped   int64
+	WriteRetries    int64
+}
+
+// newDurableWriter returns a durableWriter delivering to w from a queue
+// stored in dir.
+func newDurableWriter(w PointsWriter, dir string, c Config, logger *zap.Logger) (*durableWriter, error) {
+	d := &durableWriter{
+		w:                w,
+		queue:            newQueue(dir),
+		maxAge:           time.Duration(c.QueueMaxAge),
+		retryInterval:    time.Duration(c.RetryInterval),
+		maxRetryInterval: time.Duration(c.RetryMaxInterval),
+		maxAttempts:      c.RetryMaxAttempts,
+		logger:           logger,
+		closing:          make(chan struct{}),
+	}
+	d.queue.MaxSize = int64(c.QueueMaxSize)
+	d.queue.DroppedFn = func(b []byte) {
+		atomic.AddInt64(&d.stats.PointsDropped, int64(queuedWritePointN(b)))
+	}
+	if err := d.queue.Open(); err != nil {
+		return nil, err
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.run()
+	}()
+	return d, nil
+}
+
+// WritePoints adds the points to the queue.
+func (d *durableWriter) WritePoints(p *coordinator.WritePointsRequest) error {
+	if err := d.queue.Append(encodeQueuedWrite(time.Now(), p)); err != nil {
+		return err
+	}
+	atomic.AddInt64(&d.stats.PointsQueued, int64(len(p.Points)))
+	return nil
+}
+
+// Failing returns true if the last attempt to deliver a write failed.
+func (d *durableWriter) Failing() bool {
+	return atomic.LoadInt32(&d.failing) == 1
+}
+
+// Close stops delivering writes. Undelivered writes remain in the queue and
+// are delivered once the writer is opened again.
+func (d *durableWriter) Close() error {
+	close(d.closing)
+	d.wg.Wait()
+	return d.queue.Close()
+}
+
+// run delivers writes from the queue until the writer is closed.
+func (d *durableWriter) run() {
+	for {
+		buf, err := d.queue.Peek()
+		if err == errQueueEmpty {
+			atomic.StoreInt64(&d.headTime, 0)
+			select {
+			case <-d.queue.Notify():
+				continue
+			case <-d.closing:
+				return
+			}
+		} else if err != nil {
+			d.logger.Info(fmt.Sprintf("Failed to read subscription queue: %s", err))
+			select {
+			case <-time.After(d.retryInterval):
+				continue
+			case <-d.closing:
+				return
+			}
+		}
+
+		t, p, err := decodeQueuedWrite(buf)
+		if err != nil {
+			d.logger.Info(fmt.Sprintf("Dropping invalid write in subscription queue: %s", err))
+			d.queue.Advance()
+			continue
+		}
+		atomic.StoreInt64(&d.headTime, t.UnixNano())
+
+		if err := d.deliver(t, p); err == errDurableWriterClosed {
+			return
+		} else if err != nil {
+			d.logger.Info(fmt.Sprintf("Dropping subscription write of %d points: %s", len(p.Points), err))
+			atomic.AddInt64(&d.stats.PointsDropped, int64(len(p.Points)))
+		} else {
+			atomic.AddInt64(&d.stats.PointsDelivered, int64(len(p.Points)))
+		}
+
+		if err := d.queue.Advance(); err != nil {
+			d.logger.Info(fmt.Sprintf("Failed to advance subscription queue: %s", err))
+		}
+	}
+}
+
+// deliver writes the points queued at t to the destination, retrying until
+// it succeeds. Returns the reason the write was given up on if it exceeded
+// the maximum age or number of attempts or failed with a permanent error,
+// and errDurableWriterClosed if the writer was closed first.
+func (d *durableWriter) deliver(t time.Time, p *coordinator.WritePointsRequest) error {
+	interval := d.retryInterval
+	for attempt := 1; ; attempt++ {
+		if d.maxAge > 0 && time.Since(t) > d.maxAge {
+			return errors.New("write exceeded queue-max-age")
+		}
+
+		err := d.w.WritePoints(p)
+		if err == nil {
+			atomic.StoreInt32(&d.failing, 0)
+			return nil
+		}
+		atomic.StoreInt32(&d.failing, 1)
+
+		if isPermanentError(err) {
+			return err
+		} else if d.maxAttempts > 0 && attempt >= d.maxAttempts {
+			return fmt.Errorf("write failed %d times: %s", attempt, err)
+		}
+		atomic.AddInt64(&d.stats.WriteRetries, 1)
+		d.logger.Info(fmt.Sprintf("Subscription write failed, retrying in %s: %s", interval, err))
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-d.closing:
+			timer.Stop()
+			return errDurableWriterClosed
+		}
+
+		if interval *= 2; interval > d.maxRetryInterval {
+			interval = d.maxRetryInterval
+		}
+	}
+}
+
+// addStatistics adds the queue statistics to values.
+func (d *durableWriter) addStatistics(values map[string]interface{}) {
+	var lag int64
+	if t := atomic.LoadInt64(&d.headTime); t > 0 {
+		lag = time.Now().UnixNano() - t
+	}
+
+	values[statPointsQueued] = atomic.LoadInt64(&d.stats.PointsQueued)
+	values[statPointsDelivered] = atomic.LoadInt64(&d.stats.PointsDelivered)
+	values[statPointsDropped] = atomic.LoadInt64(&d.stats.PointsDropped)
+	values[statWriteRetries] = atomic.LoadInt64(&d.stats.WriteRetries)
+	values[statQueueBytes] = d.queue.Size()
+	values[statQueueLag] = lag
+}
+
+// encodeQueuedWrite encodes a write and the time it was queued as a queue
+// entry. The entry starts with the time and the number of points, followed
+// by the database, the retention policy and the points as line protocol.
+func encodeQueuedWrite(t time.Time, p *coordinator.WritePointsRequest) []byte {
+	var buf bytes.Buffer
+	var tmp [binary.MaxVarintLen64]byte
+
+	binary.BigEndian.PutUint64(tmp[:8], uint64(t.UnixNano()))
+	buf.Write(tmp[:8])
+	n := binary.PutUvarint(tmp[:], uint64(len(p.Points)))
+	buf.Write(tmp[:n])
+	for _, s := range []string{p.Database, p.RetentionPolicy} {
+		n := binary.PutUvarint(tmp[:], uint64(len(s)))
+		buf.Write(tmp[:n])
+		buf.WriteString(s)
+	}
+	for _, pt := range p.Points {
+		buf.WriteString(pt.String())
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// decodeQueuedWrite decodes a queue entry created by encodeQueuedWrite.
+func decodeQueuedWrite(b []byte) (time.Time, *coordinator.WritePointsRequest, error) {
+	if len(b) < 8 {
+		return time.Time{}, nil, errors.New("queued write too short")
+	}
+	t := time.Unix(0, int64(binary.BigEndian.Uint64(b[:8])))
+	b = b[8:]
+
+	// The number of points is only read when counting dropped entries.
+	_, sz := binary.Uvarint(b)
+	if sz <= 0 {
+		return time.Time{}, nil, errors.New("queued write too short")
+	}
+	b = b[sz:]
+
+	var names [2]string
+	for i := range names {
+		n, sz := binary.Uvarint(b)
+		if sz <= 0 || uint64(len(b)-sz) < n {
+			return time.Time{}, nil, errors.New("queued write too short")
+		}
+		names[i], b = string(b[sz:sz+int(n)]), b[sz+int(n):]
+	}
+
+	points, err := models.ParsePoints(b)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return t, &coordinator.WritePointsRequest{
+		Database:        names[0],
+		RetentionPolicy: names[1],
+		Points:          points,
+	}, nil
+}
+
+// queuedWritePointN returns the number of points of a queue entry created by
+// encodeQueuedWrite without decoding the points.
+func queuedWritePointN(b []byte) int {
+	if len(b) < 8 {
+		return 0
+	}
+	n, sz := binary.Uvarint(b[8:])
+	if sz <= 0 {
+		return 0
+	}
+	return int(n)
+}
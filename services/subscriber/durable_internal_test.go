@@ -0,0 +1,175 @@
+package subscriber
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/toml"
+	"go.uber.org/zap"
+)
+
+// pointsWriterFunc is a PointsWriter calling a function.
+type pointsWriterFunc func(p *coordinator.WritePointsRequest) error
+
+func (fn pointsWriterFunc) WritePoints(p *coordinator.WritePointsRequest) error { return fn(p) }
+
+// newTestDurableWriter returns a durable writer delivering to w from a queue
+// in a temporary directory.
+func newTestDurableWriter(t *testing.T, w PointsWriter, c Config) (*durableWriter, func()) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.RetryInterval = toml.Duration(time.Millisecond)
+	c.RetryMaxInterval = toml.Duration(time.Millisecond)
+	d, err := newDurableWriter(w, dir, c, zap.NewNop())
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return d, func() {
+		d.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// newTestWrite returns a write request of n points.
+func newTestWrite(n int) *coordinator.WritePointsRequest {
+	p := &coordinator.WritePointsRequest{Database: "db0", RetentionPolicy: "rp0"}
+	for i := 0; i < n; i++ {
+		p.Points = append(p.Points, models.MustNewPoint("cpu", nil, map[string]interface{}{"value": float64(i)}, time.Unix(0, int64(i))))
+	}
+	return p
+}
+
+// waitForStat waits until the statistic read by fn equals exp.
+func waitForStat(t *testing.T, name string, fn func() int64, exp int64) {
+	t.Helper()
+	for i := 0; fn() != exp; i++ {
+		if i == 1000 {
+			t.Fatalf("unexpected %s: got %d, exp %d", name, fn(), exp)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Ensure writes failing with a permanent error are dropped without being
+// retried.
+func TestDurableWriter_PermanentError(t *testing.T) {
+	var attempts int64
+	w := pointsWriterFunc(func(p *coordinator.WritePointsRequest) error {
+		if atomic.AddInt64(&attempts, 1) == 1 {
+			return kafkaError(10) // MESSAGE_TOO_LARGE
+		}
+		return nil
+	})
+
+	d, cleanup := newTestDurableWriter(t, w, NewConfig())
+	defer cleanup()
+
+	if err := d.WritePoints(newTestWrite(3)); err != nil {
+		t.Fatal(err)
+	} else if err := d.WritePoints(newTestWrite(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForStat(t, "points delivered", func() int64 { return atomic.LoadInt64(&d.stats.PointsDelivered) }, 2)
+	if n := atomic.LoadInt64(&d.stats.PointsDropped); n != 3 {
+		t.Fatalf("unexpected points dropped: %d", n)
+	} else if n := atomic.LoadInt64(&d.stats.WriteRetries); n != 0 {
+		t.Fatalf("unexpected write retries: %d", n)
+	}
+}
+
+// Ensure writes are dropped once they exceed the maximum number of attempts.
+func TestDurableWriter_MaxAttempts(t *testing.T) {
+	w := pointsWriterFunc(func(p *coordinator.WritePointsRequest) error {
+		return errors.New("connection refused")
+	})
+
+	c := NewConfig()
+	c.RetryMaxAttempts = 3
+	d, cleanup := newTestDurableWriter(t, w, c)
+	defer cleanup()
+
+	if err := d.WritePoints(newTestWrite(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForStat(t, "points dropped", func() int64 { return atomic.LoadInt64(&d.stats.PointsDropped) }, 2)
+	if n := atomic.LoadInt64(&d.stats.WriteRetries); n != 2 {
+		t.Fatalf("unexpected write retries: %d", n)
+	} else if !d.Failing() {
+		t.Fatal("expected writer to be failing")
+	}
+}
+
+// Ensure writes in ANY mode are sent to a destination that is not failing
+// instead of being queued for one that is.
+func TestBalanceWriter_ANY_Failover(t *testing.T) {
+	failing, cleanup := newTestDurableWriter(t, pointsWriterFunc(func(p *coordinator.WritePointsRequest) error {
+		return errors.New("connection refused")
+	}), NewConfig())
+	defer cleanup()
+
+	// Mark the destination as failing as a failed delivery would.
+	if err := failing.WritePoints(newTestWrite(1)); err != nil {
+		t.Fatal(err)
+	}
+	waitForStat(t, "failing", func() int64 { return int64(atomic.LoadInt32(&failing.failing)) }, 1)
+
+	var written int64
+	b := &balancewriter{
+		bm: ANY,
+		writers: []PointsWriter{failing, pointsWriterFunc(func(p *coordinator.WritePointsRequest) error {
+			atomic.AddInt64(&written, int64(len(p.Points)))
+			return nil
+		})},
+		stats: make([]writerStats, 2),
+	}
+
+	for i := 0; i < 4; i++ {
+		if err := b.WritePoints(newTestWrite(1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt64(&written); n != 4 {
+		t.Fatalf("unexpected points written: %d", n)
+	} else if n := atomic.LoadInt64(&failing.stats.PointsQueued); n != 1 {
+		t.Fatalf("unexpected points queued: %d", n)
+	}
+}
+
+// Ensure the points of writes dropped because the queue is full are counted.
+func TestDurableWriter_MaxSize(t *testing.T) {
+	w := pointsWriterFunc(func(p *coordinator.WritePointsRequest) error {
+		return errors.New("connection refused")
+	})
+
+	c := NewConfig()
+	c.RetryMaxAttempts = 0
+	d, cleanup := newTestDurableWriter(t, w, c)
+	defer cleanup()
+
+	// Each write fills a segment, so the oldest ones are dropped.
+	d.queue.mu.Lock()
+	d.queue.SegmentSize = 1
+	d.queue.MaxSize = 1
+	d.queue.mu.Unlock()
+	for i := 0; i < 3; i++ {
+		if err := d.WritePoints(newTestWrite(2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt64(&d.stats.PointsDropped); n != 4 {
+		t.Fatalf("unexpected points dropped: %d", n)
+	}
+}
@@ -0,0 +1,645 @@
+package subscriber
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+)
+
+// Kafka API keys and the versions of the requests sent by the Kafka writer.
+const (
+	kafkaProduceKey      = 0
+	kafkaProduceVersion  = 3
+	kafkaMetadataKey     = 3
+	kafkaMetadataVersion = 1
+
+	kafkaDescribeConfigsKey     = 32
+	kafkaDescribeConfigsVersion = 0
+)
+
+const (
+	// kafkaDefaultMaxMessageBytes is the default size limit of a record
+	// batch, used if the topic's max.message.bytes cannot be read.
+	kafkaDefaultMaxMessageBytes = 1000012
+
+	// kafkaRecordBatchOverhead is the size of the header of a record batch.
+	kafkaRecordBatchOverhead = 61
+
+	// kafkaRecordOverhead is the maximum size of the fields of a record
+	// other than its key and value.
+	kafkaRecordOverhead = 23
+)
+
+// kafkaClientID identifies the writer to Kafka brokers.
+const kafkaClientID = "influxdb"
+
+// kafkaBootstrapNode is the connection key of the broker in the destination URL.
+const kafkaBootstrapNode = -1
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// kafkaError is an error code returned by a Kafka broker.
+type kafkaError int16
+
+func (e kafkaError) Error() string {
+	return fmt.Sprintf("kafka error code %d", int16(e))
+}
+
+// Permanent returns true if the broker rejected the messages themselves, so
+// sending them again fails the same way.
+func (e kafkaError) Permanent() bool {
+	switch e {
+	case 2, // CORRUPT_MESSAGE
+		10, // MESSAGE_TOO_LARGE
+		17, // INVALID_TOPIC_EXCEPTION
+		18, // RECORD_LIST_TOO_LARGE
+		87: // INVALID_RECORD
+		return true
+	}
+	return false
+}
+
+// Kafka publishes points as line protocol to a Kafka topic.
+//
+// The destination URL has the form kafka://host:port/topic. The broker in the
+// URL is used to discover the leaders of the topic's partitions. The following
+// query parameters are supported:
+//
+//   - key: "measurement" or "series" publishes one message per measurement or
+//     series, keyed by its name so it is always sent to the same partition.
+//     By default, all points of a write are published in one message and
+//     partitions are chosen round robin.
+//   - acks: the acknowledgements required before a write succeeds. "0" does
+//     not wait for the broker, "1" waits for the partition leader and "all"
+//     waits for all in-sync replicas. Defaults to "1".
+//
+// Messages are split and spread over several produce requests so that no
+// record batch exceeds the topic's max.message.bytes.
+type Kafka struct {
+	mu      sync.Mutex
+	addr    string
+	topic   string
+	key     publishKey
+	acks    int16
+	timeout time.Duration
+
+	// Addresses of the brokers and open connections, by node id.
+	brokers map[int32]string
+	conns   map[int32]*kafkaConn
+
+	// Leader node id of each partition. Nil if the metadata must be
+	// refreshed before the next write.
+	leaders []int32
+
+	// Maximum size of a record batch accepted for the topic.
+	maxMessageBytes int
+
+	// The next partition of unkeyed messages.
+	next int
+}
+
+// NewKafka returns a new Kafka points writer for the destination URL.
+func NewKafka(u url.URL, timeout time.Duration) (*Kafka, error) {
+	topic := strings.Trim(u.Path, "/")
+	if topic == "" {
+		return nil, fmt.Errorf("kafka destination has no topic: %s", u.Host)
+	}
+
+	key, err := parsePublishKey(u.Query().Get("key"))
+	if err != nil {
+		return nil, err
+	}
+
+	var acks int16
+	switch s := u.Query().Get("acks"); s {
+	case "", "1":
+		acks = 1
+	case "0":
+		acks = 0
+	case "all", "-1":
+		acks = -1
+	default:
+		return nil, fmt.Errorf("invalid kafka acks: %s", s)
+	}
+
+	return &Kafka{
+		addr:    u.Host,
+		topic:   topic,
+		key:     key,
+		acks:    acks,
+		timeout: timeout,
+		conns:   make(map[int32]*kafkaConn),
+	}, nil
+}
+
+// WritePoints publishes the points to the topic.
+func (k *Kafka) WritePoints(p *coordinator.WritePointsRequest) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.leaders == nil {
+		if err := k.refreshMetadata(); err != nil {
+			k.closeConns()
+			return err
+		}
+	}
+
+	// Assign the messages to partitions and group the partitions by leader.
+	requests := make(map[int32]map[int32][]*publishBatch)
+	maxSize := k.maxMessageBytes - kafkaRecordBatchOverhead - kafkaRecordOverhead
+	for _, b := range groupPoints(k.key, p.Points, maxSize) {
+		partition := k.partition(b.key)
+		leader := k.leaders[partition]
+		if requests[leader] == nil {
+			requests[leader] = make(map[int32][]*publishBatch)
+		}
+		requests[leader][partition] = append(requests[leader][partition], b)
+	}
+
+	for leader, partitions := range requests {
+		for len(partitions) > 0 {
+			// Send as many messages of each partition as fit in a record
+			// batch and leave the rest for the next request.
+			req, rest := make(map[int32][]*publishBatch), make(map[int32][]*publishBatch)
+			for partition, batches := range partitions {
+				n := k.recordBatchLen(batches)
+				req[partition] = batches[:n]
+				if n < len(batches) {
+					rest[partition] = batches[n:]
+				}
+			}
+
+			if err := k.produce(leader, req); err != nil {
+				// Refresh the metadata in case the leadership changed.
+				k.leaders = nil
+				k.closeConns()
+				return err
+			}
+			partitions = rest
+		}
+	}
+	return nil
+}
+
+// recordBatchLen returns the number of messages at the front of batches that
+// fit in a record batch. At least one message is always returned.
+func (k *Kafka) recordBatchLen(batches []*publishBatch) int {
+	size := kafkaRecordBatchOverhead
+	for i, b := range batches {
+		if size += kafkaRecordOverhead + b.size(); i > 0 && size > k.maxMessageBytes {
+			return i
+		}
+	}
+	return len(batches)
+}
+
+// Close closes the connections to the brokers.
+func (k *Kafka) Close() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.closeConns()
+	return nil
+}
+
+// closeConns closes all broker connections. Must be called under lock.
+func (k *Kafka) closeConns() {
+	for id, c := range k.conns {
+		c.Close()
+		delete(k.conns, id)
+	}
+}
+
+// partition returns the partition of a message with the given key.
+func (k *Kafka) partition(key string) int32 {
+	n := len(k.leaders)
+	if key == "" {
+		k.next = (k.next + 1) % n
+		return int32(k.next)
+	}
+	// Use the same partitioner as the Java client so consumers can rely on
+	// the partition of a key regardless of the producer.
+	return int32(int(murmur2([]byte(key))&0x7fffffff) % n)
+}
+
+// conn returns a connection to the broker with the given node id.
+func (k *Kafka) conn(node int32) (*kafkaConn, error) {
+	if c := k.conns[node]; c != nil {
+		return c, nil
+	}
+
+	addr := k.addr
+	if node != kafkaBootstrapNode {
+		var ok bool
+		if addr, ok = k.brokers[node]; !ok {
+			return nil, fmt.Errorf("kafka broker %d not found", node)
+		}
+	}
+
+	nc, err := net.DialTimeout("tcp", addr, k.timeout)
+	if err != nil {
+		return nil, err
+	}
+	c := &kafkaConn{Conn: nc, r: bufio.NewReader(nc), timeout: k.timeout}
+	k.conns[node] = c
+	return c, nil
+}
+
+// refreshMetadata loads the brokers and partition leaders of the topic.
+func (k *Kafka) refreshMetadata() error {
+	c, err := k.conn(kafkaBootstrapNode)
+	if err != nil {
+		return err
+	}
+
+	var req kafkaEncoder
+	req.int32(1)
+	req.string(k.topic)
+
+	resp, err := c.roundTrip(kafkaMetadataKey, kafkaMetadataVersion, req.Bytes(), true)
+	if err != nil {
+		return err
+	}
+
+	d := kafkaDecoder{b: resp}
+	brokers := make(map[int32]string)
+	for i, n := 0, d.int32(); i < int(n) && d.err == nil; i++ {
+		id, host, port := d.int32(), d.string(), d.int32()
+		d.string() // rack
+		brokers[id] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.int32() // controller id
+
+	var leaders []int32
+	for i, n := 0, d.int32(); i < int(n) && d.err == nil; i++ {
+		code, name := d.int16(), d.string()
+		d.int8() // is internal
+
+		var partitions []int32
+		for j, m := 0, d.int32(); j < int(m) && d.err == nil; j++ {
+			d.int16() // partition error code
+			id, leader := d.int32(), d.int32()
+			d.int32s() // replicas
+			d.int32s() // isr
+
+			for int(id) >= len(partitions) {
+				partitions = append(partitions, -1)
+			}
+			partitions[id] = leader
+		}
+
+		if name != k.topic {
+			continue
+		} else if code != 0 {
+			return kafkaError(code)
+		}
+		leaders = partitions
+	}
+	if d.err != nil {
+		return d.err
+	} else if len(leaders) == 0 {
+		return fmt.Errorf("kafka topic has no partitions: %s", k.topic)
+	}
+
+	maxMessageBytes, err := k.describeMaxMessageBytes(c)
+	if err != nil {
+		return err
+	}
+
+	k.brokers, k.leaders, k.maxMessageBytes = brokers, leaders, maxMessageBytes
+	return nil
+}
+
+// describeMaxMessageBytes returns the max.message.bytes of the topic. The
+// default is returned if the broker does not allow reading the topic's
+// configuration.
+func (k *Kafka) describeMaxMessageBytes(c *kafkaConn) (int, error) {
+	var req kafkaEncoder
+	req.int32(1)
+	req.int8(2) // topic resource
+	req.string(k.topic)
+	req.int32(1)
+	req.string("max.message.bytes")
+
+	resp, err := c.roundTrip(kafkaDescribeConfigsKey, kafkaDescribeConfigsVersion, req.Bytes(), true)
+	if err != nil {
+		return 0, err
+	}
+
+	d := kafkaDecoder{b: resp}
+	d.int32() // throttle time
+	for i, n := 0, d.int32(); i < int(n) && d.err == nil; i++ {
+		code := d.int16()
+		d.string() // error message
+		d.int8()   // resource type
+		d.string() // resource name
+		for j, m := 0, d.int32(); j < int(m) && d.err == nil; j++ {
+			name, value := d.string(), d.string()
+			d.int8() // read only
+			d.int8() // is default
+			d.int8() // is sensitive
+
+			if code != 0 || name != "max.message.bytes" {
+				continue
+			} else if v, err := strconv.Atoi(value); err == nil && v > 0 {
+				return v, nil
+			}
+		}
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return kafkaDefaultMaxMessageBytes, nil
+}
+
+// produce sends the messages to their partitions on the leader.
+func (k *Kafka) produce(leader int32, partitions map[int32][]*publishBatch) error {
+	if leader < 0 {
+		return errors.New("kafka partition has no leader")
+	}
+
+	c, err := k.conn(leader)
+	if err != nil {
+		return err
+	}
+
+	var req kafkaEncoder
+	req.int16(-1) // transactional id
+	req.int16(k.acks)
+	req.int32(int32(k.timeout / time.Millisecond))
+	req.int32(1)
+	req.string(k.topic)
+	req.int32(int32(len(partitions)))
+	now := time.Now()
+	for partition, batches := range partitions {
+		req.int32(partition)
+		req.bytes(encodeKafkaRecordBatch(k.key, batches, now))
+	}
+
+	resp, err := c.roundTrip(kafkaProduceKey, kafkaProduceVersion, req.Bytes(), k.acks != 0)
+	if err != nil || k.acks == 0 {
+		return err
+	}
+
+	d := kafkaDecoder{b: resp}
+	for i, n := 0, d.int32(); i < int(n) && d.err == nil; i++ {
+		d.string() // topic
+		for j, m := 0, d.int32(); j < int(m) && d.err == nil; j++ {
+			d.int32() // partition
+			code := d.int16()
+			d.int64() // base offset
+			d.int64() // log append time
+			if code != 0 && d.err == nil {
+				return kafkaError(code)
+			}
+		}
+	}
+	return d.err
+}
+
+// encodeKafkaRecordBatch encodes the messages in the v2 record batch format.
+// Messages are keyed unless the writer has no publish key.
+func encodeKafkaRecordBatch(k publishKey, batches []*publishBatch, now time.Time) []byte {
+	ts := now.UnixNano() / int64(time.Millisecond)
+
+	// Encode everything covered by the checksum first.
+	var body kafkaEncoder
+	body.int16(0) // attributes
+	body.int32(int32(len(batches) - 1))
+	body.int64(ts)
+	body.int64(ts)
+	body.int64(-1) // producer id
+	body.int16(-1) // producer epoch
+	body.int32(-1) // base sequence
+	body.int32(int32(len(batches)))
+	for i, b := range batches {
+		var rec kafkaEncoder
+		rec.int8(0)   // attributes
+		rec.varint(0) // timestamp delta
+		rec.varint(int64(i))
+		if k == publishKeyNone {
+			rec.varint(-1)
+		} else {
+			rec.varint(int64(len(b.key)))
+			rec.WriteString(b.key)
+		}
+		rec.varint(int64(len(b.lines)))
+		rec.Write(b.lines)
+		rec.varint(0) // headers
+
+		body.varint(int64(rec.Len()))
+		body.Write(rec.Bytes())
+	}
+
+	var batch kafkaEncoder
+	batch.int64(0)                             // base offset
+	batch.int32(int32(4 + 1 + 4 + body.Len())) // batch length
+	batch.int32(-1)                            // partition leader epoch
+	batch.int8(2)                              // magic
+	batch.int32(int32(crc32.Checksum(body.Bytes(), crc32c)))
+	batch.Write(body.Bytes())
+	return batch.Bytes()
+}
+
+// kafkaConn is a connection to a Kafka broker.
+type kafkaConn struct {
+	net.Conn
+	r             *bufio.Reader
+	timeout       time.Duration
+	correlationID int32
+}
+
+// roundTrip sends a request and returns the body of its response. If
+// response is false, the broker does not send a response and nil is returned.
+func (c *kafkaConn) roundTrip(apiKey, version int16, body []byte, response bool) ([]byte, error) {
+	if c.timeout > 0 {
+		c.SetDeadline(time.Now().Add(c.timeout))
+	}
+	c.correlationID++
+
+	var hdr kafkaEncoder
+	hdr.int32(int32(2 + 2 + 4 + 2 + len(kafkaClientID) + len(body)))
+	hdr.int16(apiKey)
+	hdr.int16(version)
+	hdr.int32(c.correlationID)
+	hdr.string(kafkaClientID)
+	hdr.Write(body)
+	if _, err := c.Write(hdr.Bytes()); err != nil || !response {
+		return nil, err
+	}
+
+	var buf [8]byte
+	if _, err := io.ReadFull(c.r, buf[:]); err != nil {
+		return nil, err
+	}
+	size := int32(binary.BigEndian.Uint32(buf[0:4]))
+	if id := int32(binary.BigEndian.Uint32(buf[4:8])); id != c.correlationID {
+		return nil, fmt.Errorf("kafka correlation id mismatch: got %d, exp %d", id, c.correlationID)
+	} else if size < 4 {
+		return nil, errors.New("kafka response too short")
+	}
+
+	resp := make([]byte, size-4)
+	if _, err := io.ReadFull(c.r, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// kafkaEncoder encodes the primitive types of the Kafka protocol.
+type kafkaEncoder struct {
+	bytes.Buffer
+}
+
+func (e *kafkaEncoder) int8(v int8) { e.WriteByte(byte(v)) }
+
+func (e *kafkaEncoder) int16(v int16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], uint16(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) int32(v int32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) int64(v int64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v))
+	e.Write(b[:])
+}
+
+func (e *kafkaEncoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.Write(b[:n])
+}
+
+func (e *kafkaEncoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.WriteString(s)
+}
+
+func (e *kafkaEncoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.Write(b)
+}
+
+// kafkaDecoder decodes the primitive types of the Kafka protocol. After the
+// first error, all reads return zero values and err is set.
+type kafkaDecoder struct {
+	b   []byte
+	err error
+}
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	} else if n < 0 || len(d.b) < n {
+		d.err = errors.New("kafka response too short")
+		return nil
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+// string decodes a nullable string. Null strings are returned as empty.
+func (d *kafkaDecoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+func (d *kafkaDecoder) int32s() []int32 {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	var a []int32
+	for i := 0; i < int(n) && d.err == nil; i++ {
+		a = append(a, d.int32())
+	}
+	return a
+}
+
+// murmur2 is the hash used by the default partitioner of the Java client.
+func murmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+
+	length := len(data)
+	h := uint32(seed) ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
@@ -0,0 +1,395 @@
+package subscriber_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/services/subscriber"
+)
+
+// Ensure points are published to Kafka with one message per measurement and
+// each measurement is always sent to the same partition.
+func TestKafka_WritePoints(t *testing.T) {
+	b := NewKafkaBroker(t, "metrics", 4)
+	b.Open()
+	defer b.Close()
+
+	u, _ := url.Parse("kafka://" + b.Addr() + "/metrics?key=measurement&acks=all")
+	w, err := subscriber.NewKafka(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	points := []models.Point{
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+		models.MustNewPoint("mem", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": 2.0}, time.Unix(0, 10)),
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server02"}), map[string]interface{}{"value": 3.0}, time.Unix(0, 10)),
+	}
+	for i := 0; i < 2; i++ {
+		if err := w.WritePoints(&coordinator.WritePointsRequest{Database: "db0", RetentionPolicy: "rp0", Points: points}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	partitions := make(map[string]int32)
+	for i := 0; i < 4; i++ {
+		var m KafkaMessage
+		select {
+		case m = <-b.Messages:
+		case <-time.After(time.Second):
+			t.Fatal("expected message")
+		}
+
+		switch m.Key {
+		case "cpu":
+			if exp := points[0].String() + "\n" + points[2].String() + "\n"; m.Value != exp {
+				t.Fatalf("unexpected value: %q", m.Value)
+			}
+		case "mem":
+			if exp := points[1].String() + "\n"; m.Value != exp {
+				t.Fatalf("unexpected value: %q", m.Value)
+			}
+		default:
+			t.Fatalf("unexpected key: %q", m.Key)
+		}
+
+		if p, ok := partitions[m.Key]; ok && p != m.Partition {
+			t.Fatalf("key %s sent to partitions %d and %d", m.Key, p, m.Partition)
+		}
+		partitions[m.Key] = m.Partition
+	}
+}
+
+// Ensure errors returned by the broker fail the write.
+func TestKafka_WritePoints_Error(t *testing.T) {
+	b := NewKafkaBroker(t, "metrics", 1)
+	b.ErrorCode = 2 // corrupt message
+	b.Open()
+	defer b.Close()
+
+	u, _ := url.Parse("kafka://" + b.Addr() + "/metrics")
+	w, err := subscriber.NewKafka(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	pt := models.MustNewPoint("cpu", nil, map[string]interface{}{"value": 1.0}, time.Unix(0, 10))
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: []models.Point{pt}}); err == nil || err.Error() != "kafka error code 2" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure messages are split so no record batch exceeds the topic's
+// max.message.bytes.
+func TestKafka_WritePoints_MaxMessageBytes(t *testing.T) {
+	b := NewKafkaBroker(t, "metrics", 1)
+	b.MaxMessageBytes = 256
+	b.Open()
+	defer b.Close()
+
+	u, _ := url.Parse("kafka://" + b.Addr() + "/metrics")
+	w, err := subscriber.NewKafka(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	var points []models.Point
+	var exp string
+	for i := 0; i < 20; i++ {
+		pt := models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": float64(i)}, time.Unix(0, int64(i)))
+		points = append(points, pt)
+		exp += pt.String() + "\n"
+	}
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: points}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The messages hold all points in order.
+	var got string
+	for n := 0; got != exp; n++ {
+		select {
+		case m := <-b.Messages:
+			got += m.Value
+		case <-time.After(time.Second):
+			t.Fatalf("expected message after %d messages", n)
+		}
+	}
+}
+
+// KafkaMessage is a message received by a KafkaBroker.
+type KafkaMessage struct {
+	Partition int32
+	Key       string
+	Value     string
+}
+
+// KafkaBroker is an in-process stand-in for a Kafka broker that is the leader
+// of every partition of a single topic.
+type KafkaBroker struct {
+	t          *testing.T
+	ln         net.Listener
+	topic      string
+	partitions int32
+
+	// ErrorCode is returned for every produced partition if set.
+	ErrorCode int16
+
+	// MaxMessageBytes is the max.message.bytes of the topic. If zero, the
+	// topic's configuration cannot be read.
+	MaxMessageBytes int
+
+	Messages chan KafkaMessage
+}
+
+// NewKafkaBroker returns a new broker listening on a random port. The broker
+// does not accept connections until it is opened.
+func NewKafkaBroker(t *testing.T, topic string, partitions int32) *KafkaBroker {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := &KafkaBroker{
+		t:          t,
+		ln:         ln,
+		topic:      topic,
+		partitions: partitions,
+		Messages:   make(chan KafkaMessage, 100),
+	}
+	return b
+}
+
+// Open starts accepting connections.
+func (b *KafkaBroker) Open() { go b.serve() }
+
+// Addr returns the address of the broker.
+func (b *KafkaBroker) Addr() string { return b.ln.Addr().String() }
+
+// Close stops the broker.
+func (b *KafkaBroker) Close() error { return b.ln.Close() }
+
+func (b *KafkaBroker) serve() {
+	for {
+		conn, err := b.ln.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *KafkaBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		var size int32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return
+		}
+
+		d := &kafkaReader{b: buf}
+		apiKey, _, correlationID := d.int16(), d.int16(), d.int32()
+		d.string() // client id
+
+		var resp *bytes.Buffer
+		switch apiKey {
+		case 0:
+			resp = b.produce(d)
+		case 3:
+			resp = b.metadata()
+		case 32:
+			resp = b.describeConfigs()
+		default:
+			b.t.Errorf("unexpected api key: %d", apiKey)
+			return
+		}
+		if resp == nil {
+			continue
+		}
+
+		var hdr [8]byte
+		binary.BigEndian.PutUint32(hdr[0:4], uint32(4+resp.Len()))
+		binary.BigEndian.PutUint32(hdr[4:8], uint32(correlationID))
+		conn.Write(append(hdr[:], resp.Bytes()...))
+	}
+}
+
+// metadata returns a v1 metadata response.
+func (b *KafkaBroker) metadata() *bytes.Buffer {
+	host, port, _ := net.SplitHostPort(b.Addr())
+	p, _ := strconv.Atoi(port)
+
+	var w bytes.Buffer
+	write := func(v interface{}) { binary.Write(&w, binary.BigEndian, v) }
+	writeString := func(s string) { write(int16(len(s))); w.WriteString(s) }
+
+	write(int32(1)) // brokers
+	write(int32(0))
+	writeString(host)
+	write(int32(p))
+	write(int16(-1)) // rack
+	write(int32(0))  // controller id
+	write(int32(1))  // topics
+	write(int16(0))
+	writeString(b.topic)
+	write(int8(0))
+	write(b.partitions)
+	for i := int32(0); i < b.partitions; i++ {
+		write(int16(0))
+		write(i)
+		write(int32(0)) // leader
+		write(int32(1)) // replicas
+		write(int32(0))
+		write(int32(1)) // isr
+		write(int32(0))
+	}
+	return &w
+}
+
+// describeConfigs returns a v0 describe configs response with the topic's
+// max.message.bytes.
+func (b *KafkaBroker) describeConfigs() *bytes.Buffer {
+	var w bytes.Buffer
+	write := func(v interface{}) { binary.Write(&w, binary.BigEndian, v) }
+	writeString := func(s string) { write(int16(len(s))); w.WriteString(s) }
+
+	write(int32(0)) // throttle time
+	write(int32(1)) // resources
+	if b.MaxMessageBytes == 0 {
+		write(int16(29)) // topic authorization failed
+		writeString("not authorized")
+		write(int8(2))
+		writeString(b.topic)
+		write(int32(0))
+		return &w
+	}
+	write(int16(0))
+	write(int16(-1)) // error message
+	write(int8(2))
+	writeString(b.topic)
+	write(int32(1))
+	writeString("max.message.bytes")
+	writeString(strconv.Itoa(b.MaxMessageBytes))
+	write(int8(0)) // read only
+	write(int8(0)) // is default
+	write(int8(0)) // is sensitive
+	return &w
+}
+
+// produce decodes a v3 produce request and returns its response.
+func (b *KafkaBroker) produce(d *kafkaReader) *bytes.Buffer {
+	d.string() // transactional id
+	acks := d.int16()
+	d.int32() // timeout
+
+	var partitions []int32
+	for i, n := 0, d.int32(); i < int(n); i++ {
+		if topic := d.string(); topic != b.topic {
+			b.t.Errorf("unexpected topic: %s", topic)
+		}
+		for j, m := 0, d.int32(); j < int(m); j++ {
+			partition := d.int32()
+			partitions = append(partitions, partition)
+			size := int(d.int32())
+			if b.MaxMessageBytes > 0 && size > b.MaxMessageBytes {
+				b.t.Errorf("record batch of %d bytes exceeds max.message.bytes", size)
+			}
+			b.records(partition, d.next(size))
+		}
+	}
+	if acks == 0 {
+		return nil
+	}
+
+	var w bytes.Buffer
+	write := func(v interface{}) { binary.Write(&w, binary.BigEndian, v) }
+	write(int32(1))
+	write(int16(len(b.topic)))
+	w.WriteString(b.topic)
+	write(int32(len(partitions)))
+	for _, p := range partitions {
+		write(p)
+		write(b.ErrorCode)
+		write(int64(0))  // base offset
+		write(int64(-1)) // log append time
+	}
+	write(int32(0)) // throttle time
+	return &w
+}
+
+// records decodes a v2 record batch.
+func (b *KafkaBroker) records(partition int32, batch []byte) {
+	d := &kafkaReader{b: batch}
+	d.next(8 + 4 + 4) // base offset, length, leader epoch
+	if magic := d.next(1)[0]; magic != 2 {
+		b.t.Errorf("unexpected magic: %d", magic)
+		return
+	}
+	crc := uint32(d.int32())
+	if exp := crc32.Checksum(d.b, crc32.MakeTable(crc32.Castagnoli)); crc != exp {
+		b.t.Errorf("checksum mismatch: got %x, exp %x", crc, exp)
+		return
+	}
+	d.next(2 + 4 + 8 + 8 + 8 + 2 + 4)
+
+	for i, n := 0, d.int32(); i < int(n); i++ {
+		d.varint() // length
+		d.next(1)  // attributes
+		d.varint() // timestamp delta
+		d.varint() // offset delta
+
+		var key []byte
+		if n := d.varint(); n >= 0 {
+			key = d.next(int(n))
+		}
+		value := d.next(int(d.varint()))
+		d.varint() // headers
+
+		b.Messages <- KafkaMessage{Partition: partition, Key: string(key), Value: string(value)}
+	}
+}
+
+// kafkaReader decodes the primitive types of the Kafka protocol.
+type kafkaReader struct {
+	b []byte
+}
+
+func (d *kafkaReader) next(n int) []byte {
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *kafkaReader) int16() int16 { return int16(binary.BigEndian.Uint16(d.next(2))) }
+func (d *kafkaReader) int32() int32 { return int32(binary.BigEndian.Uint32(d.next(4))) }
+
+func (d *kafkaReader) varint() int64 {
+	v, n := binary.Varint(d.b)
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *kafkaReader) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
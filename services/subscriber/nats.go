@@ -0,0 +1,309 @@
+package subscriber
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+)
+
+// natsClientName identifies the writer to NATS servers.
+const natsClientName = "influxdb"
+
+// errNATSConnClosed is returned when the connection to the server is lost.
+var errNATSConnClosed = errors.New("nats connection closed")
+
+// natsError is an error sent by a NATS server.
+type natsError string
+
+func (e natsError) Error() string {
+	return "nats: " + string(e)
+}
+
+// Permanent returns true if the server rejected the messages themselves, so
+// publishing them again fails the same way.
+func (e natsError) Permanent() bool {
+	return strings.HasPrefix(string(e), "Maximum Payload Violation")
+}
+
+// NATS publishes points as line protocol to a NATS subject.
+//
+// The destination URL has the form nats://[user:password@]host:port/subject.
+// The following query parameters are supported:
+//
+//   - key: "measurement" or "series" publishes one message per measurement or
+//     series to a subject with the key appended as the last token, e.g.
+//     "subject.cpu". By default, all points of a write are published in one
+//     message to the subject.
+//   - acks: "1" waits for the server to process the messages before a write
+//     succeeds and "0" returns once they are sent. Defaults to "1".
+//
+// Messages are split so that no payload exceeds the max_payload announced
+// by the server.
+type NATS struct {
+	mu      sync.Mutex
+	addr    string
+	user    *url.Userinfo
+	subject string
+	key     publishKey
+	confirm bool
+	timeout time.Duration
+	conn    *natsConn
+}
+
+// NewNATS returns a new NATS points writer for the destination URL.
+func NewNATS(u url.URL, timeout time.Duration) (*NATS, error) {
+	subject := strings.Trim(u.Path, "/")
+	if subject == "" {
+		return nil, fmt.Errorf("nats destination has no subject: %s", u.Host)
+	}
+
+	key, err := parsePublishKey(u.Query().Get("key"))
+	if err != nil {
+		return nil, err
+	}
+
+	var confirm bool
+	switch s := u.Query().Get("acks"); s {
+	case "", "1":
+		confirm = true
+	case "0":
+	default:
+		return nil, fmt.Errorf("invalid nats acks: %s", s)
+	}
+
+	return &NATS{
+		addr:    u.Host,
+		user:    u.User,
+		subject: subject,
+		key:     key,
+		confirm: confirm,
+		timeout: timeout,
+	}, nil
+}
+
+// WritePoints publishes the points to the subject.
+func (n *NATS) WritePoints(p *coordinator.WritePointsRequest) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn == nil {
+		c, err := n.connect()
+		if err != nil {
+			return err
+		}
+		n.conn = c
+	}
+
+	if err := n.publish(p); err != nil {
+		n.conn.Close()
+		n.conn = nil
+		return err
+	}
+	return nil
+}
+
+// publish sends the messages of the points and waits for the server to
+// process them if writes are confirmed. Must be called under lock.
+func (n *NATS) publish(p *coordinator.WritePointsRequest) error {
+	c := n.conn
+	if n.timeout > 0 {
+		c.conn.SetWriteDeadline(time.Now().Add(n.timeout))
+	}
+
+	// Discard replies to earlier writes, such as errors sent after an
+	// unconfirmed write, so they are not taken for replies to this one.
+	for len(c.pongs) > 0 {
+		<-c.pongs
+	}
+
+	c.mu.Lock()
+	for _, b := range groupPoints(n.key, p.Points, c.maxPayload) {
+		subject := n.subject
+		if b.key != "" {
+			subject += "." + natsToken(b.key)
+		}
+		fmt.Fprintf(c.w, "PUB %s %d\r\n", subject, len(b.lines))
+		c.w.Write(b.lines)
+		c.w.WriteString("\r\n")
+	}
+	if n.confirm {
+		c.w.WriteString("PING\r\n")
+	}
+	err := c.w.Flush()
+	c.mu.Unlock()
+	if err != nil || !n.confirm {
+		return err
+	}
+
+	var timeout <-chan time.Time
+	if n.timeout > 0 {
+		timer := time.NewTimer(n.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err := <-c.pongs:
+		return err
+	case <-c.done:
+		return c.err
+	case <-timeout:
+		// The reply may still arrive, so the connection must not be used
+		// for another write. It is closed by WritePoints.
+		return errors.New("timeout waiting for nats server")
+	}
+}
+
+// connect opens a connection to the server.
+func (n *NATS) connect() (*natsConn, error) {
+	conn, err := net.DialTimeout("tcp", n.addr, n.timeout)
+	if err != nil {
+		return nil, err
+	}
+	if n.timeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(n.timeout))
+	}
+
+	// The server greets the client with its INFO.
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return nil, err
+	} else if !strings.HasPrefix(line, "INFO ") {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected nats greeting: %s", strings.TrimSpace(line))
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	var info struct {
+		MaxPayload int `json:"max_payload"`
+	}
+	if err := json.Unmarshal([]byte(line[len("INFO "):]), &info); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("invalid nats info: %s", err)
+	}
+
+	opts := struct {
+		Verbose  bool   `json:"verbose"`
+		Pedantic bool   `json:"pedantic"`
+		Name     string `json:"name"`
+		User     string `json:"user,omitempty"`
+		Pass     string `json:"pass,omitempty"`
+	}{Name: natsClientName}
+	if n.user != nil {
+		opts.User = n.user.Username()
+		opts.Pass, _ = n.user.Password()
+	}
+	buf, err := json.Marshal(opts)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	c := &natsConn{
+		conn:       conn,
+		w:          bufio.NewWriter(conn),
+		maxPayload: info.MaxPayload,
+		pongs:      make(chan error, 16),
+		done:       make(chan struct{}),
+	}
+	fmt.Fprintf(c.w, "CONNECT %s\r\n", buf)
+	if err := c.w.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	go c.read(r)
+	return c, nil
+}
+
+// Close closes the connection to the server.
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.conn == nil {
+		return nil
+	}
+	err := n.conn.Close()
+	n.conn = nil
+	return err
+}
+
+// natsToken replaces the characters that are not allowed in a token of a
+// NATS subject.
+func natsToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// natsConn is a connection to a NATS server.
+type natsConn struct {
+	conn net.Conn
+
+	mu sync.Mutex // guards w
+	w  *bufio.Writer
+
+	// Maximum size of a message's payload. Zero if the server did not
+	// announce a limit.
+	maxPayload int
+
+	// Receives nil for each PONG and the errors sent by the server.
+	pongs chan error
+
+	// Closed when the connection is lost. err holds the cause.
+	done chan struct{}
+	err  error
+}
+
+// Close closes the connection.
+func (c *natsConn) Close() error {
+	return c.conn.Close()
+}
+
+// read handles the messages sent by the server until the connection is
+// closed.
+func (c *natsConn) read(r *bufio.Reader) {
+	defer close(c.done)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			c.err = errNATSConnClosed
+			return
+		}
+		line = strings.TrimSpace(line)
+
+		var reply error
+		switch {
+		case line == "PING":
+			c.mu.Lock()
+			c.w.WriteString("PONG\r\n")
+			c.w.Flush()
+			c.mu.Unlock()
+			continue
+		case line == "PONG":
+		case strings.HasPrefix(line, "-ERR"):
+			reply = natsError(strings.Trim(strings.TrimSpace(line[4:]), "'"))
+		default:
+			// Ignore INFO updates and +OK.
+			continue
+		}
+
+		select {
+		case c.pongs <- reply:
+		default:
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package subscriber_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/coordinator"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/services/subscriber"
+)
+
+// Ensure points are published to NATS with one subject per measurement.
+func TestNATS_WritePoints(t *testing.T) {
+	s := NewNATSServer(t)
+	s.Open()
+	defer s.Close()
+
+	u, _ := url.Parse("nats://" + s.Addr() + "/metrics?key=measurement")
+	w, err := subscriber.NewNATS(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	points := []models.Point{
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+		models.MustNewPoint("mem.used", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": 2.0}, time.Unix(0, 10)),
+	}
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: points}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, exp := range []NATSMessage{
+		{Subject: "metrics.cpu", Payload: points[0].String() + "\n"},
+		{Subject: "metrics.mem_used", Payload: points[1].String() + "\n"},
+	} {
+		select {
+		case m := <-s.Messages:
+			if m != exp {
+				t.Fatalf("unexpected message %d: %#v", i, m)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected message")
+		}
+	}
+}
+
+// Ensure errors sent by the server fail confirmed writes.
+func TestNATS_WritePoints_Error(t *testing.T) {
+	s := NewNATSServer(t)
+	s.Err = "Permissions Violation for Publish to metrics"
+	s.Open()
+	defer s.Close()
+
+	u, _ := url.Parse("nats://" + s.Addr() + "/metrics")
+	w, err := subscriber.NewNATS(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	pt := models.MustNewPoint("cpu", nil, map[string]interface{}{"value": 1.0}, time.Unix(0, 10))
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: []models.Point{pt}}); err == nil || err.Error() != "nats: "+s.Err {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// Ensure messages are split so no payload exceeds the server's max_payload.
+func TestNATS_WritePoints_MaxPayload(t *testing.T) {
+	s := NewNATSServer(t)
+	s.MaxPayload = 128
+	s.Open()
+	defer s.Close()
+
+	u, _ := url.Parse("nats://" + s.Addr() + "/metrics")
+	w, err := subscriber.NewNATS(*u, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	var points []models.Point
+	var exp string
+	for i := 0; i < 20; i++ {
+		pt := models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": float64(i)}, time.Unix(0, int64(i)))
+		points = append(points, pt)
+		exp += pt.String() + "\n"
+	}
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: points}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The messages hold all points in order.
+	var got string
+	for n := 0; got != exp; n++ {
+		select {
+		case m := <-s.Messages:
+			got += m.Payload
+		case <-time.After(time.Second):
+			t.Fatalf("expected message after %d messages", n)
+		}
+	}
+}
+
+// Ensure a write timing out closes the connection so its reply cannot be
+// taken for the reply to the next write.
+func TestNATS_WritePoints_Timeout(t *testing.T) {
+	s := NewNATSServer(t)
+	s.SkipPings = 1
+	s.Open()
+	defer s.Close()
+
+	u, _ := url.Parse("nats://" + s.Addr() + "/metrics")
+	w, err := subscriber.NewNATS(*u, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	pt := models.MustNewPoint("cpu", nil, map[string]interface{}{"value": 1.0}, time.Unix(0, 10))
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: []models.Point{pt}}); err == nil || err.Error() != "timeout waiting for nats server" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The next write is sent on a new connection.
+	if err := w.WritePoints(&coordinator.WritePointsRequest{Points: []models.Point{pt}}); err != nil {
+		t.Fatal(err)
+	} else if n := atomic.LoadInt32(&s.Conns); n != 2 {
+		t.Fatalf("unexpected connections: %d", n)
+	}
+}
+
+// NATSMessage is a message received by a NATSServer.
+type NATSMessage struct {
+	Subject string
+	Payload string
+}
+
+// NATSServer is an in-process stand-in for a NATS server.
+type NATSServer struct {
+	t  *testing.T
+	ln net.Listener
+
+	// Err is sent in reply to every published message if set.
+	Err string
+
+	// MaxPayload is announced to clients if set.
+	MaxPayload int
+
+	// SkipPings is the number of PINGs left unanswered.
+	SkipPings int32
+
+	// Conns is the number of connections accepted.
+	Conns int32
+
+	Messages chan NATSMessage
+}
+
+// NewNATSServer returns a new server listening on a random port. The server
+// does not accept connections until it is opened.
+func NewNATSServer(t *testing.T) *NATSServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &NATSServer{
+		t:        t,
+		ln:       ln,
+		Messages: make(chan NATSMessage, 100),
+	}
+}
+
+// Open starts accepting connections.
+func (s *NATSServer) Open() { go s.serve() }
+
+// Addr returns the address of the server.
+func (s *NATSServer) Addr() string { return s.ln.Addr().String() }
+
+// Close stops the server.
+func (s *NATSServer) Close() error { return s.ln.Close() }
+
+func (s *NATSServer) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *NATSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	atomic.AddInt32(&s.Conns, 1)
+	fmt.Fprintf(conn, "INFO {\"server_id\":\"test\",\"max_payload\":%d}\r\n", s.MaxPayload)
+
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+
+		switch args[0] {
+		case "CONNECT":
+		case "PING":
+			if atomic.AddInt32(&s.SkipPings, -1) >= 0 {
+				continue
+			}
+			fmt.Fprintf(conn, "PONG\r\n")
+		case "PUB":
+			var n int
+			fmt.Sscan(args[len(args)-1], &n)
+			payload := make([]byte, n+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+
+			if s.Err != "" {
+				fmt.Fprintf(conn, "-ERR '%s'\r\n", s.Err)
+				continue
+			}
+			if s.MaxPayload > 0 && n > s.MaxPayload {
+				s.t.Errorf("payload of %d bytes exceeds max_payload", n)
+			}
+			s.Messages <- NATSMessage{Subject: args[1], Payload: string(payload[:n])}
+		default:
+			s.t.Errorf("unexpected command: %s", line)
+			return
+		}
+	}
+}
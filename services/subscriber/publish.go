@@ -0,0 +1,74 @@
+package subscriber
+
+import (
+	"fmt"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// publishKey determines how points are grouped into messages by the writers
+// that publish to message brokers.
+type publishKey int
+
+const (
+	// publishKeyNone publishes all points of a write in one message.
+	publishKeyNone publishKey = iota
+
+	// publishKeyMeasurement publishes one message per measurement.
+	publishKeyMeasurement
+
+	// publishKeySeries publishes one message per series.
+	publishKeySeries
+)
+
+// parsePublishKey parses the "key" query parameter of a destination URL.
+func parsePublishKey(s string) (publishKey, error) {
+	switch s {
+	case "", "none":
+		return publishKeyNone, nil
+	case "measurement":
+		return publishKeyMeasurement, nil
+	case "series":
+		return publishKeySeries, nil
+	default:
+		return 0, fmt.Errorf("invalid publish key: %s", s)
+	}
+}
+
+// publishBatch is the line protocol of the points sharing a key.
+type publishBatch struct {
+	key   string
+	lines []byte
+}
+
+// size returns the size of the batch's key and lines.
+func (b *publishBatch) size() int { return len(b.key) + len(b.lines) }
+
+// groupPoints groups the points into batches of line protocol by key. Batches
+// are returned in the order their keys were first added to a batch.
+//
+// A batch is split so its size does not exceed maxSize, unless it holds a
+// single point that is larger. A maxSize of 0 disables the limit.
+func groupPoints(k publishKey, points []models.Point, maxSize int) []*publishBatch {
+	var batches []*publishBatch
+	index := make(map[string]*publishBatch)
+	for _, pt := range points {
+		var key string
+		switch k {
+		case publishKeyMeasurement:
+			key = string(pt.Name())
+		case publishKeySeries:
+			key = string(pt.Key())
+		}
+
+		line := append(pt.AppendString(nil), '\n')
+		b := index[key]
+		if b == nil || (maxSize > 0 && b.size()+len(line) > maxSize) {
+			b = &publishBatch{key: key}
+			index[key] = b
+			batches = append(batches, b)
+		}
+		b.lines = append(b.lines, line...)
+	}
+	return batches
+}
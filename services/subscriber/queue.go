@@ -0,0 +1,515 @@
+package subscriber
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/influxdata/influxdb/pkg/file"
+)
+
+const (
+	// queueSegmentExtension is the file extension of queue segment files.
+	queueSegmentExtension = "seg"
+
+	// queuePositionFileName is the name of the file holding the read
+	// position of the queue.
+	queuePositionFileName = "position"
+
+	// defaultQueueSegmentSize is the size at which a new segment file is
+	// started.
+	defaultQueueSegmentSize = 10 * 1024 * 1024
+
+	// queueEntryHeaderSize is the size of the length and checksum that
+	// precede every entry.
+	queueEntryHeaderSize = 8
+)
+
+var (
+	// errQueueEmpty is returned by Peek when there are no entries to read.
+	errQueueEmpty = errors.New("queue empty")
+
+	// errQueueClosed is returned when using a closed queue.
+	errQueueClosed = errors.New("queue closed")
+)
+
+// queueSegment is a single file of a queue.
+type queueSegment struct {
+	id   uint64
+	path string
+	size int64
+}
+
+// queue is a FIFO of byte slices stored in segment files on disk.
+//
+// Each entry is written as a 4 byte length and a 4 byte CRC32 checksum
+// followed by the entry. Appends are synced to disk before they return.
+// Concurrent appends share a sync so their writers don't wait for one sync
+// after another.
+// Segments are removed once all of their entries have been read. The read
+// position is persisted after every Advance so entries that were read but
+// not advanced are read again after a restart.
+type queue struct {
+	mu   sync.Mutex
+	dir  string
+	open bool
+
+	// Segments ordered from oldest to newest. The first segment is read
+	// from and the last segment is appended to.
+	segments []*queueSegment
+	tail     *os.File
+	head     *os.File
+	offset   int64 // read offset within the head segment
+	next     int64 // offset of the entry after the last peeked entry
+	size     int64 // total size of all segments
+
+	// MaxSize is the total size of the segments at which the oldest
+	// segments are removed, dropping their entries. A value of 0 disables
+	// the limit.
+	MaxSize int64
+
+	// SegmentSize is the size at which a new segment is started.
+	SegmentSize int64
+
+	// DroppedFn is called with each entry dropped because the queue
+	// exceeded MaxSize. It is called under the queue's lock.
+	DroppedFn func(b []byte)
+
+	// Signals that an entry was appended.
+	notify chan struct{}
+
+	// Number of bytes dropped because the queue exceeded MaxSize or an
+	// entry was corrupt.
+	droppedBytes int64
+
+	// Number of entries appended, and the number appended when the last
+	// tail segment was synced before a new segment was started.
+	written uint64
+	rolled  uint64
+
+	// Number of entries synced to disk. Only used under syncMu, which is
+	// held while syncing the tail so other appends can wait on it.
+	syncMu sync.Mutex
+	synced uint64
+}
+
+// newQueue returns a new queue stored in dir.
+func newQueue(dir string) *queue {
+	return &queue{
+		dir:         dir,
+		SegmentSize: defaultQueueSegmentSize,
+		notify:      make(chan struct{}, 1),
+	}
+}
+
+// Open loads the segments in the queue's directory, creating it if needed.
+func (q *queue) Open() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := os.MkdirAll(q.dir, 0777); err != nil {
+		return err
+	}
+
+	names, err := filepath.Glob(filepath.Join(q.dir, "*."+queueSegmentExtension))
+	if err != nil {
+		return err
+	}
+
+	q.segments, q.size = nil, 0
+	for _, name := range names {
+		id, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(name), "."+queueSegmentExtension), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid queue segment name: %s", name)
+		}
+
+		fi, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		q.segments = append(q.segments, &queueSegment{id: id, path: name, size: fi.Size()})
+		q.size += fi.Size()
+	}
+	sort.Slice(q.segments, func(i, j int) bool { return q.segments[i].id < q.segments[j].id })
+
+	if len(q.segments) == 0 {
+		if err := q.newSegment(); err != nil {
+			return err
+		}
+	} else if err := q.openTail(); err != nil {
+		return err
+	}
+
+	if err := q.readPosition(); err != nil {
+		return err
+	} else if err := q.openHead(); err != nil {
+		return err
+	}
+
+	q.open = true
+	return nil
+}
+
+// Close closes the segment files of the queue.
+func (q *queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.open {
+		return nil
+	}
+	q.open = false
+
+	err := q.tail.Close()
+	if e := q.head.Close(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+// Size returns the total size of the queue's segments.
+func (q *queue) Size() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// DroppedBytes returns the number of bytes dropped from the queue.
+func (q *queue) DroppedBytes() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.droppedBytes
+}
+
+// Append adds an entry to the end of the queue.
+func (q *queue) Append(b []byte) error {
+	buf := make([]byte, queueEntryHeaderSize+len(b))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(b)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(b))
+	copy(buf[queueEntryHeaderSize:], b)
+
+	seq, err := q.append(buf)
+	if err != nil {
+		return err
+	}
+	return q.sync(seq)
+}
+
+// append writes an encoded entry to the tail segment and returns its
+// sequence number.
+func (q *queue) append(buf []byte) (uint64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.open {
+		return 0, errQueueClosed
+	}
+
+	tail := q.segments[len(q.segments)-1]
+	if tail.size > 0 && tail.size+int64(len(buf)) > q.SegmentSize {
+		// The entries of the full segment are synced before it is closed.
+		if err := q.tail.Sync(); err != nil {
+			return 0, err
+		} else if err := q.tail.Close(); err != nil {
+			return 0, err
+		} else if err := q.newSegment(); err != nil {
+			return 0, err
+		}
+		q.rolled = q.written
+		tail = q.segments[len(q.segments)-1]
+	}
+
+	n, err := q.tail.Write(buf)
+	tail.size += int64(n)
+	q.size += int64(n)
+	if err != nil {
+		return 0, err
+	}
+	q.written++
+
+	if err := q.enforceMaxSize(); err != nil {
+		return 0, err
+	}
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return q.written, nil
+}
+
+// sync syncs the tail segment unless the entry with sequence number seq was
+// already synced by another append.
+func (q *queue) sync(seq uint64) error {
+	q.syncMu.Lock()
+	defer q.syncMu.Unlock()
+
+	if q.synced >= seq {
+		return nil
+	}
+
+	q.mu.Lock()
+	if !q.open {
+		q.mu.Unlock()
+		return errQueueClosed
+	}
+	f, written, rolled := q.tail, q.written, q.rolled
+	q.mu.Unlock()
+
+	if seq <= rolled {
+		return nil
+	} else if err := f.Sync(); err != nil {
+		// The segment may have been synced and closed by an append
+		// starting a new segment.
+		q.mu.Lock()
+		rolled = q.rolled
+		q.mu.Unlock()
+		if seq <= rolled {
+			return nil
+		}
+		return err
+	}
+	q.synced = written
+	return nil
+}
+
+// Peek returns the entry at the front of the queue without removing it.
+// Returns errQueueEmpty if there are no entries.
+func (q *queue) Peek() ([]byte, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.open {
+		return nil, errQueueClosed
+	}
+
+	for {
+		head := q.segments[0]
+		if q.offset >= head.size {
+			if len(q.segments) == 1 {
+				return nil, errQueueEmpty
+			} else if err := q.removeHead(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		var hdr [queueEntryHeaderSize]byte
+		if _, err := q.head.ReadAt(hdr[:], q.offset); err != nil {
+			return nil, q.skipCorruptSegment(err)
+		}
+
+		n := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		if q.offset+queueEntryHeaderSize+n > head.size {
+			return nil, q.skipCorruptSegment(io.ErrUnexpectedEOF)
+		}
+
+		b := make([]byte, n)
+		if _, err := q.head.ReadAt(b, q.offset+queueEntryHeaderSize); err != nil {
+			return nil, q.skipCorruptSegment(err)
+		} else if crc32.ChecksumIEEE(b) != binary.BigEndian.Uint32(hdr[4:8]) {
+			return nil, q.skipCorruptSegment(errors.New("checksum mismatch"))
+		}
+
+		q.next = q.offset + queueEntryHeaderSize + n
+		return b, nil
+	}
+}
+
+// Advance removes the entry last returned by Peek from the queue.
+func (q *queue) Advance() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.open {
+		return errQueueClosed
+	} else if q.next <= q.offset {
+		return nil
+	}
+
+	q.offset = q.next
+	return q.writePosition()
+}
+
+// Notify returns a channel that receives a value after entries are appended.
+func (q *queue) Notify() <-chan struct{} { return q.notify }
+
+// enforceMaxSize removes the oldest segments until the queue is within its
+// maximum size. The segment being appended to is never removed. Must be
+// called under lock.
+func (q *queue) enforceMaxSize() error {
+	for q.MaxSize > 0 && q.size > q.MaxSize && len(q.segments) > 1 {
+		q.droppedBytes += q.segments[0].size - q.offset
+		if q.DroppedFn != nil {
+			q.readHead(q.DroppedFn)
+		}
+		if err := q.removeHead(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHead calls fn with each unread entry of the head segment. Reading
+// stops at the first corrupt entry. Must be called under lock.
+func (q *queue) readHead(fn func(b []byte)) {
+	head := q.segments[0]
+	for offset := q.offset; offset < head.size; {
+		var hdr [queueEntryHeaderSize]byte
+		if _, err := q.head.ReadAt(hdr[:], offset); err != nil {
+			return
+		}
+
+		n := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		if offset+queueEntryHeaderSize+n > head.size {
+			return
+		}
+
+		b := make([]byte, n)
+		if _, err := q.head.ReadAt(b, offset+queueEntryHeaderSize); err != nil {
+			return
+		} else if crc32.ChecksumIEEE(b) != binary.BigEndian.Uint32(hdr[4:8]) {
+			return
+		}
+		fn(b)
+		offset += queueEntryHeaderSize + n
+	}
+}
+
+// skipCorruptSegment drops the rest of the head segment after a read error.
+// Must be called under lock.
+func (q *queue) skipCorruptSegment(err error) error {
+	head := q.segments[0]
+	q.droppedBytes += head.size - q.offset
+	if len(q.segments) == 1 {
+		// The tail cannot be removed, so skip to its end instead.
+		q.offset, q.next = head.size, head.size
+		if e := q.writePosition(); e != nil {
+			return e
+		}
+	} else if e := q.removeHead(); e != nil {
+		return e
+	}
+	return fmt.Errorf("corrupt queue segment %s: %s", head.path, err)
+}
+
+// removeHead deletes the head segment and starts reading from the next one.
+// Must be called under lock.
+func (q *queue) removeHead() error {
+	head := q.segments[0]
+	if err := q.head.Close(); err != nil {
+		return err
+	} else if err := os.Remove(head.path); err != nil {
+		return err
+	}
+
+	q.size -= head.size
+	q.segments = q.segments[1:]
+	q.offset, q.next = 0, 0
+	if err := q.openHead(); err != nil {
+		return err
+	}
+	return q.writePosition()
+}
+
+// newSegment creates a new segment to append to. Must be called under lock.
+func (q *queue) newSegment() error {
+	var id uint64 = 1
+	if len(q.segments) > 0 {
+		id = q.segments[len(q.segments)-1].id + 1
+	}
+
+	path := filepath.Join(q.dir, fmt.Sprintf("%05d.%s", id, queueSegmentExtension))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	} else if err := file.SyncDir(q.dir); err != nil {
+		f.Close()
+		return err
+	}
+	q.segments = append(q.segments, &queueSegment{id: id, path: path})
+	q.tail = f
+	return nil
+}
+
+// openTail opens the last segment for appending. Must be called under lock.
+func (q *queue) openTail() error {
+	tail := q.segments[len(q.segments)-1]
+	f, err := os.OpenFile(tail.path, os.O_RDWR|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	q.tail = f
+	return nil
+}
+
+// openHead opens the first segment for reading. Must be called under lock.
+func (q *queue) openHead() error {
+	f, err := os.Open(q.segments[0].path)
+	if err != nil {
+		return err
+	}
+	q.head = f
+	return nil
+}
+
+// readPosition loads the persisted read position, discarding segments that
+// were fully read. Must be called under lock.
+func (q *queue) readPosition() error {
+	buf, err := ioutil.ReadFile(filepath.Join(q.dir, queuePositionFileName))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	} else if len(buf) != 16 {
+		return nil
+	}
+
+	id, offset := binary.BigEndian.Uint64(buf[0:8]), int64(binary.BigEndian.Uint64(buf[8:16]))
+	for len(q.segments) > 1 && q.segments[0].id < id {
+		if err := os.Remove(q.segments[0].path); err != nil {
+			return err
+		}
+		q.size -= q.segments[0].size
+		q.segments = q.segments[1:]
+	}
+	if q.segments[0].id == id && offset <= q.segments[0].size {
+		q.offset, q.next = offset, offset
+	}
+	return nil
+}
+
+// writePosition persists the read position. The position is written to a
+// temporary file that replaces the previous one once it is synced, so a
+// crash never leaves a partially written position. Must be called under
+// lock.
+func (q *queue) writePosition() error {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[0:8], q.segments[0].id)
+	binary.BigEndian.PutUint64(buf[8:16], uint64(q.offset))
+
+	path := filepath.Join(q.dir, queuePositionFileName)
+	f, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf[:]); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Close(); err != nil {
+		return err
+	}
+	return file.RenameFile(path+".tmp", path)
+}
@@ -0,0 +1,155 @@
+package subscriber
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+)
+
+// Ensure entries are read back in order and the read position survives a
+// reopen.
+func TestQueue_AppendAdvance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	q := newQueue(dir)
+	q.SegmentSize = 32
+	if err := q.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err := q.Append([]byte(fmt.Sprintf("entry-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if b, err := q.Peek(); err != nil {
+			t.Fatal(err)
+		} else if exp := fmt.Sprintf("entry-%d", i); string(b) != exp {
+			t.Fatalf("unexpected entry: got %q, exp %q", b, exp)
+		} else if err := q.Advance(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Peek without advancing so the entry is read again after reopening.
+	if _, err := q.Peek(); err != nil {
+		t.Fatal(err)
+	} else if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q = newQueue(dir)
+	q.SegmentSize = 32
+	if err := q.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+
+	for i := 2; i < 5; i++ {
+		if b, err := q.Peek(); err != nil {
+			t.Fatal(err)
+		} else if exp := fmt.Sprintf("entry-%d", i); string(b) != exp {
+			t.Fatalf("unexpected entry: got %q, exp %q", b, exp)
+		} else if err := q.Advance(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := q.Peek(); err != errQueueEmpty {
+		t.Fatalf("unexpected error: %v", err)
+	} else if q.Size() != 15 {
+		t.Fatalf("unexpected size: %d", q.Size())
+	}
+}
+
+// Ensure concurrent appends are all queued when they start new segments.
+func TestQueue_ConcurrentAppend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	q := newQueue(dir)
+	q.SegmentSize = 64
+	if err := q.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+
+	const n = 100
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- q.Append([]byte(fmt.Sprintf("entry-%03d", i)))
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for {
+		b, err := q.Peek()
+		if err == errQueueEmpty {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		seen[string(b)] = true
+		if err := q.Advance(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(seen) != n {
+		t.Fatalf("unexpected entry count: %d", len(seen))
+	}
+}
+
+// Ensure the oldest segments are dropped once the queue exceeds its maximum
+// size.
+func TestQueue_MaxSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	q := newQueue(dir)
+	q.SegmentSize = 16
+	q.MaxSize = 32
+	if err := q.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+
+	// Each entry fills a segment.
+	for i := 0; i < 4; i++ {
+		if err := q.Append([]byte(fmt.Sprintf("entry-%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if b, err := q.Peek(); err != nil {
+		t.Fatal(err)
+	} else if string(b) != "entry-2" {
+		t.Fatalf("unexpected entry: %q", b)
+	} else if q.DroppedBytes() != 30 {
+		t.Fatalf("unexpected dropped bytes: %d", q.DroppedBytes())
+	}
+}
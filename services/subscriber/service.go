@@ -3,9 +3,14 @@
 package subscriber // import "github.com/influxdata/influxdb/services/subscriber"
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,7 +18,9 @@ import (
 	"github.com/influxdata/influxdb/coordinator"
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/monitor"
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxql"
 	"go.uber.org/zap"
 )
 
@@ -204,8 +211,17 @@ func (s *Service) createSubscription(se subEntry, mode string, destinations []st
 		}
 		w, err := s.NewPointsWriter(*u)
 		if err != nil {
+			closeWriters(writers)
 			return nil, fmt.Errorf("failed to create writer for destination: %s", dest)
 		}
+		if s.conf.QueueDir != "" {
+			dw, err := newDurableWriter(w, filepath.Join(s.queuePath(se), queueName(*u)), s.conf, s.Logger)
+			if err != nil {
+				closeWriters(writers)
+				return nil, fmt.Errorf("failed to open queue for destination %s: %s", dest, err)
+			}
+			w = dw
+		}
 		writers = append(writers, w)
 		stats = append(stats, writerStats{dest: dest})
 	}
@@ -223,6 +239,23 @@ func (s *Service) createSubscription(se subEntry, mode string, destinations []st
 	}, nil
 }
 
+// queuePath returns the directory of the on-disk queues of a subscription.
+func (s *Service) queuePath(se subEntry) string {
+	return filepath.Join(s.conf.QueueDir, se.db, se.rp, se.name)
+}
+
+// queueName returns the name of the queue directory of a destination. The
+// name is a hash of the destination so credentials in the URL are not
+// written to disk. The password is left out so it can be changed without
+// abandoning the queue.
+func queueName(u url.URL) string {
+	if u.User != nil {
+		u.User = url.User(u.User.Username())
+	}
+	h := sha256.Sum256([]byte(u.String()))
+	return hex.EncodeToString(h[:16])
+}
+
 // Points returns a channel into which write point requests can be sent.
 func (s *Service) Points() chan<- *coordinator.WritePointsRequest {
 	return s.points
@@ -246,6 +279,17 @@ func (s *Service) run() {
 			}
 			for se, cw := range s.subs {
 				if p.Database == se.db && p.RetentionPolicy == se.rp {
+					p := cw.filter(p)
+					if p == nil {
+						continue
+					}
+					// Writes to on-disk queues are only dropped by the queue
+					// limits, so wait for a writer to append them instead
+					// of dropping them while the writers are syncing.
+					if s.conf.QueueDir != "" {
+						cw.writeRequests <- p
+						continue
+					}
 					select {
 					case cw.writeRequests <- p:
 					default:
@@ -290,7 +334,22 @@ func (s *Service) updateSubs(wg *sync.WaitGroup) {
 					name: si.Name,
 				}
 				allEntries[se] = true
-				if _, ok := s.subs[se]; ok {
+				if cw, ok := s.subs[se]; ok {
+					if cw.filterText != si.Filter {
+						cond, err := parseSubscriptionFilter(si.Filter)
+						if err != nil {
+							s.Logger.Info(fmt.Sprintf("Subscription filter update failed for '%s' with error: %s", si.Name, err))
+							continue
+						}
+						cw.filterText, cw.cond = si.Filter, cond
+						s.subs[se] = cw
+					}
+					continue
+				}
+				cond, err := parseSubscriptionFilter(si.Filter)
+				if err != nil {
+					atomic.AddInt64(&s.stats.CreateFailures, 1)
+					s.Logger.Info(fmt.Sprintf("Subscription creation failed for '%s' with error: %s", si.Name, err))
 					continue
 				}
 				sub, err := s.createSubscription(se, si.Mode, si.Destinations)
@@ -300,19 +359,37 @@ func (s *Service) updateSubs(wg *sync.WaitGroup) {
 					continue
 				}
 				cw := chanWriter{
+					filterText:    si.Filter,
+					cond:          cond,
 					writeRequests: make(chan *coordinator.WritePointsRequest, s.conf.WriteBufferSize),
 					pw:            sub,
 					pointsWritten: &s.stats.PointsWritten,
 					failures:      &s.stats.WriteFailures,
 					logger:        s.Logger,
+					done:          make(chan struct{}),
 				}
+				var cwg sync.WaitGroup
 				for i := 0; i < s.conf.WriteConcurrency; i++ {
 					wg.Add(1)
+					cwg.Add(1)
 					go func() {
 						defer wg.Done()
+						defer cwg.Done()
 						cw.Run()
 					}()
 				}
+
+				// Close the subscription's writers once all writes have
+				// been handed to them.
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					cwg.Wait()
+					if c, ok := cw.pw.(io.Closer); ok {
+						c.Close()
+					}
+					close(cw.done)
+				}()
 				s.subs[se] = cw
 				s.Logger.Info(fmt.Sprintf("added new subscription for %s %s", se.db, se.rp))
 			}
@@ -323,7 +400,18 @@ func (s *Service) updateSubs(wg *sync.WaitGroup) {
 	for se := range s.subs {
 		if !allEntries[se] {
 			// Close the chanWriter
-			s.subs[se].Close()
+			cw := s.subs[se]
+			cw.Close()
+
+			// Remove the queues of the deleted subscription once closed.
+			if s.conf.QueueDir != "" {
+				go func(se subEntry) {
+					<-cw.done
+					if err := os.RemoveAll(s.queuePath(se)); err != nil {
+						s.Logger.Info(fmt.Sprintf("failed to remove queue of subscription %s: %s", se.name, err))
+					}
+				}(se)
+			}
 
 			// Remove it from the set
 			delete(s.subs, se)
@@ -344,6 +432,10 @@ func (s *Service) newPointsWriter(u url.URL) (PointsWriter, error) {
 			s.Logger.Info("WARNING: 'insecure-skip-verify' is true. This will skip all certificate verifications.")
 		}
 		return NewHTTPS(u.String(), time.Duration(s.conf.HTTPTimeout), s.conf.InsecureSkipVerify, s.conf.CaCerts)
+	case "kafka":
+		return NewKafka(u, time.Duration(s.conf.HTTPTimeout))
+	case "nats":
+		return NewNATS(u, time.Duration(s.conf.HTTPTimeout))
 	default:
 		return nil, fmt.Errorf("unknown destination scheme %s", u.Scheme)
 	}
@@ -356,6 +448,11 @@ type chanWriter struct {
 	pointsWritten *int64
 	failures      *int64
 	logger        *zap.Logger
+	done          chan struct{} // closed once the writer has stopped
+
+	// Points must match cond to be written. A nil cond matches every point.
+	filterText string
+	cond       influxql.Expr
 }
 
 // Close closes the chanWriter.
@@ -363,6 +460,64 @@ func (c chanWriter) Close() {
 	close(c.writeRequests)
 }
 
+// filter returns the request with only the points that match the writer's
+// filter. Returns nil if no points match.
+func (c chanWriter) filter(p *coordinator.WritePointsRequest) *coordinator.WritePointsRequest {
+	if c.cond == nil {
+		return p
+	}
+
+	var points []models.Point
+	m := make(map[string]interface{})
+	for _, pt := range p.Points {
+		for k := range m {
+			delete(m, k)
+		}
+		for _, t := range pt.Tags() {
+			m[string(t.Key)] = string(t.Value)
+		}
+		m[measurementKey] = string(pt.Name())
+
+		if influxql.EvalBool(c.cond, m) {
+			points = append(points, pt)
+		}
+	}
+
+	if len(points) == 0 {
+		return nil
+	} else if len(points) == len(p.Points) {
+		return p
+	}
+	return &coordinator.WritePointsRequest{
+		Database:        p.Database,
+		RetentionPolicy: p.RetentionPolicy,
+		Points:          points,
+	}
+}
+
+// measurementKey is the key of the measurement name of a point when a filter
+// is evaluated. Tag keys cannot be empty, so a tag named measurement can
+// still be referenced.
+const measurementKey = ""
+
+// parseSubscriptionFilter parses the filter of a subscription. Returns nil
+// if the subscription has no filter.
+func parseSubscriptionFilter(filter string) (influxql.Expr, error) {
+	if filter == "" {
+		return nil, nil
+	}
+	expr, err := influxql.ParseExpr(filter)
+	if err != nil {
+		return nil, err
+	}
+	return influxql.RewriteExpr(expr, func(e influxql.Expr) influxql.Expr {
+		if ref, ok := e.(*influxql.VarRef); ok && query.IsSubscriptionMeasurementRef(ref) {
+			return &influxql.VarRef{Val: measurementKey}
+		}
+		return e
+	}), nil
+}
+
 func (c chanWriter) Run() {
 	for wr := range c.writeRequests {
 		err := c.pw.WritePoints(wr)
@@ -411,12 +566,20 @@ type balancewriter struct {
 
 func (b *balancewriter) WritePoints(p *coordinator.WritePointsRequest) error {
 	var lastErr error
+	var skipped []int
 	for range b.writers {
 		// round robin through destinations.
 		i := b.i
 		w := b.writers[i]
 		b.i = (b.i + 1) % len(b.writers)
 
+		// Queues accept writes even if their destination is down, so in ANY
+		// mode writes fail over to destinations that are being delivered to.
+		if d, ok := w.(*durableWriter); ok && b.bm == ANY && d.Failing() {
+			skipped = append(skipped, i)
+			continue
+		}
+
 		// write points to destination.
 		err := w.WritePoints(p)
 		if err != nil {
@@ -425,13 +588,30 @@ func (b *balancewriter) WritePoints(p *coordinator.WritePointsRequest) error {
 		} else {
 			atomic.AddInt64(&b.stats[i].pointsWritten, int64(len(p.Points)))
 			if b.bm == ANY {
-				break
+				return lastErr
 			}
 		}
 	}
+
+	// Queue the write for the first failing destination if no other
+	// destination accepted it.
+	if len(skipped) > 0 {
+		i := skipped[0]
+		if err := b.writers[i].WritePoints(p); err != nil {
+			atomic.AddInt64(&b.stats[i].failures, 1)
+			return err
+		}
+		atomic.AddInt64(&b.stats[i].pointsWritten, int64(len(p.Points)))
+		return nil
+	}
 	return lastErr
 }
 
+// Close closes the writers that hold resources, such as on-disk queues.
+func (b *balancewriter) Close() error {
+	return closeWriters(b.writers)
+}
+
 // Statistics returns statistics for periodic monitoring.
 func (b *balancewriter) Statistics(tags map[string]string) []models.Statistic {
 	statistics := make([]models.Statistic, len(b.stats))
@@ -446,6 +626,22 @@ func (b *balancewriter) Statistics(tags map[string]string) []models.Statistic {
 				statWriteFailures: atomic.LoadInt64(&b.stats[i].failures),
 			},
 		}
+		if d, ok := b.writers[i].(*durableWriter); ok {
+			d.addStatistics(statistics[i].Values)
+		}
 	}
 	return statistics
 }
+
+// closeWriters closes each writer that implements io.Closer.
+func closeWriters(writers []PointsWriter) error {
+	var err error
+	for _, w := range writers {
+		if c, ok := w.(io.Closer); ok {
+			if e := c.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
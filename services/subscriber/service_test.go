@@ -1,13 +1,18 @@
 package subscriber_test
 
 import (
+	"errors"
+	"io/ioutil"
 	"net/url"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/influxdata/influxdb/coordinator"
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/services/subscriber"
+	"github.com/influxdata/influxdb/toml"
 )
 
 type MetaClient struct {
@@ -441,3 +446,235 @@ func TestService_WaitForDataChanged(t *testing.T) {
 
 	close(dataChanged)
 }
+
+// Ensure only points matching a subscription's filter are written to it.
+func TestService_Filter(t *testing.T) {
+	dataChanged := make(chan struct{})
+	ms := MetaClient{}
+	ms.WaitForDataChangedFn = func() chan struct{} {
+		return dataChanged
+	}
+	ms.DatabasesFn = func() []meta.DatabaseInfo {
+		return []meta.DatabaseInfo{
+			{
+				Name: "db0",
+				RetentionPolicies: []meta.RetentionPolicyInfo{
+					{
+						Name: "rp0",
+						Subscriptions: []meta.SubscriptionInfo{
+							{Name: "s0", Mode: "ANY", Destinations: []string{"udp://h0:9093"}, Filter: `"measurement" =~ /^http_/ AND region = 'eu'`},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	prs := make(chan *coordinator.WritePointsRequest, 2)
+	newPointsWriter := func(u url.URL) (subscriber.PointsWriter, error) {
+		sub := Subscription{}
+		sub.WritePointsFn = func(p *coordinator.WritePointsRequest) error {
+			prs <- p
+			return nil
+		}
+		return sub, nil
+	}
+
+	s := subscriber.NewService(subscriber.NewConfig())
+	s.MetaClient = ms
+	s.NewPointsWriter = newPointsWriter
+	s.Open()
+	defer s.Close()
+
+	points := []models.Point{
+		models.MustNewPoint("http_requests", models.NewTags(map[string]string{"region": "eu"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+		models.MustNewPoint("http_requests", models.NewTags(map[string]string{"region": "us"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"region": "eu"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+		models.MustNewPoint("cpu", models.NewTags(map[string]string{"measurement": "http_requests", "region": "eu"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10)),
+	}
+
+	// Write points where only the first one matches.
+	s.Points() <- &coordinator.WritePointsRequest{
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Points:          points,
+	}
+
+	// Write points that don't match the filter.
+	s.Points() <- &coordinator.WritePointsRequest{
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Points:          points[1:],
+	}
+
+	select {
+	case pr := <-prs:
+		if len(pr.Points) != 1 || pr.Points[0].String() != points[0].String() {
+			t.Fatalf("unexpected points: %v", pr.Points)
+		}
+	case <-time.After(10 * time.Millisecond):
+		t.Fatal("expected points request")
+	}
+
+	select {
+	case pr := <-prs:
+		t.Fatalf("unexpected points request %v", pr)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(dataChanged)
+}
+
+// Ensure writes to a durable subscription are queued and retried until they
+// are delivered.
+func TestService_QueueRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dataChanged := make(chan struct{})
+	ms := MetaClient{}
+	ms.WaitForDataChangedFn = func() chan struct{} {
+		return dataChanged
+	}
+	ms.DatabasesFn = func() []meta.DatabaseInfo {
+		return []meta.DatabaseInfo{
+			{
+				Name: "db0",
+				RetentionPolicies: []meta.RetentionPolicyInfo{
+					{
+						Name: "rp0",
+						Subscriptions: []meta.SubscriptionInfo{
+							{Name: "s0", Mode: "ANY", Destinations: []string{"udp://h0:9093"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// Fail the first write so it must be retried.
+	prs := make(chan *coordinator.WritePointsRequest, 2)
+	attempts := 0
+	newPointsWriter := func(u url.URL) (subscriber.PointsWriter, error) {
+		sub := Subscription{}
+		sub.WritePointsFn = func(p *coordinator.WritePointsRequest) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("connection refused")
+			}
+			prs <- p
+			return nil
+		}
+		return sub, nil
+	}
+
+	c := subscriber.NewConfig()
+	c.QueueDir = dir
+	c.RetryInterval = toml.Duration(time.Millisecond)
+	s := subscriber.NewService(c)
+	s.MetaClient = ms
+	s.NewPointsWriter = newPointsWriter
+	s.Open()
+	defer s.Close()
+
+	pt := models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "server01"}), map[string]interface{}{"value": 1.0}, time.Unix(0, 10))
+	s.Points() <- &coordinator.WritePointsRequest{
+		Database:        "db0",
+		RetentionPolicy: "rp0",
+		Points:          []models.Point{pt},
+	}
+
+	select {
+	case pr := <-prs:
+		if pr.Database != "db0" || pr.RetentionPolicy != "rp0" {
+			t.Fatalf("unexpected destination: %s.%s", pr.Database, pr.RetentionPolicy)
+		} else if len(pr.Points) != 1 || pr.Points[0].String() != pt.String() {
+			t.Fatalf("unexpected points: %v", pr.Points)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected points request")
+	}
+	close(dataChanged)
+}
+
+// Ensure writes to a durable subscription are not dropped when they arrive
+// faster than they are queued.
+func TestService_QueueNoDrop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber-queue-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dataChanged := make(chan struct{})
+	ms := MetaClient{}
+	ms.WaitForDataChangedFn = func() chan struct{} {
+		return dataChanged
+	}
+	ms.DatabasesFn = func() []meta.DatabaseInfo {
+		return []meta.DatabaseInfo{
+			{
+				Name: "db0",
+				RetentionPolicies: []meta.RetentionPolicyInfo{
+					{
+						Name: "rp0",
+						Subscriptions: []meta.SubscriptionInfo{
+							{Name: "s0", Mode: "ALL", Destinations: []string{"udp://h0:9093"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// Hold the first delivery until all writes were sent.
+	const n = 50
+	release := make(chan struct{})
+	prs := make(chan *coordinator.WritePointsRequest, n)
+	newPointsWriter := func(u url.URL) (subscriber.PointsWriter, error) {
+		sub := Subscription{}
+		sub.WritePointsFn = func(p *coordinator.WritePointsRequest) error {
+			<-release
+			prs <- p
+			return nil
+		}
+		return sub, nil
+	}
+
+	c := subscriber.NewConfig()
+	c.QueueDir = dir
+	c.WriteBufferSize = 1
+	c.WriteConcurrency = 1
+	s := subscriber.NewService(c)
+	s.MetaClient = ms
+	s.NewPointsWriter = newPointsWriter
+	s.Open()
+	defer s.Close()
+
+	for i := 0; i < n; i++ {
+		pt := models.MustNewPoint("cpu", nil, map[string]interface{}{"value": float64(i)}, time.Unix(0, int64(i)))
+		s.Points() <- &coordinator.WritePointsRequest{
+			Database:        "db0",
+			RetentionPolicy: "rp0",
+			Points:          []models.Point{pt},
+		}
+	}
+	close(release)
+
+	for i := 0; i < n; i++ {
+		select {
+		case pr := <-prs:
+			if exp := time.Unix(0, int64(i)); !pr.Points[0].Time().Equal(exp) {
+				t.Fatalf("unexpected point %d: %v", i, pr.Points[0])
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected points request %d", i)
+		}
+	}
+	if v := s.Statistics(nil)[0].Values["writeFailures"]; v != int64(0) {
+		t.Fatalf("unexpected write failures: %v", v)
+	}
+	close(dataChanged)
+}
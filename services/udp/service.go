@@ -52,6 +52,12 @@ type Service struct {
 		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
 	}
 
+	// RejectedPoints records points dropped because their packet failed
+	// to parse. It may be nil.
+	RejectedPoints interface {
+		WriteRejectedPoints(database string, rejected []tsdb.RejectedPoint) error
+	}
+
 	MetaClient interface {
 		CreateDatabase(name string) (*meta.DatabaseInfo, error)
 	}
@@ -215,6 +221,7 @@ func (s *Service) parser() {
 			if err != nil {
 				atomic.AddInt64(&s.stats.PointsParseFail, 1)
 				s.Logger.Info(fmt.Sprintf("Failed to parse points: %s", err))
+				s.writeRejectedPoints(points, err)
 				continue
 			}
 
@@ -226,6 +233,39 @@ func (s *Service) parser() {
 	}
 }
 
+// writeRejectedPoints records the lines of a packet that failed to parse, as
+// well as the points that were dropped along with them.
+func (s *Service) writeRejectedPoints(points []models.Point, err error) {
+	if s.RejectedPoints == nil {
+		return
+	}
+
+	var rejected []tsdb.RejectedPoint
+	if perr, ok := err.(*models.ParseError); ok {
+		for _, l := range perr.Lines {
+			rejected = append(rejected, tsdb.RejectedPoint{
+				Line:            []byte(l.Text),
+				RetentionPolicy: s.config.RetentionPolicy,
+				Reason:          tsdb.RejectParseError,
+				Message:         l.Error(),
+			})
+		}
+	}
+	for _, p := range points {
+		rejected = append(rejected, tsdb.RejectedPoint{
+			Point:           p,
+			Key:             p.Key(),
+			RetentionPolicy: s.config.RetentionPolicy,
+			Reason:          tsdb.RejectParseError,
+			Message:         "dropped with a packet containing unparseable lines",
+		})
+	}
+
+	if err := s.RejectedPoints.WriteRejectedPoints(s.config.Database, rejected); err != nil {
+		s.Logger.Info(fmt.Sprintf("Failed to record rejected points: %s", err))
+	}
+}
+
 // Close closes the service and the underlying listener.
 func (s *Service) Close() error {
 	if wait := func() bool {
@@ -51,6 +51,9 @@ const (
 	// DefaultMaxConcurrentCompactions is the maximum number of concurrent full and level compactions
 	// that can run at one time.  A value of 0 results in 50% of runtime.GOMAXPROCS(0) used at runtime.
 	DefaultMaxConcurrentCompactions = 0
+
+	// DefaultRejectedPointsMaxSize is the size at which a database's rejected points log is rotated.
+	DefaultRejectedPointsMaxSize = 10 * 1024 * 1024 // 10MB
 )
 
 // Config holds the configuration for the tsbd package.
@@ -94,6 +97,22 @@ type Config struct {
 	// not affected by this limit.  A value of 0 limits compactions to runtime.GOMAXPROCS(0).
 	MaxConcurrentCompactions int `toml:"max-concurrent-compactions"`
 
+	// RejectedPointsEnabled enables recording points dropped from writes, along with the
+	// reason they were dropped, to a log per database within RejectedPointsDir.
+	RejectedPointsEnabled bool `toml:"rejected-points-enabled"`
+
+	// RejectedPointsDir is the directory of the rejected points logs.
+	RejectedPointsDir string `toml:"rejected-points-dir"`
+
+	// RejectedPointsMaxSize is the size at which a database's rejected points log is rotated.
+	// Only the most recently rotated log is kept. A value of 0 disables rotation.
+	RejectedPointsMaxSize toml.Size `toml:"rejected-points-max-size"`
+
+	// MetadataTimeBoundsEnabled restricts SHOW MEASUREMENTS, SHOW TAG KEYS and SHOW TAG VALUES
+	// with a time condition to the series written to in that time range.  Only the tsi1 index
+	// records the time ranges of its series.
+	MetadataTimeBoundsEnabled bool `toml:"metadata-time-bounds-enabled"`
+
 	TraceLoggingEnabled bool `toml:"trace-logging-enabled"`
 }
 
@@ -114,6 +133,10 @@ func NewConfig() Config {
 		MaxValuesPerTag:          DefaultMaxValuesPerTag,
 		MaxConcurrentCompactions: DefaultMaxConcurrentCompactions,
 
+		RejectedPointsMaxSize: toml.Size(DefaultRejectedPointsMaxSize),
+
+		MetadataTimeBoundsEnabled: true,
+
 		TraceLoggingEnabled: false,
 	}
 }
@@ -124,6 +147,8 @@ func (c *Config) Validate() error {
 		return errors.New("Data.Dir must be specified")
 	} else if c.WALDir == "" {
 		return errors.New("Data.WALDir must be specified")
+	} else if c.RejectedPointsEnabled && c.RejectedPointsDir == "" {
+		return errors.New("Data.RejectedPointsDir must be specified")
 	}
 
 	if c.MaxConcurrentCompactions < 0 {
@@ -168,5 +193,8 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 		"max-series-per-database":            c.MaxSeriesPerDatabase,
 		"max-values-per-tag":                 c.MaxValuesPerTag,
 		"max-concurrent-compactions":         c.MaxConcurrentCompactions,
+		"rejected-points-enabled":            c.RejectedPointsEnabled,
+		"rejected-points-dir":                c.RejectedPointsDir,
+		"metadata-time-bounds-enabled":       c.MetadataTimeBoundsEnabled,
 	}), nil
 }
@@ -1,6 +1,9 @@
 package tsdb
 
-import "github.com/influxdata/influxdb/query"
+import (
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxql"
+)
 
 // EOF represents a "not found" key returned by a Cursor.
 const EOF = query.ZeroTime
@@ -44,4 +47,9 @@ type CursorRequest struct {
 	Ascending   bool
 	StartTime   int64
 	EndTime     int64
+
+	// Condition is an optional condition on the values of the field,
+	// referenced as $.  The engine may use it to skip blocks of values that
+	// cannot match, but does not filter the values returned.
+	Condition influxql.Expr
 }
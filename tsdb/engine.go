@@ -50,6 +50,7 @@ type Engine interface {
 	CreateIterator(ctx context.Context, measurement string, opt query.IteratorOptions) (query.Iterator, error)
 	CreateCursor(ctx context.Context, r *CursorRequest) (Cursor, error)
 	IteratorCost(measurement string, opt query.IteratorOptions) (query.IteratorCost, error)
+	EstimateSeriesCost(seriesKey, field string, tmin, tmax int64) query.IteratorCost
 	WritePoints(points []models.Point) error
 
 	CreateSeriesIfNotExists(key, name []byte, tags models.Tags) error
@@ -157,6 +158,10 @@ type EngineOptions struct {
 
 	Config       Config
 	SeriesIDSets SeriesIDSets
+
+	// RejectedPoints records points dropped from writes to the shard. This
+	// is nil if rejected points are not being recorded.
+	RejectedPoints *RejectedPointsLog
 }
 
 // NewEngineOptions returns the default options.
@@ -23,10 +23,11 @@ func (e *Engine) buildFloatCursor(ctx context.Context, measurement, seriesKey, f
 }
 
 // buildFloatBatchCursor creates a batch cursor for a float field.
-func (e *Engine) buildFloatBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions) tsdb.FloatBatchCursor {
+// Blocks rejected by filter are skipped.
+func (e *Engine) buildFloatBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions, filter BlockFilter) tsdb.FloatBatchCursor {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	cacheValues := e.Cache.Values(key)
-	keyCursor := e.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	keyCursor := e.FileStore.FilteredKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, filter)
 	return newFloatBatchCursor(seriesKey, opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
 }
 
@@ -39,10 +40,11 @@ func (e *Engine) buildIntegerCursor(ctx context.Context, measurement, seriesKey,
 }
 
 // buildIntegerBatchCursor creates a batch cursor for a integer field.
-func (e *Engine) buildIntegerBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions) tsdb.IntegerBatchCursor {
+// Blocks rejected by filter are skipped.
+func (e *Engine) buildIntegerBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions, filter BlockFilter) tsdb.IntegerBatchCursor {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	cacheValues := e.Cache.Values(key)
-	keyCursor := e.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	keyCursor := e.FileStore.FilteredKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, filter)
 	return newIntegerBatchCursor(seriesKey, opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
 }
 
@@ -55,10 +57,11 @@ func (e *Engine) buildUnsignedCursor(ctx context.Context, measurement, seriesKey
 }
 
 // buildUnsignedBatchCursor creates a batch cursor for a unsigned field.
-func (e *Engine) buildUnsignedBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions) tsdb.UnsignedBatchCursor {
+// Blocks rejected by filter are skipped.
+func (e *Engine) buildUnsignedBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions, filter BlockFilter) tsdb.UnsignedBatchCursor {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	cacheValues := e.Cache.Values(key)
-	keyCursor := e.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	keyCursor := e.FileStore.FilteredKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, filter)
 	return newUnsignedBatchCursor(seriesKey, opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
 }
 
@@ -71,10 +74,11 @@ func (e *Engine) buildStringCursor(ctx context.Context, measurement, seriesKey,
 }
 
 // buildStringBatchCursor creates a batch cursor for a string field.
-func (e *Engine) buildStringBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions) tsdb.StringBatchCursor {
+// Blocks rejected by filter are skipped.
+func (e *Engine) buildStringBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions, filter BlockFilter) tsdb.StringBatchCursor {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	cacheValues := e.Cache.Values(key)
-	keyCursor := e.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	keyCursor := e.FileStore.FilteredKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, filter)
 	return newStringBatchCursor(seriesKey, opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
 }
 
@@ -87,10 +91,11 @@ func (e *Engine) buildBooleanCursor(ctx context.Context, measurement, seriesKey,
 }
 
 // buildBooleanBatchCursor creates a batch cursor for a boolean field.
-func (e *Engine) buildBooleanBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions) tsdb.BooleanBatchCursor {
+// Blocks rejected by filter are skipped.
+func (e *Engine) buildBooleanBatchCursor(ctx context.Context, measurement, seriesKey, field string, opt query.IteratorOptions, filter BlockFilter) tsdb.BooleanBatchCursor {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	cacheValues := e.Cache.Values(key)
-	keyCursor := e.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	keyCursor := e.FileStore.FilteredKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, filter)
 	return newBooleanBatchCursor(seriesKey, opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
 }
 
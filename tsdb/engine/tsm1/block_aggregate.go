@@ -0,0 +1,329 @@
+package tsm1
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+// blockAggregator reduces the blocks of a series that fall entirely inside a
+// window of a call from the aggregates in their index entries instead of
+// decoding them.  The cursors it builds return the values of the remaining
+// blocks and the cache, merged with one value per whole block, so the usual
+// reducers of the call combine both.
+type blockAggregator struct {
+	call string
+	typ  influxql.DataType
+}
+
+// newBlockAggregator returns a block aggregator for the call of a query on the
+// measurement.  Returns nil if the call can't be reduced from block aggregates:
+// the call isn't a count, sum, min, max, first or last of a numeric field, the
+// field is cast to another type, or the query has auxiliary fields or
+// overlapping windows.  min and max also need an interval since the aggregates
+// don't record the time of their value.
+func (e *Engine) newBlockAggregator(measurement string, call *influxql.Call, opt query.IteratorOptions) *blockAggregator {
+	ref, ok := call.Args[0].(*influxql.VarRef)
+	if !ok || len(opt.Aux) > 0 || opt.Interval.Stride() != opt.Interval.Duration {
+		return nil
+	}
+
+	switch call.Name {
+	case "count", "sum", "first", "last":
+	case "min", "max":
+		if opt.Interval.IsZero() {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	mf := e.fieldset.Fields(measurement)
+	if mf == nil {
+		return nil
+	}
+	f := mf.Field(ref.Val)
+	if f == nil {
+		return nil
+	}
+
+	// Casts are left to the regular cursors.
+	if ref.Type != influxql.Unknown && ref.Type != influxql.AnyField && ref.Type != f.Type {
+		return nil
+	}
+	switch f.Type {
+	case influxql.Float, influxql.Integer, influxql.Unsigned:
+		return &blockAggregator{call: call.Name, typ: f.Type}
+	}
+	return nil
+}
+
+// callOptions returns the options of the call iterators that reduce the
+// cursors of a.  The counts of the cursors are summed.
+func (a *blockAggregator) callOptions(opt query.IteratorOptions) query.IteratorOptions {
+	if a.call == "count" {
+		call := opt.Expr.(*influxql.Call)
+		opt.Expr = &influxql.Call{Name: "sum", Args: call.Args}
+	}
+	return opt
+}
+
+// buildCursor creates a cursor for the field of a series.  Whole blocks are
+// only reduced from their aggregates if the series has no filter.
+func (a *blockAggregator) buildCursor(ctx context.Context, e *Engine, seriesKey, field string, filter influxql.Expr, opt query.IteratorOptions) cursor {
+	key := SeriesFieldKeyBytes(seriesKey, field)
+	cacheValues := e.Cache.Values(key)
+
+	var keyCursor *KeyCursor
+	var entries []IndexEntry
+	if filter == nil {
+		keyCursor, entries = e.FileStore.AggregateKeyCursor(ctx, key, opt.SeekTime(), opt.Ascending, func(ie *IndexEntry) bool {
+			return isWholeBlock(ie, cacheValues, opt)
+		})
+	} else {
+		keyCursor = e.FileStore.KeyCursor(ctx, key, opt.SeekTime(), opt.Ascending)
+	}
+	blocks := a.blockValues(entries, opt.Ascending)
+
+	if a.call == "count" {
+		var cur cursor
+		switch a.typ {
+		case influxql.Float:
+			cur = newFloatCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		case influxql.Integer:
+			cur = newIntegerCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		case influxql.Unsigned:
+			cur = newUnsignedCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		default:
+			panic("unreachable")
+		}
+		return &countBlockCursor{cursor: cur, blocks: blocks}
+	}
+
+	switch a.typ {
+	case influxql.Float:
+		cur := newFloatCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		return &floatBlockCursor{cursor: cur, blocks: blocks}
+	case influxql.Integer:
+		cur := newIntegerCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		return &integerBlockCursor{cursor: cur, blocks: blocks}
+	case influxql.Unsigned:
+		cur := newUnsignedCursor(opt.SeekTime(), opt.Ascending, cacheValues, keyCursor)
+		return &unsignedBlockCursor{cursor: cur, blocks: blocks}
+	default:
+		panic("unreachable")
+	}
+}
+
+// blockValues returns the value of the call for each block, in cursor order.
+// Each value is timed within its block, where no other value of the series is.
+func (a *blockAggregator) blockValues(entries []IndexEntry, ascending bool) blockValues {
+	values := blockValues{ascending: ascending}
+	if len(entries) == 0 {
+		return values
+	}
+
+	values.a = make([]blockValue, len(entries))
+	for i := range entries {
+		ie := &entries[i]
+		v := blockValue{t: ie.MinTime}
+		switch a.call {
+		case "count":
+			v.v = uint64(ie.Count)
+		case "sum":
+			v.v = ie.SumValue
+		case "min":
+			v.v = ie.MinValue
+		case "max":
+			v.v = ie.MaxValue
+		case "first":
+			v.v = ie.FirstValue
+		case "last":
+			v.t, v.v = ie.MaxTime, ie.LastValue
+		}
+		values.a[i] = v
+	}
+
+	sort.Slice(values.a, func(i, j int) bool {
+		if ascending {
+			return values.a[i].t < values.a[j].t
+		}
+		return values.a[i].t > values.a[j].t
+	})
+	return values
+}
+
+// isWholeBlock returns true if the block of ie lies within the time range and
+// a single window of opt and no cached value overwrites its values.
+func isWholeBlock(ie *IndexEntry, cacheValues Values, opt query.IteratorOptions) bool {
+	if ie.MinTime < opt.StartTime || ie.MaxTime > opt.EndTime {
+		return false
+	}
+	minStart, _ := opt.Window(ie.MinTime)
+	maxStart, _ := opt.Window(ie.MaxTime)
+	if minStart != maxStart {
+		return false
+	}
+
+	i := sort.Search(len(cacheValues), func(i int) bool {
+		return cacheValues[i].UnixNano() >= ie.MinTime
+	})
+	return i == len(cacheValues) || cacheValues[i].UnixNano() > ie.MaxTime
+}
+
+// blockValue is the value of a call for a whole block.  v holds the bits of
+// the value as they are encoded in the index entry.
+type blockValue struct {
+	t int64
+	v uint64
+}
+
+// blockValues is a queue of block values in cursor order.
+type blockValues struct {
+	a         []blockValue
+	ascending bool
+}
+
+// before returns true if the next block value comes before the time t of a
+// value of the underlying cursor.
+func (b *blockValues) before(t int64) bool {
+	if len(b.a) == 0 {
+		return false
+	} else if t == tsdb.EOF {
+		return true
+	} else if b.ascending {
+		return b.a[0].t < t
+	}
+	return b.a[0].t > t
+}
+
+// pop removes and returns the next block value.
+func (b *blockValues) pop() blockValue {
+	v := b.a[0]
+	b.a = b.a[1:]
+	return v
+}
+
+// floatBlockCursor merges the values of a float cursor with block values.
+type floatBlockCursor struct {
+	cursor floatCursor
+	blocks blockValues
+
+	buf struct {
+		t     int64
+		v     float64
+		valid bool
+	}
+}
+
+func (c *floatBlockCursor) close() error { return c.cursor.close() }
+
+func (c *floatBlockCursor) next() (t int64, v interface{}) { return c.nextFloat() }
+
+func (c *floatBlockCursor) nextFloat() (int64, float64) {
+	if !c.buf.valid {
+		c.buf.t, c.buf.v = c.cursor.nextFloat()
+		c.buf.valid = true
+	}
+
+	if c.blocks.before(c.buf.t) {
+		b := c.blocks.pop()
+		return b.t, math.Float64frombits(b.v)
+	}
+	c.buf.valid = false
+	return c.buf.t, c.buf.v
+}
+
+// integerBlockCursor merges the values of an integer cursor with block values.
+type integerBlockCursor struct {
+	cursor integerCursor
+	blocks blockValues
+
+	buf struct {
+		t     int64
+		v     int64
+		valid bool
+	}
+}
+
+func (c *integerBlockCursor) close() error { return c.cursor.close() }
+
+func (c *integerBlockCursor) next() (t int64, v interface{}) { return c.nextInteger() }
+
+func (c *integerBlockCursor) nextInteger() (int64, int64) {
+	if !c.buf.valid {
+		c.buf.t, c.buf.v = c.cursor.nextInteger()
+		c.buf.valid = true
+	}
+
+	if c.blocks.before(c.buf.t) {
+		b := c.blocks.pop()
+		return b.t, int64(b.v)
+	}
+	c.buf.valid = false
+	return c.buf.t, c.buf.v
+}
+
+// unsignedBlockCursor merges the values of an unsigned cursor with block values.
+type unsignedBlockCursor struct {
+	cursor unsignedCursor
+	blocks blockValues
+
+	buf struct {
+		t     int64
+		v     uint64
+		valid bool
+	}
+}
+
+func (c *unsignedBlockCursor) close() error { return c.cursor.close() }
+
+func (c *unsignedBlockCursor) next() (t int64, v interface{}) { return c.nextUnsigned() }
+
+func (c *unsignedBlockCursor) nextUnsigned() (int64, uint64) {
+	if !c.buf.valid {
+		c.buf.t, c.buf.v = c.cursor.nextUnsigned()
+		c.buf.valid = true
+	}
+
+	if c.blocks.before(c.buf.t) {
+		b := c.blocks.pop()
+		return b.t, b.v
+	}
+	c.buf.valid = false
+	return c.buf.t, c.buf.v
+}
+
+// countBlockCursor is an integer cursor that returns a count of 1 for each
+// value of a cursor, merged with the counts of whole blocks.
+type countBlockCursor struct {
+	cursor cursor
+	blocks blockValues
+
+	buf struct {
+		t     int64
+		valid bool
+	}
+}
+
+func (c *countBlockCursor) close() error { return c.cursor.close() }
+
+func (c *countBlockCursor) next() (t int64, v interface{}) { return c.nextInteger() }
+
+func (c *countBlockCursor) nextInteger() (int64, int64) {
+	if !c.buf.valid {
+		c.buf.t, _ = c.cursor.next()
+		c.buf.valid = true
+	}
+
+	if c.blocks.before(c.buf.t) {
+		b := c.blocks.pop()
+		return b.t, int64(b.v)
+	}
+	c.buf.valid = false
+	return c.buf.t, 1
+}
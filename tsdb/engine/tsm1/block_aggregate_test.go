@@ -0,0 +1,249 @@
+package tsm1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+func TestEngine_NewBlockAggregator(t *testing.T) {
+	dir := mustTempDir()
+	defer os.RemoveAll(dir)
+
+	fields, err := tsdb.NewMeasurementFieldSet(filepath.Join(dir, "fields.idx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mf := fields.CreateFieldsIfNotExists([]byte("cpu"))
+	for name, typ := range map[string]influxql.DataType{
+		"f": influxql.Float,
+		"i": influxql.Integer,
+		"s": influxql.String,
+		"b": influxql.Boolean,
+	} {
+		if err := mf.CreateFieldIfNotExists([]byte(name), typ); err != nil {
+			t.Fatal(err)
+		}
+	}
+	e := &Engine{fieldset: fields}
+
+	interval := query.Interval{Duration: time.Minute}
+	for _, tt := range []struct {
+		expr     string
+		interval query.Interval
+		aux      bool
+		exp      bool
+	}{
+		{expr: "count(f)", exp: true},
+		{expr: "sum(i)", exp: true},
+		{expr: "first(f::float)", exp: true},
+		{expr: "last(i)", interval: interval, exp: true},
+		{expr: "min(f)", interval: interval, exp: true},
+		{expr: "min(f)", exp: false},
+		{expr: "count(f::integer)", exp: false},
+		{expr: "sum(i::float)", exp: false},
+		{expr: "count(s)", exp: false},
+		{expr: "count(b)", exp: false},
+		{expr: "last(s)", exp: false},
+		{expr: "mean(f)", exp: false},
+		{expr: "count(f)", aux: true, exp: false},
+		{expr: "count(x)", exp: false},
+	} {
+		opt := query.IteratorOptions{Interval: tt.interval}
+		if tt.aux {
+			opt.Aux = []influxql.VarRef{{Val: "i"}}
+		}
+
+		call := influxql.MustParseExpr(tt.expr).(*influxql.Call)
+		if got := e.newBlockAggregator("cpu", call, opt) != nil; got != tt.exp {
+			t.Errorf("%s: got %v, exp %v", tt.expr, got, tt.exp)
+		}
+	}
+}
+
+// Ensure the blocks of version 1 files, which have no statistics, are read
+// instead of being reduced from their aggregates.
+func TestFileStore_AggregateKeyCursor_Version1(t *testing.T) {
+	dir := mustTempDir()
+	defer os.RemoveAll(dir)
+
+	tsmPath := func(gen int) string {
+		return filepath.Join(dir, fmt.Sprintf("%09d-%09d.%s", gen, 1, TSMFileExtension))
+	}
+
+	f, err := os.Create(tsmPath(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustWriteVersion1(t, f, "cpu", []Value{NewValue(0, 1.0), NewValue(1, 2.0)})
+
+	if f, err = os.Create(tsmPath(2)); err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewTSMWriter(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]byte("cpu"), []Value{NewValue(10, 3.0), NewValue(11, 4.0)}); err != nil {
+		t.Fatal(err)
+	} else if err := w.WriteIndex(); err != nil {
+		t.Fatal(err)
+	} else if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := NewFileStore(dir)
+	if err := fs.Replace(nil, []string{tsmPath(1), tsmPath(2)}); err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	c, entries := fs.AggregateKeyCursor(context.Background(), []byte("cpu"), 0, true, func(*IndexEntry) bool { return true })
+	defer c.Close()
+
+	if len(entries) != 1 || entries[0].MinTime != 10 || entries[0].Count != 2 {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+
+	buf := make([]FloatValue, 1000)
+	var times []int64
+	for {
+		values, err := c.ReadFloatBlock(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading values: %v", err)
+		} else if len(values) == 0 {
+			break
+		}
+		for _, v := range values {
+			times = append(times, v.UnixNano())
+		}
+		c.Next()
+	}
+
+	if got, exp := times, []int64{0, 1}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("read times mismatch: got %v, exp %v", got, exp)
+	}
+}
+
+func TestFloatBlockCursor(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		ascending bool
+		values    []FloatValue
+		blocks    []blockValue
+		exp       []FloatValue
+	}{
+		{
+			name:      "ascending",
+			ascending: true,
+			values:    []FloatValue{{unixnano: 5, value: 1}, {unixnano: 30, value: 2}},
+			blocks:    []blockValue{{t: 0, v: math.Float64bits(10)}, {t: 10, v: math.Float64bits(20)}, {t: 40, v: math.Float64bits(30)}},
+			exp: []FloatValue{
+				{unixnano: 0, value: 10}, {unixnano: 5, value: 1}, {unixnano: 10, value: 20},
+				{unixnano: 30, value: 2}, {unixnano: 40, value: 30},
+			},
+		},
+		{
+			name:   "descending",
+			values: []FloatValue{{unixnano: 30, value: 2}, {unixnano: 5, value: 1}},
+			blocks: []blockValue{{t: 40, v: math.Float64bits(30)}, {t: 10, v: math.Float64bits(20)}},
+			exp: []FloatValue{
+				{unixnano: 40, value: 30}, {unixnano: 30, value: 2}, {unixnano: 10, value: 20}, {unixnano: 5, value: 1},
+			},
+		},
+	} {
+		cur := &floatBlockCursor{
+			cursor: &floatSliceCursor{values: tt.values},
+			blocks: blockValues{a: tt.blocks, ascending: tt.ascending},
+		}
+
+		var got []FloatValue
+		for {
+			ts, v := cur.nextFloat()
+			if ts == tsdb.EOF {
+				break
+			}
+			got = append(got, FloatValue{unixnano: ts, value: v})
+		}
+
+		if !reflect.DeepEqual(got, tt.exp) {
+			t.Errorf("%s: unexpected values: got %v, exp %v", tt.name, got, tt.exp)
+		}
+	}
+}
+
+func TestCountBlockCursor(t *testing.T) {
+	cur := &countBlockCursor{
+		cursor: &floatSliceCursor{values: []FloatValue{{unixnano: 5, value: 1}, {unixnano: 6, value: 2}}},
+		blocks: blockValues{a: []blockValue{{t: 0, v: 100}, {t: 10, v: 50}}, ascending: true},
+	}
+
+	var got []int64
+	for {
+		ts, v := cur.nextInteger()
+		if ts == tsdb.EOF {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if exp := []int64{100, 1, 1, 50}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected counts: got %v, exp %v", got, exp)
+	}
+}
+
+func TestIsWholeBlock(t *testing.T) {
+	opt := query.IteratorOptions{
+		StartTime: 0,
+		EndTime:   int64(time.Hour) - 1,
+		Interval:  query.Interval{Duration: 10 * time.Minute},
+		Ascending: true,
+	}
+	minute := int64(time.Minute)
+	cacheValues := Values{NewValue(25*minute, 1.0)}
+
+	for _, tt := range []struct {
+		name     string
+		min, max int64
+		exp      bool
+	}{
+		{name: "inside window", min: 0, max: 9 * minute, exp: true},
+		{name: "across windows", min: 5 * minute, max: 15 * minute, exp: false},
+		{name: "before start", min: -minute, max: minute, exp: false},
+		{name: "after end", min: 55 * minute, max: 65 * minute, exp: false},
+		{name: "cached value", min: 20 * minute, max: 29 * minute, exp: false},
+		{name: "cached value after", min: 20 * minute, max: 24 * minute, exp: true},
+	} {
+		ie := &IndexEntry{MinTime: tt.min, MaxTime: tt.max}
+		if got := isWholeBlock(ie, cacheValues, opt); got != tt.exp {
+			t.Errorf("%s: got %v, exp %v", tt.name, got, tt.exp)
+		}
+	}
+}
+
+// floatSliceCursor is a floatCursor over a slice of values.
+type floatSliceCursor struct {
+	values []FloatValue
+}
+
+func (c *floatSliceCursor) close() error { return nil }
+
+func (c *floatSliceCursor) next() (t int64, v interface{}) { return c.nextFloat() }
+
+func (c *floatSliceCursor) nextFloat() (int64, float64) {
+	if len(c.values) == 0 {
+		return tsdb.EOF, 0
+	}
+	v := c.values[0]
+	c.values = c.values[1:]
+	return v.unixnano, v.value
+}
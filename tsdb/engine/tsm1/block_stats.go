@@ -0,0 +1,379 @@
+package tsm1
+
+import (
+	"math"
+	"sort"
+
+	"github.com/influxdata/influxql"
+)
+
+// blockStatsReader computes the statistics of blocks for their index entries.  It
+// keeps the buffers used to decode the blocks between calls.
+type blockStatsReader struct {
+	floats    []FloatValue
+	integers  []IntegerValue
+	unsigneds []UnsignedValue
+	booleans  []BooleanValue
+}
+
+// read sets the statistics and aggregates of the entry to those of the values
+// of block.  String blocks only have a count.
+func (s *blockStatsReader) read(block []byte, e *IndexEntry) error {
+	typ, err := BlockType(block)
+	if err != nil {
+		return err
+	}
+
+	switch typ {
+	case BlockFloat64:
+		s.floats, err = DecodeFloatBlock(block, &s.floats)
+		FloatValues(s.floats).stats(e)
+	case BlockInteger:
+		s.integers, err = DecodeIntegerBlock(block, &s.integers)
+		IntegerValues(s.integers).stats(e)
+	case BlockUnsigned:
+		s.unsigneds, err = DecodeUnsignedBlock(block, &s.unsigneds)
+		UnsignedValues(s.unsigneds).stats(e)
+	case BlockBoolean:
+		s.booleans, err = DecodeBooleanBlock(block, &s.booleans)
+		BooleanValues(s.booleans).stats(e)
+	case BlockString:
+		// Strings have no min and max value.
+		e.resetStats()
+		e.Count = uint32(BlockCount(block))
+	}
+	return err
+}
+
+// values sets the statistics and aggregates of the entry to those of values,
+// which all have the same type, without encoding them.
+func (s *blockStatsReader) values(values Values, e *IndexEntry) {
+	if len(values) == 0 {
+		e.resetStats()
+		return
+	}
+
+	switch values[0].(type) {
+	case FloatValue:
+		s.floats = s.floats[:0]
+		for _, v := range values {
+			s.floats = append(s.floats, v.(FloatValue))
+		}
+		FloatValues(s.floats).stats(e)
+	case IntegerValue:
+		s.integers = s.integers[:0]
+		for _, v := range values {
+			s.integers = append(s.integers, v.(IntegerValue))
+		}
+		IntegerValues(s.integers).stats(e)
+	case UnsignedValue:
+		s.unsigneds = s.unsigneds[:0]
+		for _, v := range values {
+			s.unsigneds = append(s.unsigneds, v.(UnsignedValue))
+		}
+		UnsignedValues(s.unsigneds).stats(e)
+	case BooleanValue:
+		s.booleans = s.booleans[:0]
+		for _, v := range values {
+			s.booleans = append(s.booleans, v.(BooleanValue))
+		}
+		BooleanValues(s.booleans).stats(e)
+	default:
+		e.resetStats()
+		e.Count = uint32(len(values))
+	}
+}
+
+// resetStats clears the statistics and aggregates of the entry.
+func (e *IndexEntry) resetStats() {
+	e.Count, e.MinValue, e.MaxValue = 0, 0, 0
+	e.SumValue, e.FirstValue, e.LastValue = 0, 0, 0
+}
+
+// stats sets the statistics and aggregates of the entry to those of a.
+func (a FloatValues) stats(e *IndexEntry) {
+	e.resetStats()
+	if len(a) == 0 {
+		return
+	}
+
+	min, max, sum := a[0].value, a[0].value, 0.0
+	for _, v := range a {
+		min, max = math.Min(min, v.value), math.Max(max, v.value)
+		sum += v.value
+	}
+	e.Count, e.MinValue, e.MaxValue = uint32(len(a)), math.Float64bits(min), math.Float64bits(max)
+	e.SumValue = math.Float64bits(sum)
+	e.FirstValue, e.LastValue = math.Float64bits(a[0].value), math.Float64bits(a[len(a)-1].value)
+}
+
+// stats sets the statistics and aggregates of the entry to those of a.
+func (a IntegerValues) stats(e *IndexEntry) {
+	e.resetStats()
+	if len(a) == 0 {
+		return
+	}
+
+	min, max, sum := a[0].value, a[0].value, int64(0)
+	for _, v := range a {
+		if v.value < min {
+			min = v.value
+		} else if v.value > max {
+			max = v.value
+		}
+		sum += v.value
+	}
+	e.Count, e.MinValue, e.MaxValue = uint32(len(a)), uint64(min), uint64(max)
+	e.SumValue = uint64(sum)
+	e.FirstValue, e.LastValue = uint64(a[0].value), uint64(a[len(a)-1].value)
+}
+
+// stats sets the statistics and aggregates of the entry to those of a.
+func (a UnsignedValues) stats(e *IndexEntry) {
+	e.resetStats()
+	if len(a) == 0 {
+		return
+	}
+
+	min, max, sum := a[0].value, a[0].value, uint64(0)
+	for _, v := range a {
+		if v.value < min {
+			min = v.value
+		} else if v.value > max {
+			max = v.value
+		}
+		sum += v.value
+	}
+	e.Count, e.MinValue, e.MaxValue = uint32(len(a)), min, max
+	e.SumValue = sum
+	e.FirstValue, e.LastValue = a[0].value, a[len(a)-1].value
+}
+
+// stats sets the statistics and aggregates of the entry to those of a.  The
+// sum of booleans is zero.
+func (a BooleanValues) stats(e *IndexEntry) {
+	e.resetStats()
+	if len(a) == 0 {
+		return
+	}
+
+	min, max := uint64(1), uint64(0)
+	for _, v := range a {
+		if v.value {
+			max = 1
+		} else {
+			min = 0
+		}
+	}
+	e.Count, e.MinValue, e.MaxValue = uint32(len(a)), min, max
+	e.FirstValue, e.LastValue = boolBits(a[0].value), boolBits(a[len(a)-1].value)
+}
+
+// stats sets the statistics of the entry to those of a.  Strings only have a
+// count.
+func (a StringValues) stats(e *IndexEntry) {
+	e.resetStats()
+	e.Count = uint32(len(a))
+}
+
+// boolBits returns 1 for true and 0 for false.
+func boolBits(v bool) uint64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// valueRange returns the min and max value of a block of the given type as
+// float64s.  Booleans are 0 and 1.  Returns false if the entry has no
+// statistics or the block is not of a numeric or boolean type.
+func (e *IndexEntry) valueRange(typ byte) (min, max float64, ok bool) {
+	if e.Count == 0 {
+		return 0, 0, false
+	}
+
+	switch typ {
+	case BlockFloat64:
+		return math.Float64frombits(e.MinValue), math.Float64frombits(e.MaxValue), true
+	case BlockInteger:
+		return float64(int64(e.MinValue)), float64(int64(e.MaxValue)), true
+	case BlockUnsigned, BlockBoolean:
+		return float64(e.MinValue), float64(e.MaxValue), true
+	}
+	return 0, 0, false
+}
+
+// BlockFilter returns false if the block of the index entry cannot contain
+// values of interest and can be skipped.
+type BlockFilter func(e *IndexEntry) bool
+
+// newBlockFilter returns a filter of the blocks of the given type that skips
+// the blocks whose statistics show no value can satisfy cond.  The values are
+// referenced as $ in cond.  Returns nil if no block can be skipped.
+func newBlockFilter(cond influxql.Expr, typ byte) BlockFilter {
+	if cond == nil || typ == BlockString {
+		return nil
+	}
+
+	return func(e *IndexEntry) bool {
+		min, max, ok := e.valueRange(typ)
+		return !ok || mayMatch(cond, min, max)
+	}
+}
+
+// mayMatch returns false if no value between min and max satisfies expr.
+// Comparisons are made as float64 and inclusive of the bounds, so expressions
+// that can't be evaluated against a range or lose precision always match.
+func mayMatch(expr influxql.Expr, min, max float64) bool {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return mayMatch(expr.Expr, min, max)
+
+	case *influxql.BinaryExpr:
+		switch expr.Op {
+		case influxql.AND:
+			return mayMatch(expr.LHS, min, max) && mayMatch(expr.RHS, min, max)
+		case influxql.OR:
+			return mayMatch(expr.LHS, min, max) || mayMatch(expr.RHS, min, max)
+		}
+
+		op, v, ok := valueComparison(expr)
+		if !ok {
+			return true
+		}
+
+		switch op {
+		case influxql.EQ:
+			return min <= v && v <= max
+		case influxql.LT, influxql.LTE:
+			return min <= v
+		case influxql.GT, influxql.GTE:
+			return max >= v
+		}
+	}
+	return true
+}
+
+// valueComparison returns the operator and literal of a comparison between
+// the value reference $ and a numeric or boolean literal, as if the reference
+// were on the left-hand side.
+func valueComparison(expr *influxql.BinaryExpr) (influxql.Token, float64, bool) {
+	op, ref, lit := expr.Op, expr.LHS, expr.RHS
+	if _, ok := lit.(*influxql.VarRef); ok {
+		ref, lit = lit, ref
+		switch op {
+		case influxql.LT:
+			op = influxql.GT
+		case influxql.LTE:
+			op = influxql.GTE
+		case influxql.GT:
+			op = influxql.LT
+		case influxql.GTE:
+			op = influxql.LTE
+		}
+	}
+
+	if ref, ok := ref.(*influxql.VarRef); !ok || ref.Val != "$" {
+		return 0, 0, false
+	}
+
+	switch lit := lit.(type) {
+	case *influxql.NumberLiteral:
+		return op, lit.Val, true
+	case *influxql.IntegerLiteral:
+		return op, float64(lit.Val), true
+	case *influxql.UnsignedLiteral:
+		return op, float64(lit.Val), true
+	case *influxql.BooleanLiteral:
+		if lit.Val {
+			return op, 1, true
+		}
+		return op, 0, true
+	}
+	return 0, 0, false
+}
+
+// filterLocations removes the locations of the blocks skipped by filter.
+// Blocks that overlap other blocks in time are never skipped, since their
+// values may overwrite or be overwritten by the values of the other blocks.
+func filterLocations(locations []*location, filter BlockFilter) []*location {
+	skip := make(map[*location]struct{})
+	for _, l := range isolatedLocations(locations) {
+		if !filter(&l.entry) {
+			skip[l] = struct{}{}
+		}
+	}
+	return removeLocations(locations, skip)
+}
+
+// splitAggregateLocations removes the locations of the blocks accepted by
+// whole and returns their index entries in the order of locations.  Only the
+// blocks with statistics that overlap no other block and have no tombstones
+// for key are passed to whole, so their aggregates are those of their values.
+func splitAggregateLocations(key []byte, locations []*location, whole BlockFilter) ([]*location, []IndexEntry) {
+	var entries []IndexEntry
+	skip := make(map[*location]struct{})
+	for _, l := range isolatedLocations(locations) {
+		if l.entry.Count == 0 || hasTombstones(l.r.TombstoneRange(key), &l.entry) || !whole(&l.entry) {
+			continue
+		}
+		skip[l] = struct{}{}
+	}
+
+	for _, l := range locations {
+		if _, ok := skip[l]; ok {
+			entries = append(entries, l.entry)
+		}
+	}
+	return removeLocations(locations, skip), entries
+}
+
+// isolatedLocations returns the locations of the blocks that overlap no other
+// block in time, ordered by min time.
+func isolatedLocations(locations []*location) []*location {
+	byMinTime := make([]*location, len(locations))
+	copy(byMinTime, locations)
+	sort.Slice(byMinTime, func(i, j int) bool {
+		return byMinTime[i].entry.MinTime < byMinTime[j].entry.MinTime
+	})
+
+	var a []*location
+	maxTime := int64(math.MinInt64)
+	for i, l := range byMinTime {
+		overlaps := (i > 0 && maxTime >= l.entry.MinTime) ||
+			(i+1 < len(byMinTime) && byMinTime[i+1].entry.MinTime <= l.entry.MaxTime)
+		if l.entry.MaxTime > maxTime {
+			maxTime = l.entry.MaxTime
+		}
+
+		if !overlaps {
+			a = append(a, l)
+		}
+	}
+	return a
+}
+
+// hasTombstones returns true if any of the tombstones overlaps the block of e.
+func hasTombstones(tombstones []TimeRange, e *IndexEntry) bool {
+	for _, t := range tombstones {
+		if t.Overlaps(e.MinTime, e.MaxTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeLocations removes the locations in skip from locations.
+func removeLocations(locations []*location, skip map[*location]struct{}) []*location {
+	if len(skip) == 0 {
+		return locations
+	}
+
+	a := locations[:0]
+	for _, l := range locations {
+		if _, ok := skip[l]; !ok {
+			a = append(a, l)
+		}
+	}
+	return a
+}
@@ -0,0 +1,84 @@
+package tsm1
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influxdata/influxql"
+)
+
+func TestBlockFilter(t *testing.T) {
+	// A float block with values between 10 and 20.
+	e := &IndexEntry{Count: 5, MinValue: math.Float64bits(10), MaxValue: math.Float64bits(20)}
+
+	for _, tt := range []struct {
+		cond string
+		exp  bool
+	}{
+		{cond: "value > 90", exp: false},
+		{cond: "value > 15", exp: true},
+		{cond: "value >= 20", exp: true},
+		{cond: "90 < value", exp: false},
+		{cond: "value < 10", exp: true},
+		{cond: "value < 5", exp: false},
+		{cond: "value = 12", exp: true},
+		{cond: "value = 25", exp: false},
+		{cond: "value != 15", exp: true},
+		{cond: "value > 90 OR value < 5", exp: false},
+		{cond: "value > 90 OR value < 15", exp: true},
+		{cond: "(value > 12 AND value < 14)", exp: true},
+		{cond: "value > 12 AND value > 30", exp: false},
+		{cond: "host = 'a' AND value > 30", exp: false},
+		{cond: "host = 'a' OR value > 30", exp: true},
+	} {
+		if got := newBlockFilter(mustParseValueExpr(tt.cond), BlockFloat64)(e); got != tt.exp {
+			t.Errorf("%s: got %v, exp %v", tt.cond, got, tt.exp)
+		}
+	}
+
+	// Blocks without statistics, such as those of version 1 files, always match.
+	cond := mustParseValueExpr("value > 90")
+	if !newBlockFilter(cond, BlockFloat64)(&IndexEntry{}) {
+		t.Error("expected block without statistics to match")
+	}
+
+	if f := newBlockFilter(cond, BlockString); f != nil {
+		t.Error("expected no filter for string blocks")
+	}
+}
+
+func TestFilterLocations(t *testing.T) {
+	newLocation := func(min, max int64, v float64) *location {
+		return &location{entry: IndexEntry{MinTime: min, MaxTime: max, Count: 1, MinValue: math.Float64bits(v), MaxValue: math.Float64bits(v)}}
+	}
+
+	// The third and fourth blocks overlap and are kept although they don't match.
+	a := []*location{
+		newLocation(0, 9, 1),
+		newLocation(10, 19, 100),
+		newLocation(20, 29, 1),
+		newLocation(25, 35, 100),
+		newLocation(40, 49, 1),
+	}
+	exp := []*location{a[1], a[2], a[3]}
+
+	got := filterLocations(a, newBlockFilter(mustParseValueExpr("value > 90"), BlockFloat64))
+	if len(got) != len(exp) {
+		t.Fatalf("unexpected locations: got %d, exp %d", len(got), len(exp))
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Fatalf("unexpected location %d: got %v, exp %v", i, got[i].entry, exp[i].entry)
+		}
+	}
+}
+
+// mustParseValueExpr parses s and replaces the references to value with $.
+func mustParseValueExpr(s string) influxql.Expr {
+	return influxql.RewriteExpr(influxql.MustParseExpr(s), func(expr influxql.Expr) influxql.Expr {
+		if ref, ok := expr.(*influxql.VarRef); ok && ref.Val == "value" {
+			return &influxql.VarRef{Val: "$"}
+		}
+		return expr
+	})
+}
@@ -491,6 +491,33 @@ func (c *Cache) Count() int {
 	return n
 }
 
+// ValueCount returns the number of values for the key between min and max in
+// the cache and its snapshot without copying them. Values overwritten since
+// the snapshot are counted twice.
+func (c *Cache) ValueCount(key []byte, min, max int64) int {
+	c.mu.RLock()
+	entries := []*entry{c.store.entry(key)}
+	if c.snapshot != nil {
+		entries = append(entries, c.snapshot.store.entry(key))
+	}
+	c.mu.RUnlock()
+
+	var n int
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		e.mu.RLock()
+		for _, v := range e.values {
+			if t := v.UnixNano(); t >= min && t <= max {
+				n++
+			}
+		}
+		e.mu.RUnlock()
+	}
+	return n
+}
+
 // Keys returns a sorted slice of all keys under management by the cache.
 func (c *Cache) Keys() [][]byte {
 	c.mu.RLock()
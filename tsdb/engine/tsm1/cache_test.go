@@ -385,6 +385,30 @@ func TestCache_CacheValues(t *testing.T) {
 	}
 }
 
+// Ensure values in the cache and its snapshot are counted within the range.
+func TestCache_ValueCount(t *testing.T) {
+	c := NewCache(512, "")
+	if n := c.ValueCount([]byte("foo"), 0, 10); n != 0 {
+		t.Fatalf("unexpected value count for no such key: %d", n)
+	}
+
+	if err := c.Write([]byte("foo"), Values{NewValue(1, 1.0), NewValue(2, 2.0)}); err != nil {
+		t.Fatalf("failed to write key foo to cache: %s", err.Error())
+	}
+	if _, err := c.Snapshot(); err != nil {
+		t.Fatalf("failed to snapshot cache: %v", err)
+	}
+	if err := c.Write([]byte("foo"), Values{NewValue(3, 3.0), NewValue(4, 4.0)}); err != nil {
+		t.Fatalf("failed to write key foo to cache: %s", err.Error())
+	}
+
+	if n := c.ValueCount([]byte("foo"), 0, 10); n != 4 {
+		t.Fatalf("unexpected value count: %d", n)
+	} else if n := c.ValueCount([]byte("foo"), 2, 3); n != 2 {
+		t.Fatalf("unexpected value count in range: %d", n)
+	}
+}
+
 func TestCache_CacheSnapshot(t *testing.T) {
 	v0 := NewValue(2, 0.0)
 	v1 := NewValue(3, 2.0)
@@ -178,12 +178,14 @@ func (k *tsmKeyIterator) chunkFloat(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: values[0].UnixNano(),
 			maxTime: values[len(values)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		FloatValues(values).stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedFloatValues = k.mergedFloatValues[k.size:]
 		return dst
 	}
@@ -196,12 +198,14 @@ func (k *tsmKeyIterator) chunkFloat(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: k.mergedFloatValues[0].UnixNano(),
 			maxTime: k.mergedFloatValues[len(k.mergedFloatValues)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		k.mergedFloatValues.stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedFloatValues = k.mergedFloatValues[:0]
 	}
 	return dst
@@ -377,12 +381,14 @@ func (k *tsmKeyIterator) chunkInteger(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: values[0].UnixNano(),
 			maxTime: values[len(values)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		IntegerValues(values).stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedIntegerValues = k.mergedIntegerValues[k.size:]
 		return dst
 	}
@@ -395,12 +401,14 @@ func (k *tsmKeyIterator) chunkInteger(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: k.mergedIntegerValues[0].UnixNano(),
 			maxTime: k.mergedIntegerValues[len(k.mergedIntegerValues)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		k.mergedIntegerValues.stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedIntegerValues = k.mergedIntegerValues[:0]
 	}
 	return dst
@@ -576,12 +584,14 @@ func (k *tsmKeyIterator) chunkUnsigned(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: values[0].UnixNano(),
 			maxTime: values[len(values)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		UnsignedValues(values).stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedUnsignedValues = k.mergedUnsignedValues[k.size:]
 		return dst
 	}
@@ -594,12 +604,14 @@ func (k *tsmKeyIterator) chunkUnsigned(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: k.mergedUnsignedValues[0].UnixNano(),
 			maxTime: k.mergedUnsignedValues[len(k.mergedUnsignedValues)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		k.mergedUnsignedValues.stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedUnsignedValues = k.mergedUnsignedValues[:0]
 	}
 	return dst
@@ -775,12 +787,14 @@ func (k *tsmKeyIterator) chunkString(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: values[0].UnixNano(),
 			maxTime: values[len(values)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		StringValues(values).stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedStringValues = k.mergedStringValues[k.size:]
 		return dst
 	}
@@ -793,12 +807,14 @@ func (k *tsmKeyIterator) chunkString(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: k.mergedStringValues[0].UnixNano(),
 			maxTime: k.mergedStringValues[len(k.mergedStringValues)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		k.mergedStringValues.stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedStringValues = k.mergedStringValues[:0]
 	}
 	return dst
@@ -974,12 +990,14 @@ func (k *tsmKeyIterator) chunkBoolean(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: values[0].UnixNano(),
 			maxTime: values[len(values)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		BooleanValues(values).stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedBooleanValues = k.mergedBooleanValues[k.size:]
 		return dst
 	}
@@ -992,12 +1010,14 @@ func (k *tsmKeyIterator) chunkBoolean(dst blocks) blocks {
 			return nil
 		}
 
-		dst = append(dst, &block{
+		blk := &block{
 			minTime: k.mergedBooleanValues[0].UnixNano(),
 			maxTime: k.mergedBooleanValues[len(k.mergedBooleanValues)-1].UnixNano(),
 			key:     k.key,
 			b:       cb,
-		})
+		}
+		k.mergedBooleanValues.stats(&blk.entry)
+		dst = append(dst, blk)
 		k.mergedBooleanValues = k.mergedBooleanValues[:0]
 	}
 	return dst
@@ -1090,13 +1090,14 @@ func (c *Compactor) write(path string, iter KeyIterator, throttle bool) (err err
 		// Each call to read returns the next sorted key (or the prior one if there are
 		// more values to write).  The size of values will be less than or equal to our
 		// chunk size (1000)
-		key, minTime, maxTime, block, err := iter.Read()
+		key, _, _, block, err := iter.Read()
 		if err != nil {
 			return err
 		}
 
-		// Write the key and value
-		if err := w.WriteBlock(key, minTime, maxTime, block); err == ErrMaxBlocksExceeded {
+		// Write the key and value with the statistics of the block, so blocks copied
+		// as is are not decoded again.
+		if err := w.WriteBlockEntry(key, iter.Entry(), block); err == ErrMaxBlocksExceeded {
 			if err := w.WriteIndex(); err != nil {
 				return err
 			}
@@ -1163,6 +1164,10 @@ type KeyIterator interface {
 	// or any error that occurred.
 	Read() (key []byte, minTime int64, maxTime int64, data []byte, err error)
 
+	// Entry returns the time range and statistics of the block returned by Read.
+	// The statistics are unset if they are not known.
+	Entry() IndexEntry
+
 	// Close closes the iterator.
 	Close() error
 
@@ -1232,6 +1237,9 @@ type block struct {
 	b                []byte
 	tombstones       []TimeRange
 
+	// entry holds the statistics of the block.  They are unset if they are not known.
+	entry IndexEntry
+
 	// readMin, readMax are the timestamps range of values have been
 	// read and encoded from this block.
 	readMin, readMax int64
@@ -1372,6 +1380,7 @@ RETRY:
 				blk.typ = typ
 				blk.b = b
 				blk.tombstones = tombstones
+				blk.entry = iter.entry()
 				blk.readMin = math.MaxInt64
 				blk.readMax = math.MinInt64
 
@@ -1404,6 +1413,7 @@ RETRY:
 					blk.typ = typ
 					blk.b = b
 					blk.tombstones = tombstones
+					blk.entry = iter.entry()
 					blk.readMin = math.MaxInt64
 					blk.readMax = math.MinInt64
 				}
@@ -1493,6 +1503,20 @@ func (k *tsmKeyIterator) Read() ([]byte, int64, int64, []byte, error) {
 	return block.key, block.minTime, block.maxTime, block.b, k.err
 }
 
+// Entry returns the time range and statistics of the block returned by Read.
+// Blocks copied as is keep the statistics of their source entry and merged
+// blocks have the statistics of their values.
+func (k *tsmKeyIterator) Entry() IndexEntry {
+	if len(k.merged) == 0 {
+		return IndexEntry{}
+	}
+
+	block := k.merged[0]
+	e := block.entry
+	e.MinTime, e.MaxTime = block.minTime, block.maxTime
+	return e
+}
+
 func (k *tsmKeyIterator) Close() error {
 	k.values = nil
 	k.pos = nil
@@ -1526,6 +1550,7 @@ type cacheBlock struct {
 	k                []byte
 	minTime, maxTime int64
 	b                []byte
+	entry            IndexEntry
 	err              error
 }
 
@@ -1584,6 +1609,8 @@ func (c *cacheKeyIterator) encode() {
 			defer putStringEncoder(senc)
 			defer putIntegerEncoder(ienc)
 
+			var stats blockStatsReader
+
 			for {
 				i := int(atomic.AddUint64(&idx, uint64(chunkSize))) - chunkSize
 
@@ -1620,6 +1647,8 @@ func (c *cacheKeyIterator) encode() {
 						b, err = Values(values[:end]).Encode(nil)
 					}
 
+					var entry IndexEntry
+					stats.values(values[:end], &entry)
 					values = values[end:]
 
 					c.blocks[i] = append(c.blocks[i], cacheBlock{
@@ -1627,6 +1656,7 @@ func (c *cacheKeyIterator) encode() {
 						minTime: minTime,
 						maxTime: maxTime,
 						b:       b,
+						entry:   entry,
 						err:     err,
 					})
 
@@ -1671,6 +1701,14 @@ func (c *cacheKeyIterator) Read() ([]byte, int64, int64, []byte, error) {
 	return blk.k, blk.minTime, blk.maxTime, blk.b, blk.err
 }
 
+// Entry returns the time range and statistics of the block returned by Read.
+func (c *cacheKeyIterator) Entry() IndexEntry {
+	blk := c.blocks[c.i][0]
+	e := blk.entry
+	e.MinTime, e.MaxTime = blk.minTime, blk.maxTime
+	return e
+}
+
 func (c *cacheKeyIterator) Close() error {
 	return nil
 }
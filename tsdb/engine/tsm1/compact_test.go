@@ -1039,6 +1039,63 @@ func TestTSMKeyIterator_Single(t *testing.T) {
 	}
 }
 
+// Ensures blocks copied as is keep the statistics of their source entry and
+// merged blocks get the statistics of their values.
+func TestTSMKeyIterator_Entry(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	// The statistics of the full block are not those of its values, so they
+	// are only returned if they are copied without decoding the block.
+	block, err := tsm1.Values{tsm1.NewValue(1, 1.0), tsm1.NewValue(2, 2.0)}.Encode(nil)
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %v", err)
+	}
+	w, name := MustTSMWriter(dir, 1)
+	copied := tsm1.IndexEntry{MinTime: 1, MaxTime: 2, Count: 2, MinValue: math.Float64bits(-1), MaxValue: math.Float64bits(9)}
+	if err := w.WriteBlockEntry([]byte("cpu"), copied, block); err != nil {
+		t.Fatalf("unexpected error writing block: %v", err)
+	} else if err := w.WriteIndex(); err != nil {
+		t.Fatalf("unexpected error writing index: %v", err)
+	} else if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+
+	r1 := MustOpenTSMReader(name)
+	r2 := MustTSMReader(dir, 2, map[string][]tsm1.Value{"cpu": {tsm1.NewValue(10, 3.0)}})
+	r3 := MustTSMReader(dir, 3, map[string][]tsm1.Value{"cpu": {tsm1.NewValue(20, 4.0)}})
+
+	iter, err := tsm1.NewTSMKeyIterator(2, false, nil, r1, r2, r3)
+	if err != nil {
+		t.Fatalf("unexpected error creating WALKeyIterator: %v", err)
+	}
+
+	exp := []tsm1.IndexEntry{
+		copied,
+		{MinTime: 10, MaxTime: 20, Count: 2, MinValue: math.Float64bits(3), MaxValue: math.Float64bits(4),
+			SumValue: math.Float64bits(7), FirstValue: math.Float64bits(3), LastValue: math.Float64bits(4)},
+	}
+
+	var entries []tsm1.IndexEntry
+	for iter.Next() {
+		if _, _, _, _, err := iter.Read(); err != nil {
+			t.Fatalf("unexpected error read: %v", err)
+		}
+		entries = append(entries, iter.Entry())
+	}
+
+	if got, exp := len(entries), len(exp); got != exp {
+		t.Fatalf("entries length mismatch: got %v, exp %v", got, exp)
+	}
+	for i, e := range entries {
+		if e.MinTime != exp[i].MinTime || e.MaxTime != exp[i].MaxTime || e.Count != exp[i].Count ||
+			e.MinValue != exp[i].MinValue || e.MaxValue != exp[i].MaxValue || e.SumValue != exp[i].SumValue ||
+			e.FirstValue != exp[i].FirstValue || e.LastValue != exp[i].LastValue {
+			t.Fatalf("entry %d mismatch: got %+v, exp %+v", i, e, exp[i])
+		}
+	}
+}
+
 // Tests that duplicate point values are merged.  There is only one case
 // where this could happen and that is when a compaction completed and we replace
 // the old TSM file with a new one and we crash just before deleting the old file.
@@ -1331,6 +1388,38 @@ func TestCacheKeyIterator_Single(t *testing.T) {
 	}
 }
 
+// Ensures the blocks of the cache have the statistics of their values.
+func TestCacheKeyIterator_Entry(t *testing.T) {
+	c := tsm1.NewCache(0, "")
+	if err := c.Write([]byte("cpu"), []tsm1.Value{tsm1.NewValue(1, int64(5)), tsm1.NewValue(2, int64(-3)), tsm1.NewValue(3, int64(4))}); err != nil {
+		t.Fatalf("failed to write key cpu to cache: %s", err.Error())
+	}
+
+	iter := tsm1.NewCacheKeyIterator(c, 2, nil)
+	var entries []tsm1.IndexEntry
+	for iter.Next() {
+		if _, _, _, _, err := iter.Read(); err != nil {
+			t.Fatalf("unexpected error read: %v", err)
+		}
+		entries = append(entries, iter.Entry())
+	}
+
+	exp := []tsm1.IndexEntry{
+		{MinTime: 1, MaxTime: 2, Count: 2, MinValue: uint64(1<<64 - 3), MaxValue: 5, SumValue: 2, FirstValue: 5, LastValue: uint64(1<<64 - 3)},
+		{MinTime: 3, MaxTime: 3, Count: 1, MinValue: 4, MaxValue: 4, SumValue: 4, FirstValue: 4, LastValue: 4},
+	}
+	if got, exp := len(entries), len(exp); got != exp {
+		t.Fatalf("entries length mismatch: got %v, exp %v", got, exp)
+	}
+	for i, e := range entries {
+		if e.MinTime != exp[i].MinTime || e.MaxTime != exp[i].MaxTime || e.Count != exp[i].Count ||
+			e.MinValue != exp[i].MinValue || e.MaxValue != exp[i].MaxValue || e.SumValue != exp[i].SumValue ||
+			e.FirstValue != exp[i].FirstValue || e.LastValue != exp[i].LastValue {
+			t.Fatalf("entry %d mismatch: got %+v, exp %+v", i, e, exp[i])
+		}
+	}
+}
+
 func TestCacheKeyIterator_Chunked(t *testing.T) {
 	v0 := tsm1.NewValue(1, 1.0)
 	v1 := tsm1.NewValue(2, 2.0)
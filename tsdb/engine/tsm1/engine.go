@@ -880,7 +880,7 @@ func (e *Engine) filterFileToBackup(r *TSMReader, fi os.FileInfo, shardRelativeP
 		if minTime >= start && minTime <= end ||
 			maxTime >= start && maxTime <= end ||
 			minTime <= start && maxTime >= end {
-			err := w.WriteBlock(key, minTime, maxTime, buf)
+			err := w.WriteBlockEntry(key, bi.entry(), buf)
 			if err != nil {
 				return err
 			}
@@ -2074,6 +2074,11 @@ func (e *Engine) KeyCursor(ctx context.Context, key []byte, t int64, ascending b
 
 // CreateIterator returns an iterator for the measurement based on opt.
 func (e *Engine) CreateIterator(ctx context.Context, measurement string, opt query.IteratorOptions) (query.Iterator, error) {
+	// The metrics group is always attached so the cost of reading blocks can
+	// be reported through the iterator stats and checked against query limits.
+	group := metrics.NewGroup(tsmGroup)
+	ctx = metrics.NewContextWithGroup(ctx, group)
+
 	if span := tracing.SpanFromContext(ctx); span != nil {
 		labels := []string{"shard_id", strconv.Itoa(int(e.id)), "measurement", measurement}
 		if opt.Condition != nil {
@@ -2084,8 +2089,6 @@ func (e *Engine) CreateIterator(ctx context.Context, measurement string, opt que
 		span.SetLabels(labels...)
 		ctx = tracing.NewContextWithSpan(ctx, span)
 
-		group := metrics.NewGroup(tsmGroup)
-		ctx = metrics.NewContextWithGroup(ctx, group)
 		start := time.Now()
 
 		defer group.GetTimer(planningTimer).UpdateSince(start)
@@ -2150,6 +2153,13 @@ func (e *Engine) createCallIterator(ctx context.Context, measurement string, cal
 	// Calculate tag sets and apply SLIMIT/SOFFSET.
 	tagSets = query.LimitTagSets(tagSets, opt.SLimit, opt.SOffset)
 
+	// Reduce whole blocks from their aggregates if the call allows it.
+	callOpt := opt
+	agg := e.newBlockAggregator(measurement, call, opt)
+	if agg != nil {
+		callOpt = agg.callOptions(opt)
+	}
+
 	itrs := make([]query.Iterator, 0, len(tagSets))
 	if err := func() error {
 		for _, t := range tagSets {
@@ -2161,7 +2171,7 @@ func (e *Engine) createCallIterator(ctx context.Context, measurement string, cal
 			default:
 			}
 
-			inputs, err := e.createTagSetIterators(ctx, ref, measurement, t, opt)
+			inputs, err := e.createTagSetIterators(ctx, ref, measurement, t, agg, opt)
 			if err != nil {
 				return err
 			} else if len(inputs) == 0 {
@@ -2174,7 +2184,7 @@ func (e *Engine) createCallIterator(ctx context.Context, measurement string, cal
 					input = query.NewInterruptIterator(input, opt.InterruptCh)
 				}
 
-				itr, err := query.NewCallIterator(input, opt)
+				itr, err := query.NewCallIterator(input, callOpt)
 				if err != nil {
 					query.Iterators(inputs).Close()
 					return err
@@ -2223,7 +2233,7 @@ func (e *Engine) createVarRefIterator(ctx context.Context, measurement string, o
 	itrs := make([]query.Iterator, 0, len(tagSets))
 	if err := func() error {
 		for _, t := range tagSets {
-			inputs, err := e.createTagSetIterators(ctx, ref, measurement, t, opt)
+			inputs, err := e.createTagSetIterators(ctx, ref, measurement, t, nil, opt)
 			if err != nil {
 				return err
 			} else if len(inputs) == 0 {
@@ -2272,8 +2282,9 @@ func (e *Engine) createVarRefIterator(ctx context.Context, measurement string, o
 	return itrs, nil
 }
 
-// createTagSetIterators creates a set of iterators for a tagset.
-func (e *Engine) createTagSetIterators(ctx context.Context, ref *influxql.VarRef, name string, t *query.TagSet, opt query.IteratorOptions) ([]query.Iterator, error) {
+// createTagSetIterators creates a set of iterators for a tagset.  If agg is
+// not nil, the series cursors reduce whole blocks with it.
+func (e *Engine) createTagSetIterators(ctx context.Context, ref *influxql.VarRef, name string, t *query.TagSet, agg *blockAggregator, opt query.IteratorOptions) ([]query.Iterator, error) {
 	// Set parallelism by number of logical cpus.
 	parallelism := runtime.GOMAXPROCS(0)
 	if parallelism > len(t.SeriesKeys) {
@@ -2308,7 +2319,7 @@ func (e *Engine) createTagSetIterators(ctx context.Context, ref *influxql.VarRef
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			groups[i].itrs, groups[i].err = e.createTagSetGroupIterators(ctx, ref, name, groups[i].keys, t, groups[i].filters, opt)
+			groups[i].itrs, groups[i].err = e.createTagSetGroupIterators(ctx, ref, name, groups[i].keys, t, groups[i].filters, agg, opt)
 		}(i)
 	}
 	wg.Wait()
@@ -2339,7 +2350,7 @@ func (e *Engine) createTagSetIterators(ctx context.Context, ref *influxql.VarRef
 }
 
 // createTagSetGroupIterators creates a set of iterators for a subset of a tagset's series.
-func (e *Engine) createTagSetGroupIterators(ctx context.Context, ref *influxql.VarRef, name string, seriesKeys []string, t *query.TagSet, filters []influxql.Expr, opt query.IteratorOptions) ([]query.Iterator, error) {
+func (e *Engine) createTagSetGroupIterators(ctx context.Context, ref *influxql.VarRef, name string, seriesKeys []string, t *query.TagSet, filters []influxql.Expr, agg *blockAggregator, opt query.IteratorOptions) ([]query.Iterator, error) {
 	itrs := make([]query.Iterator, 0, len(seriesKeys))
 	for i, seriesKey := range seriesKeys {
 		var conditionFields []influxql.VarRef
@@ -2348,7 +2359,7 @@ func (e *Engine) createTagSetGroupIterators(ctx context.Context, ref *influxql.V
 			conditionFields = influxql.ExprNames(filters[i])
 		}
 
-		itr, err := e.createVarRefSeriesIterator(ctx, ref, name, seriesKey, t, filters[i], conditionFields, opt)
+		itr, err := e.createVarRefSeriesIterator(ctx, ref, name, seriesKey, t, filters[i], conditionFields, agg, opt)
 		if err != nil {
 			return itrs, err
 		} else if itr == nil {
@@ -2375,7 +2386,7 @@ func (e *Engine) createTagSetGroupIterators(ctx context.Context, ref *influxql.V
 }
 
 // createVarRefSeriesIterator creates an iterator for a variable reference for a series.
-func (e *Engine) createVarRefSeriesIterator(ctx context.Context, ref *influxql.VarRef, name string, seriesKey string, t *query.TagSet, filter influxql.Expr, conditionFields []influxql.VarRef, opt query.IteratorOptions) (query.Iterator, error) {
+func (e *Engine) createVarRefSeriesIterator(ctx context.Context, ref *influxql.VarRef, name string, seriesKey string, t *query.TagSet, filter influxql.Expr, conditionFields []influxql.VarRef, agg *blockAggregator, opt query.IteratorOptions) (query.Iterator, error) {
 	_, tfs := models.ParseKey([]byte(seriesKey))
 	tags := query.NewTags(tfs.Map())
 
@@ -2393,7 +2404,11 @@ func (e *Engine) createVarRefSeriesIterator(ctx context.Context, ref *influxql.V
 	// Build main cursor.
 	var cur cursor
 	if ref != nil {
-		cur = e.buildCursor(ctx, name, seriesKey, tfs, ref, opt)
+		if agg != nil {
+			cur = agg.buildCursor(ctx, e, seriesKey, ref.Val, filter, opt)
+		} else {
+			cur = e.buildCursor(ctx, name, seriesKey, tfs, ref, opt)
+		}
 		// If the field doesn't exist then don't build an iterator.
 		if cur == nil {
 			return nil, nil
@@ -2720,6 +2735,17 @@ func (e *Engine) IteratorCost(measurement string, opt query.IteratorOptions) (qu
 	return cost, nil
 }
 
+// EstimateSeriesCost returns an estimate of the cost of reading the values of
+// the field of the series between tmin and tmax. It is computed from the time
+// and key ranges of the TSM files and the cache without reading the TSM
+// indexes.
+func (e *Engine) EstimateSeriesCost(seriesKey, field string, tmin, tmax int64) query.IteratorCost {
+	key := SeriesFieldKeyBytes(seriesKey, field)
+	c := e.FileStore.EstimateCost(key, tmin, tmax)
+	c.CachedValues = int64(e.Cache.ValueCount(key, tmin, tmax))
+	return c
+}
+
 func (e *Engine) seriesCost(seriesKey, field string, tmin, tmax int64) query.IteratorCost {
 	key := SeriesFieldKeyBytes(seriesKey, field)
 	c := e.FileStore.Cost(key, tmin, tmax)
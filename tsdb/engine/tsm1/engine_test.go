@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -752,6 +753,68 @@ func TestEngine_CreateIterator_TSM_Ascending(t *testing.T) {
 	}
 }
 
+// Ensure the cost of reading blocks is reported in the iterator stats and
+// that block reads are only timed when the query asks for it.
+func TestEngine_CreateIterator_BlockStats(t *testing.T) {
+	t.Parallel()
+
+	e := MustOpenEngine(tsdb.DefaultIndex)
+	defer e.Close()
+
+	e.MeasurementFields([]byte("cpu")).CreateFieldIfNotExists([]byte("value"), influxql.Float)
+	e.CreateSeriesIfNotExists([]byte("cpu,host=A"), []byte("cpu"), models.NewTags(map[string]string{"host": "A"}))
+
+	if err := e.WritePointsString(
+		`cpu,host=A value=1.1 1000000000`,
+		`cpu,host=A value=1.2 2000000000`,
+		`cpu,host=A value=1.3 3000000000`,
+	); err != nil {
+		t.Fatalf("failed to write points: %s", err.Error())
+	}
+	e.MustWriteSnapshot()
+
+	for _, tt := range []struct {
+		name  string
+		ctx   context.Context
+		timed bool
+	}{
+		{name: "Untimed", ctx: context.Background()},
+		{name: "Timed", ctx: query.NewContextWithCursorTime(context.Background()), timed: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			itr, err := e.CreateIterator(tt.ctx, "cpu", query.IteratorOptions{
+				Expr:       influxql.MustParseExpr(`value`),
+				Dimensions: []string{"host"},
+				StartTime:  influxql.MinTime,
+				EndTime:    influxql.MaxTime,
+				Ascending:  true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer itr.Close()
+
+			fitr := itr.(query.FloatIterator)
+			for {
+				if p, err := fitr.Next(); err != nil {
+					t.Fatal(err)
+				} else if p == nil {
+					break
+				}
+			}
+
+			stats := itr.Stats()
+			if stats.BlocksN != 1 {
+				t.Fatalf("unexpected blocks decoded: %d", stats.BlocksN)
+			} else if stats.BlockBytes <= 0 {
+				t.Fatalf("unexpected block bytes: %d", stats.BlockBytes)
+			} else if timed := stats.CursorTime > 0; timed != tt.timed {
+				t.Fatalf("unexpected cursor time: %s", stats.CursorTime)
+			}
+		})
+	}
+}
+
 // Ensure engine can create an descending iterator for cached values.
 func TestEngine_CreateIterator_TSM_Descending(t *testing.T) {
 	t.Parallel()
@@ -931,6 +994,132 @@ func TestEngine_CreateIterator_Condition(t *testing.T) {
 	}
 }
 
+// Ensure calls reduced from block aggregates return the same points as the
+// same calls over the values of the series.
+func TestEngine_CreateIterator_BlockAggregates(t *testing.T) {
+	t.Parallel()
+
+	for _, index := range tsdb.RegisteredIndexes() {
+		t.Run(index, func(t *testing.T) {
+			e := MustOpenEngine(index)
+			defer e.Close()
+
+			e.MeasurementFields([]byte("cpu")).CreateFieldIfNotExists([]byte("value"), influxql.Float)
+			e.MeasurementFields([]byte("mem")).CreateFieldIfNotExists([]byte("n"), influxql.Integer)
+
+			// Write 3 snapshots of 1000 points per series, followed by 10 cached
+			// points.  Halves keep the float sums exact.
+			write := func(start, end int) {
+				var points []models.Point
+				for i := start; i < end; i++ {
+					ts := time.Unix(int64(i), 0)
+					for _, host := range []string{"A", "B"} {
+						tags := models.NewTags(map[string]string{"host": host})
+						points = append(points,
+							models.MustNewPoint("cpu", tags, models.Fields{"value": float64((i*7)%101-50) + 0.5}, ts),
+							models.MustNewPoint("mem", tags, models.Fields{"n": int64((i*13)%97 - 40)}, ts),
+						)
+					}
+				}
+				if err := e.writePoints(points...); err != nil {
+					t.Fatalf("failed to write points: %s", err.Error())
+				}
+			}
+			for i := 0; i < 3; i++ {
+				write(i*1000, (i+1)*1000)
+				e.MustWriteSnapshot()
+			}
+			write(3000, 3010)
+
+			for _, expr := range []string{
+				`count(value)`, `sum(value)`, `min(value)`, `max(value)`, `first(value)`, `last(value)`,
+				`count(n)`, `sum(n)`, `min(n)`, `max(n)`, `first(n)`, `last(n)`,
+				`histogram(value, bounds(-50, 0, 25, 50))`, `histogram(n, bounds(-40, 0, 60))`,
+			} {
+				call := influxql.MustParseExpr(expr).(*influxql.Call)
+				measurement := "cpu"
+				if call.Args[0].(*influxql.VarRef).Val == "n" {
+					measurement = "mem"
+				}
+
+				for _, ascending := range []bool{true, false} {
+					opt := query.IteratorOptions{
+						Expr:       call,
+						Dimensions: []string{"host"},
+						Interval:   query.Interval{Duration: 30 * time.Minute},
+						StartTime:  0,
+						EndTime:    int64(2*time.Hour) - 1,
+						Ascending:  ascending,
+					}
+
+					itr, err := e.CreateIterator(context.Background(), measurement, opt)
+					if err != nil {
+						t.Fatalf("%s: %v", expr, err)
+					}
+					got := readWindowPoints(t, itr, opt)
+
+					// Reduce the values of the series with the same call.
+					rawOpt := opt
+					rawOpt.Expr = call.Args[0]
+					raw, err := e.CreateIterator(context.Background(), measurement, rawOpt)
+					if err != nil {
+						t.Fatalf("%s: %v", expr, err)
+					}
+					itr, err = query.NewCallIterator(raw, opt)
+					if err != nil {
+						t.Fatalf("%s: %v", expr, err)
+					}
+					exp := readWindowPoints(t, itr, opt)
+
+					if len(exp) == 0 {
+						t.Fatalf("%s: no points", expr)
+					} else if !reflect.DeepEqual(got, exp) {
+						t.Fatalf("%s (ascending=%v): unexpected points:\ngot %v\nexp %v", expr, ascending, got, exp)
+					}
+				}
+			}
+		})
+	}
+}
+
+// readWindowPoints reads the points of itr as strings of their name, tags,
+// window and value, sorted.  Selectors reduced from block aggregates don't
+// record the time of their value, so only the window is compared.
+func readWindowPoints(t *testing.T, itr query.Iterator, opt query.IteratorOptions) []string {
+	defer itr.Close()
+
+	var a []string
+	add := func(name string, tags query.Tags, ts int64, v interface{}) {
+		start, _ := opt.Window(ts)
+		a = append(a, fmt.Sprintf("%s %s %d %v", name, tags.ID(), start, v))
+	}
+
+	for {
+		switch itr := itr.(type) {
+		case query.FloatIterator:
+			p, err := itr.Next()
+			if err != nil {
+				t.Fatal(err)
+			} else if p == nil {
+				sort.Strings(a)
+				return a
+			}
+			add(p.Name, p.Tags, p.Time, p.Value)
+		case query.IntegerIterator:
+			p, err := itr.Next()
+			if err != nil {
+				t.Fatal(err)
+			} else if p == nil {
+				sort.Strings(a)
+				return a
+			}
+			add(p.Name, p.Tags, p.Time, p.Value)
+		default:
+			t.Fatalf("unexpected iterator: %T", itr)
+		}
+	}
+}
+
 // Test that series id set gets updated and returned appropriately.
 func TestIndex_SeriesIDSet(t *testing.T) {
 	test := func(index string) error {
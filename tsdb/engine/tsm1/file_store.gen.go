@@ -6,8 +6,15 @@
 
 package tsm1
 
+import "time"
+
 // ReadFloatBlock reads the next block as a set of float values.
 func (c *KeyCursor) ReadFloatBlock(buf *[]FloatValue) ([]FloatValue, error) {
+	if c.timed {
+		start := time.Now()
+		defer func() { c.col.GetTimer(blocksReadTimer).Add(time.Since(start)) }()
+	}
+
 LOOP:
 	// No matching blocks to decode
 	if len(c.current) == 0 {
@@ -188,6 +195,11 @@ LOOP:
 
 // ReadIntegerBlock reads the next block as a set of integer values.
 func (c *KeyCursor) ReadIntegerBlock(buf *[]IntegerValue) ([]IntegerValue, error) {
+	if c.timed {
+		start := time.Now()
+		defer func() { c.col.GetTimer(blocksReadTimer).Add(time.Since(start)) }()
+	}
+
 LOOP:
 	// No matching blocks to decode
 	if len(c.current) == 0 {
@@ -368,6 +380,11 @@ LOOP:
 
 // ReadUnsignedBlock reads the next block as a set of unsigned values.
 func (c *KeyCursor) ReadUnsignedBlock(buf *[]UnsignedValue) ([]UnsignedValue, error) {
+	if c.timed {
+		start := time.Now()
+		defer func() { c.col.GetTimer(blocksReadTimer).Add(time.Since(start)) }()
+	}
+
 LOOP:
 	// No matching blocks to decode
 	if len(c.current) == 0 {
@@ -548,6 +565,11 @@ LOOP:
 
 // ReadStringBlock reads the next block as a set of string values.
 func (c *KeyCursor) ReadStringBlock(buf *[]StringValue) ([]StringValue, error) {
+	if c.timed {
+		start := time.Now()
+		defer func() { c.col.GetTimer(blocksReadTimer).Add(time.Since(start)) }()
+	}
+
 LOOP:
 	// No matching blocks to decode
 	if len(c.current) == 0 {
@@ -728,6 +750,11 @@ LOOP:
 
 // ReadBooleanBlock reads the next block as a set of boolean values.
 func (c *KeyCursor) ReadBooleanBlock(buf *[]BooleanValue) ([]BooleanValue, error) {
+	if c.timed {
+		start := time.Now()
+		defer func() { c.col.GetTimer(blocksReadTimer).Add(time.Since(start)) }()
+	}
+
 LOOP:
 	// No matching blocks to decode
 	if len(c.current) == 0 {
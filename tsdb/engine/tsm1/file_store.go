@@ -75,6 +75,9 @@ type TSMFile interface {
 	// KeyCount returns the number of distinct keys in the file.
 	KeyCount() int
 
+	// BlockCount returns the number of blocks in the file when it was opened.
+	BlockCount() int
+
 	// Seek returns the position in the index with the key <= key.
 	Seek(key []byte) int
 
@@ -109,6 +112,9 @@ type TSMFile interface {
 	// Size returns the size of the file on disk in bytes.
 	Size() uint32
 
+	// IndexSize returns the size of the index of the file in bytes.
+	IndexSize() uint32
+
 	// Rename renames the existing TSM file to a new name and replaces the mmap backing slice using the new
 	// file name.  Index and Reader state are not re-initialized.
 	Rename(path string) error
@@ -153,6 +159,7 @@ var (
 	stringBlocksSizeCounter      = metrics.MustRegisterCounter("string_blocks_size_bytes", metrics.WithGroup(tsmGroup))
 	booleanBlocksDecodedCounter  = metrics.MustRegisterCounter("boolean_blocks_decoded", metrics.WithGroup(tsmGroup))
 	booleanBlocksSizeCounter     = metrics.MustRegisterCounter("boolean_blocks_size_bytes", metrics.WithGroup(tsmGroup))
+	blocksReadTimer              = metrics.MustRegisterTimer("blocks_read_time", metrics.WithGroup(tsmGroup))
 )
 
 // FileStore is an abstraction around multiple TSM files.
@@ -571,6 +578,45 @@ func (f *FileStore) Cost(key []byte, min, max int64) query.IteratorCost {
 	return f.cost(key, min, max)
 }
 
+// EstimateCost returns an estimate of the cost of reading the values of key
+// between min and max. Unlike Cost, it does not read the index entries of the
+// key: every file whose time and key ranges overlap is counted, with the
+// blocks of an average key of the file that fall within min and max.
+func (f *FileStore) EstimateCost(key []byte, min, max int64) query.IteratorCost {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var c query.IteratorCost
+	for _, fd := range f.files {
+		if !fd.OverlapsTimeRange(min, max) || !fd.OverlapsKeyRange(key, key) {
+			continue
+		}
+
+		keys, blocks := fd.KeyCount(), fd.BlockCount()
+		if keys == 0 || blocks == 0 {
+			continue
+		}
+
+		// Assume the blocks of a key are spread evenly over the time range
+		// of the file.
+		tmin, tmax := fd.TimeRange()
+		lo, hi := tmin, tmax
+		if min > lo {
+			lo = min
+		}
+		if max < hi {
+			hi = max
+		}
+		frac := (float64(hi) - float64(lo) + 1) / (float64(tmax) - float64(tmin) + 1)
+
+		n := int64(math.Ceil(float64(blocks) / float64(keys) * frac))
+		c.NumFiles++
+		c.BlocksRead += n
+		c.BlockSize += int64(fd.Size()-fd.IndexSize()) * n / int64(blocks)
+	}
+	return c
+}
+
 // Reader returns a TSMReader for path if one is currently managed by the FileStore.
 // Otherwise it returns nil.
 func (f *FileStore) TSMReader(path string) *TSMReader {
@@ -588,7 +634,26 @@ func (f *FileStore) TSMReader(path string) *TSMReader {
 func (f *FileStore) KeyCursor(ctx context.Context, key []byte, t int64, ascending bool) *KeyCursor {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	return newKeyCursor(ctx, f, key, t, ascending)
+	return newKeyCursor(ctx, f, key, t, ascending, nil)
+}
+
+// FilteredKeyCursor returns a KeyCursor for key and t across the files in the
+// FileStore that skips the blocks rejected by filter.
+func (f *FileStore) FilteredKeyCursor(ctx context.Context, key []byte, t int64, ascending bool, filter BlockFilter) *KeyCursor {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return newKeyCursor(ctx, f, key, t, ascending, filter)
+}
+
+// AggregateKeyCursor returns a KeyCursor for key and t across the files in the
+// FileStore and the index entries of the blocks accepted by whole, which the
+// cursor skips.  Only the blocks with aggregates that overlap no other block
+// and have no tombstones are passed to whole.
+func (f *FileStore) AggregateKeyCursor(ctx context.Context, key []byte, t int64, ascending bool, whole BlockFilter) (*KeyCursor, []IndexEntry) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	seeks, entries := splitAggregateLocations(key, f.locations(key, t, ascending), whole)
+	return newLocationsKeyCursor(ctx, key, t, ascending, seeks), entries
 }
 
 // Stats returns the stats of the underlying files, preferring the cached version if it is still valid.
@@ -1036,6 +1101,9 @@ type KeyCursor struct {
 	ctx context.Context
 	col *metrics.Group
 
+	// timed is set if the time spent reading blocks is recorded in col.
+	timed bool
+
 	// pos is the index within seeks.  Based on ascending, it will increment or
 	// decrement through the size of seeks slice.
 	pos       int
@@ -1087,16 +1155,28 @@ func (a ascLocations) Less(i, j int) bool {
 	return a[i].entry.MinTime < a[j].entry.MinTime
 }
 
-// newKeyCursor returns a new instance of KeyCursor.
+// newKeyCursor returns a new instance of KeyCursor.  If filter is not nil,
+// the blocks it rejects are skipped.
 // This function assumes the read-lock has been taken.
-func newKeyCursor(ctx context.Context, fs *FileStore, key []byte, t int64, ascending bool) *KeyCursor {
+func newKeyCursor(ctx context.Context, fs *FileStore, key []byte, t int64, ascending bool, filter BlockFilter) *KeyCursor {
+	seeks := fs.locations(key, t, ascending)
+	if filter != nil {
+		seeks = filterLocations(seeks, filter)
+	}
+	return newLocationsKeyCursor(ctx, key, t, ascending, seeks)
+}
+
+// newLocationsKeyCursor returns a new instance of KeyCursor over the given
+// block locations of key.
+func newLocationsKeyCursor(ctx context.Context, key []byte, t int64, ascending bool, seeks []*location) *KeyCursor {
 	c := &KeyCursor{
 		key:       key,
-		seeks:     fs.locations(key, t, ascending),
+		seeks:     seeks,
 		ctx:       ctx,
 		col:       metrics.GroupFromContext(ctx),
 		ascending: ascending,
 	}
+	c.timed = c.col != nil && query.CursorTimeFromContext(ctx)
 
 	if ascending {
 		sort.Sort(ascLocations(c.seeks))
@@ -135,7 +135,8 @@ func newMockTSMFile(keys ...string) *mockTSMFile {
 	return &mockTSMFile{keys: keys}
 }
 
-func (t *mockTSMFile) KeyCount() int { return len(t.keys) }
+func (t *mockTSMFile) KeyCount() int   { return len(t.keys) }
+func (t *mockTSMFile) BlockCount() int { return len(t.keys) }
 
 func (t *mockTSMFile) Seek(key []byte) int {
 	k := string(key)
@@ -168,6 +169,7 @@ func (*mockTSMFile) HasTombstones() bool
 func (*mockTSMFile) TombstoneFiles() []FileStat                                 { panic("implement me") }
 func (*mockTSMFile) Close() error                                               { panic("implement me") }
 func (*mockTSMFile) Size() uint32                                               { panic("implement me") }
+func (*mockTSMFile) IndexSize() uint32                                          { panic("implement me") }
 func (*mockTSMFile) Rename(path string) error                                   { panic("implement me") }
 func (*mockTSMFile) Remove() error                                              { panic("implement me") }
 func (*mockTSMFile) InUse() bool                                                { panic("implement me") }
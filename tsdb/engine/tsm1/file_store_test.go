@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -53,6 +54,66 @@ func TestFileStore_Read(t *testing.T) {
 	}
 }
 
+// Ensure the cost of reading a key is estimated from the files overlapping
+// the key and time range.
+func TestFileStore_EstimateCost(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+	fs := tsm1.NewFileStore(dir)
+
+	// Write a file holding 2 blocks for each of cpu and mem.
+	f := MustTempFile(dir)
+	w, err := tsm1.NewTSMWriter(f)
+	if err != nil {
+		t.Fatalf("unexpected error creating writer: %v", err)
+	}
+	for _, key := range []string{"cpu", "mem"} {
+		for i := 0; i < 2; i++ {
+			var values []tsm1.Value
+			for j := 0; j < 1000; j++ {
+				values = append(values, tsm1.NewValue(int64(i*1000+j), float64(j)))
+			}
+			if err := w.Write([]byte(key), values); err != nil {
+				t.Fatalf("unexpected error writing: %v", err)
+			}
+		}
+	}
+	if err := w.WriteIndex(); err != nil {
+		t.Fatalf("unexpected error writing index: %v", err)
+	} else if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+
+	path := filepath.Join(dir, tsmFileName(1))
+	if err := os.Rename(f.Name(), path); err != nil {
+		t.Fatalf("unexpected error renaming: %v", err)
+	}
+	fs.Replace(nil, []string{path})
+
+	for _, tt := range []struct {
+		key        string
+		min, max   int64
+		files      int64
+		blocksRead int64
+	}{
+		{key: "cpu", min: 0, max: 1999, files: 1, blocksRead: 2},
+		{key: "cpu", min: 0, max: 499, files: 1, blocksRead: 1},
+		{key: "cpu", min: 2000, max: 3000, files: 0, blocksRead: 0},
+		{key: "zzz", min: 0, max: 1999, files: 0, blocksRead: 0},
+	} {
+		c := fs.EstimateCost([]byte(tt.key), tt.min, tt.max)
+		if got, exp := c.NumFiles, tt.files; got != exp {
+			t.Fatalf("files mismatch (%s %d-%d): got %v, exp %v", tt.key, tt.min, tt.max, got, exp)
+		}
+		if got, exp := c.BlocksRead, tt.blocksRead; got != exp {
+			t.Fatalf("blocks read mismatch (%s %d-%d): got %v, exp %v", tt.key, tt.min, tt.max, got, exp)
+		}
+		if (c.BlockSize > 0) != (tt.blocksRead > 0) {
+			t.Fatalf("unexpected block size (%s %d-%d): %v", tt.key, tt.min, tt.max, c.BlockSize)
+		}
+	}
+}
+
 func TestFileStore_SeekToAsc_FromStart(t *testing.T) {
 	dir := MustTempDir()
 	defer os.RemoveAll(dir)
@@ -2038,6 +2099,75 @@ func TestFileStore_SeekToDesc_End(t *testing.T) {
 	}
 }
 
+func TestFileStore_AggregateKeyCursor(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+	fs := tsm1.NewFileStore(dir)
+
+	// The third and fourth blocks overlap and the last one has a tombstone.
+	data := []keyValues{
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(0, 1.0), tsm1.NewValue(1, 2.0)}},
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(10, 3.0), tsm1.NewValue(11, 4.0)}},
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(20, 5.0), tsm1.NewValue(21, 6.0)}},
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(21, 7.0), tsm1.NewValue(22, 8.0)}},
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(30, 9.0), tsm1.NewValue(31, 10.0)}},
+	}
+
+	files, err := newFiles(dir, data...)
+	if err != nil {
+		t.Fatalf("unexpected error creating files: %v", err)
+	}
+
+	fs.Replace(nil, files)
+
+	if err := fs.DeleteRange([][]byte{[]byte("cpu")}, 31, 31); err != nil {
+		t.Fatalf("unexpected error delete range: %v", err)
+	}
+
+	c, entries := fs.AggregateKeyCursor(context.Background(), []byte("cpu"), 0, true, func(*tsm1.IndexEntry) bool { return true })
+	defer c.Close()
+
+	if got, exp := len(entries), 2; got != exp {
+		t.Fatalf("entries length mismatch: got %v, exp %v", got, exp)
+	}
+	for i, exp := range []struct {
+		minTime          int64
+		count            uint32
+		sum, first, last float64
+	}{
+		{minTime: 0, count: 2, sum: 3, first: 1, last: 2},
+		{minTime: 10, count: 2, sum: 7, first: 3, last: 4},
+	} {
+		e := entries[i]
+		if e.MinTime != exp.minTime || e.Count != exp.count ||
+			math.Float64frombits(e.SumValue) != exp.sum ||
+			math.Float64frombits(e.FirstValue) != exp.first ||
+			math.Float64frombits(e.LastValue) != exp.last {
+			t.Fatalf("entry %d mismatch: got %v", i, e)
+		}
+	}
+
+	// The cursor only reads the remaining blocks.
+	buf := make([]tsm1.FloatValue, 1000)
+	var times []int64
+	for {
+		values, err := c.ReadFloatBlock(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading values: %v", err)
+		} else if len(values) == 0 {
+			break
+		}
+		for _, v := range values {
+			times = append(times, v.UnixNano())
+		}
+		c.Next()
+	}
+
+	if got, exp := times, []int64{20, 21, 22, 30}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("read times mismatch: got %v, exp %v", got, exp)
+	}
+}
+
 func TestKeyCursor_TombstoneRange(t *testing.T) {
 	dir := MustTempDir()
 	defer os.RemoveAll(dir)
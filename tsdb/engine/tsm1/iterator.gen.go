@@ -170,12 +170,22 @@ func (itr *floatInstrumentedIterator) Close() error {
 			panic("unexpected metrics")
 		}
 	})
-	itr.span.SetFields(f)
-	itr.span.Finish()
+	if itr.span != nil {
+		itr.span.SetFields(f)
+		itr.span.Finish()
+	}
 
 	return itr.FloatIterator.Close()
 }
 
+// Stats returns stats from the underlying iterator along with the cost of
+// the blocks read on its behalf.
+func (itr *floatInstrumentedIterator) Stats() query.IteratorStats {
+	stats := itr.FloatIterator.Stats()
+	stats.Add(blockStats(itr.group))
+	return stats
+}
+
 type floatIterator struct {
 	cur   floatCursor
 	aux   []cursorAt
@@ -634,12 +644,22 @@ func (itr *integerInstrumentedIterator) Close() error {
 			panic("unexpected metrics")
 		}
 	})
-	itr.span.SetFields(f)
-	itr.span.Finish()
+	if itr.span != nil {
+		itr.span.SetFields(f)
+		itr.span.Finish()
+	}
 
 	return itr.IntegerIterator.Close()
 }
 
+// Stats returns stats from the underlying iterator along with the cost of
+// the blocks read on its behalf.
+func (itr *integerInstrumentedIterator) Stats() query.IteratorStats {
+	stats := itr.IntegerIterator.Stats()
+	stats.Add(blockStats(itr.group))
+	return stats
+}
+
 type integerIterator struct {
 	cur   integerCursor
 	aux   []cursorAt
@@ -1098,12 +1118,22 @@ func (itr *unsignedInstrumentedIterator) Close() error {
 			panic("unexpected metrics")
 		}
 	})
-	itr.span.SetFields(f)
-	itr.span.Finish()
+	if itr.span != nil {
+		itr.span.SetFields(f)
+		itr.span.Finish()
+	}
 
 	return itr.UnsignedIterator.Close()
 }
 
+// Stats returns stats from the underlying iterator along with the cost of
+// the blocks read on its behalf.
+func (itr *unsignedInstrumentedIterator) Stats() query.IteratorStats {
+	stats := itr.UnsignedIterator.Stats()
+	stats.Add(blockStats(itr.group))
+	return stats
+}
+
 type unsignedIterator struct {
 	cur   unsignedCursor
 	aux   []cursorAt
@@ -1562,12 +1592,22 @@ func (itr *stringInstrumentedIterator) Close() error {
 			panic("unexpected metrics")
 		}
 	})
-	itr.span.SetFields(f)
-	itr.span.Finish()
+	if itr.span != nil {
+		itr.span.SetFields(f)
+		itr.span.Finish()
+	}
 
 	return itr.StringIterator.Close()
 }
 
+// Stats returns stats from the underlying iterator along with the cost of
+// the blocks read on its behalf.
+func (itr *stringInstrumentedIterator) Stats() query.IteratorStats {
+	stats := itr.StringIterator.Stats()
+	stats.Add(blockStats(itr.group))
+	return stats
+}
+
 type stringIterator struct {
 	cur   stringCursor
 	aux   []cursorAt
@@ -2026,12 +2066,22 @@ func (itr *booleanInstrumentedIterator) Close() error {
 			panic("unexpected metrics")
 		}
 	})
-	itr.span.SetFields(f)
-	itr.span.Finish()
+	if itr.span != nil {
+		itr.span.SetFields(f)
+		itr.span.Finish()
+	}
 
 	return itr.BooleanIterator.Close()
 }
 
+// Stats returns stats from the underlying iterator along with the cost of
+// the blocks read on its behalf.
+func (itr *booleanInstrumentedIterator) Stats() query.IteratorStats {
+	stats := itr.BooleanIterator.Stats()
+	stats.Add(blockStats(itr.group))
+	return stats
+}
+
 type booleanIterator struct {
 	cur   booleanCursor
 	aux   []cursorAt
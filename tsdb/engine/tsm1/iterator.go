@@ -197,7 +197,7 @@ func newInstrumentedIterator(ctx context.Context, itr query.Iterator) query.Iter
 
 	span := tracing.SpanFromContext(ctx)
 	grp := metrics.GroupFromContext(ctx)
-	if span == nil || grp == nil {
+	if grp == nil {
 		return itr
 	}
 
@@ -216,3 +216,28 @@ func newInstrumentedIterator(ctx context.Context, itr query.Iterator) query.Iter
 		panic(fmt.Sprintf("unsupported instrumented iterator type: %T", itr))
 	}
 }
+
+// blockStats returns the cost of reading blocks recorded in the group.
+func blockStats(group *metrics.Group) query.IteratorStats {
+	var stats query.IteratorStats
+	for _, id := range []metrics.ID{
+		floatBlocksDecodedCounter,
+		integerBlocksDecodedCounter,
+		unsignedBlocksDecodedCounter,
+		stringBlocksDecodedCounter,
+		booleanBlocksDecodedCounter,
+	} {
+		stats.BlocksN += int(group.GetCounter(id).Value())
+	}
+	for _, id := range []metrics.ID{
+		floatBlocksSizeCounter,
+		integerBlocksSizeCounter,
+		unsignedBlocksSizeCounter,
+		stringBlocksSizeCounter,
+		booleanBlocksSizeCounter,
+	} {
+		stats.BlockBytes += group.GetCounter(id).Value()
+	}
+	stats.CursorTime = group.GetTimer(blocksReadTimer).Value()
+	return stats
+}
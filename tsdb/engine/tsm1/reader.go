@@ -88,6 +88,9 @@ type TSMIndex interface {
 	// KeyCount returns the count of unique keys in the index.
 	KeyCount() int
 
+	// BlockCount returns the count of blocks in the index when it was loaded.
+	BlockCount() int
+
 	// Seek returns the position in the index where key <= value in the index.
 	Seek(key []byte) int
 
@@ -199,6 +202,11 @@ func (b *BlockIterator) Read() (key []byte, minTime int64, maxTime int64, typ by
 	return b.key, b.entries[0].MinTime, b.entries[0].MaxTime, b.typ, checksum, buf, err
 }
 
+// entry returns the index entry of the next block to be iterated.
+func (b *BlockIterator) entry() IndexEntry {
+	return b.entries[0]
+}
+
 // Err returns any errors encounter during iteration.
 func (b *BlockIterator) Err() error {
 	return b.err
@@ -530,6 +538,11 @@ func (t *TSMReader) KeyCount() int {
 	return t.index.KeyCount()
 }
 
+// BlockCount returns the count of blocks in the TSMReader when it was opened.
+func (t *TSMReader) BlockCount() int {
+	return t.index.BlockCount()
+}
+
 // Entries returns all index entries for key.
 func (t *TSMReader) Entries(key []byte) []IndexEntry {
 	return t.index.Entries(key)
@@ -751,14 +764,18 @@ type indirectIndex struct {
 
 	// When we have identified the correct position in the index for a given
 	// key, we could perform another binary search or a linear scan.  This
-	// should be fast as well since each index entry is 28 bytes and all
-	// contiguous in memory.  The current implementation uses a linear scan since the
+	// should be fast as well since each index entry is 72 bytes (28 bytes in
+	// version 1 files) and all contiguous in memory.  The current implementation uses a linear scan since the
 	// number of block entries is expected to be < 100 per key.
 
 	// b is the underlying index byte slice.  This could be a copy on the heap or an MMAP
 	// slice reference
 	b []byte
 
+	// entrySize is the size of the index entries in b, which depends on the
+	// version of the file.
+	entrySize int
+
 	// offsets contains the positions in b for each key.  It points to the 2 byte length of
 	// key.
 	offsets []byte
@@ -771,6 +788,9 @@ type indirectIndex struct {
 	// series.
 	minTime, maxTime int64
 
+	// blockCount is the number of index entries when the index was loaded.
+	blockCount int
+
 	// tombstones contains only the tombstoned keys with subset of time values deleted.  An
 	// entry would exist here if a subset of the points for a key were deleted and the file
 	// had not be re-compacted to remove the points on disk.
@@ -789,6 +809,7 @@ func (t TimeRange) Overlaps(min, max int64) bool {
 // NewIndirectIndex returns a new indirect index.
 func NewIndirectIndex() *indirectIndex {
 	return &indirectIndex{
+		entrySize:  indexEntrySize,
 		tombstones: make(map[string][]TimeRange),
 	}
 }
@@ -887,7 +908,7 @@ func (d *indirectIndex) readEntriesAt(ofs int, entries *[]IndexEntry) ([]byte, [
 	if entries != nil {
 		ie.entries = *entries
 	}
-	if _, err := readEntries(d.b[ofs:], &ie); err != nil {
+	if _, err := readEntries(d.b[ofs:], &ie, d.entrySize); err != nil {
 		panic(fmt.Sprintf("error reading entries: %v", err))
 	}
 	if entries != nil {
@@ -947,7 +968,7 @@ func (d *indirectIndex) Key(idx int, entries *[]IndexEntry) ([]byte, byte, []Ind
 	if entries != nil {
 		ie.entries = *entries
 	}
-	if _, err := readEntries(d.b[int(ofs)+n:], &ie); err != nil {
+	if _, err := readEntries(d.b[int(ofs)+n:], &ie, d.entrySize); err != nil {
 		return nil, 0, nil
 	}
 	if entries != nil {
@@ -982,6 +1003,15 @@ func (d *indirectIndex) KeyCount() int {
 	return n
 }
 
+// BlockCount returns the count of blocks in the index when it was loaded.
+// Deleting keys does not change the count.
+func (d *indirectIndex) BlockCount() int {
+	d.mu.RLock()
+	n := d.blockCount
+	d.mu.RUnlock()
+	return n
+}
+
 // Delete removes the given keys from the index.
 func (d *indirectIndex) Delete(keys [][]byte) {
 	if len(keys) == 0 {
@@ -1246,6 +1276,7 @@ func (d *indirectIndex) UnmarshalBinary(b []byte) error {
 	// field.
 	var i int32
 	var offsets []int32
+	var blocks int
 	iMax := int32(len(b))
 	for i < iMax {
 		offsets = append(offsets, i)
@@ -1263,6 +1294,7 @@ func (d *indirectIndex) UnmarshalBinary(b []byte) error {
 		}
 		count := int32(binary.BigEndian.Uint16(b[i : i+indexCountSize]))
 		i += indexCountSize
+		blocks += int(count)
 
 		// Find the min time for the block
 		if i+8 >= iMax {
@@ -1273,7 +1305,7 @@ func (d *indirectIndex) UnmarshalBinary(b []byte) error {
 			minTime = minT
 		}
 
-		i += (count - 1) * indexEntrySize
+		i += (count - 1) * int32(d.entrySize)
 
 		// Find the max time for the block
 		if i+16 >= iMax {
@@ -1284,7 +1316,7 @@ func (d *indirectIndex) UnmarshalBinary(b []byte) error {
 			maxTime = maxT
 		}
 
-		i += indexEntrySize
+		i += int32(d.entrySize)
 	}
 
 	firstOfs := offsets[0]
@@ -1297,6 +1329,7 @@ func (d *indirectIndex) UnmarshalBinary(b []byte) error {
 
 	d.minTime = minTime
 	d.maxTime = maxTime
+	d.blockCount = blocks
 
 	var err error
 	d.offsets, err = mmap(nil, 0, len(offsets)*4)
@@ -1345,12 +1378,11 @@ func (m *mmapAccessor) init() (*indirectIndex, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := verifyVersion(m.f); err != nil {
+	version, err := readVersion(m.f)
+	if err != nil {
 		return nil, err
 	}
 
-	var err error
-
 	if _, err := m.f.Seek(0, 0); err != nil {
 		return nil, err
 	}
@@ -1375,6 +1407,10 @@ func (m *mmapAccessor) init() (*indirectIndex, error) {
 	}
 
 	m.index = NewIndirectIndex()
+	switch version {
+	case 1:
+		m.index.entrySize = indexEntrySizeV1
+	}
 	if err := m.index.UnmarshalBinary(m.b[indexStart:indexOfsPos]); err != nil {
 		return nil, err
 	}
@@ -1718,7 +1754,9 @@ func readKey(b []byte) (n int, key []byte) {
 	return
 }
 
-func readEntries(b []byte, entries *indexEntries) (n int, err error) {
+// readEntries reads the block type and index entries of a key.  The size of
+// the entries depends on the version of the file.
+func readEntries(b []byte, entries *indexEntries, entrySize int) (n int, err error) {
 	if len(b) < 1+indexCountSize {
 		return 0, fmt.Errorf("readEntries: data too short for headers")
 	}
@@ -1739,13 +1777,13 @@ func readEntries(b []byte, entries *indexEntries) (n int, err error) {
 
 	b = b[indexCountSize+indexTypeSize:]
 	for i := 0; i < len(entries.entries); i++ {
-		if err = entries.entries[i].UnmarshalBinary(b); err != nil {
+		if err = entries.entries[i].unmarshalBinary(b, entrySize); err != nil {
 			return 0, fmt.Errorf("readEntries: unmarshal error: %v", err)
 		}
-		b = b[indexEntrySize:]
+		b = b[entrySize:]
 	}
 
-	n += count * indexEntrySize
+	n += count * entrySize
 
 	return
 }
@@ -1,7 +1,9 @@
 package tsm1
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io/ioutil"
 	"math"
 	"os"
@@ -56,6 +58,111 @@ func TestTSMReader_Type(t *testing.T) {
 	}
 }
 
+func TestTSMReader_BlockStats(t *testing.T) {
+	dir := mustTempDir()
+	defer os.RemoveAll(dir)
+	f := mustTempFile(dir)
+
+	w, err := NewTSMWriter(f)
+	if err != nil {
+		t.Fatalf("unexpected error creating writer: %v", err)
+	}
+
+	if err := w.Write([]byte("cpu"), []Value{NewValue(0, 3.5), NewValue(1, -2.0), NewValue(2, 8.25)}); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+
+	block, err := Values([]Value{NewValue(0, int64(7)), NewValue(1, int64(-4))}).Encode(nil)
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %v", err)
+	}
+	if err := w.WriteBlock([]byte("mem"), 0, 1, block); err != nil {
+		t.Fatalf("unexpected error writing block: %v", err)
+	}
+
+	if err := w.WriteIndex(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+
+	f, err = os.Open(f.Name())
+	if err != nil {
+		t.Fatalf("unexpected error opening: %v", err)
+	}
+	r, err := NewTSMReader(f)
+	if err != nil {
+		t.Fatalf("unexpected error created reader: %v", err)
+	}
+	defer r.Close()
+
+	for _, tt := range []struct {
+		key              string
+		typ              byte
+		count            uint32
+		min, max         float64
+		sum, first, last uint64
+	}{
+		{key: "cpu", typ: BlockFloat64, count: 3, min: -2, max: 8.25, sum: math.Float64bits(9.75), first: math.Float64bits(3.5), last: math.Float64bits(8.25)},
+		{key: "mem", typ: BlockInteger, count: 2, min: -4, max: 7, sum: 3, first: 7, last: uint64(1<<64 - 4)},
+	} {
+		entries := r.Entries([]byte(tt.key))
+		if len(entries) != 1 {
+			t.Fatalf("%s: entries length mismatch: got %v, exp %v", tt.key, len(entries), 1)
+		}
+
+		min, max, ok := entries[0].valueRange(tt.typ)
+		if got := entries[0].Count; !ok || got != tt.count || min != tt.min || max != tt.max {
+			t.Fatalf("%s: stats mismatch: got %d %v %v, exp %d %v %v", tt.key, got, min, max, tt.count, tt.min, tt.max)
+		}
+
+		e := entries[0]
+		if e.SumValue != tt.sum || e.FirstValue != tt.first || e.LastValue != tt.last {
+			t.Fatalf("%s: aggregates mismatch: got %d %d %d, exp %d %d %d", tt.key, e.SumValue, e.FirstValue, e.LastValue, tt.sum, tt.first, tt.last)
+		}
+	}
+}
+
+func TestTSMReader_Version1(t *testing.T) {
+	dir := mustTempDir()
+	defer os.RemoveAll(dir)
+	f := mustTempFile(dir)
+
+	values := []Value{NewValue(1, 1.5), NewValue(2, 2.5)}
+	entry := mustWriteVersion1(t, f, "cpu", values)
+
+	f, err := os.Open(f.Name())
+	if err != nil {
+		t.Fatalf("unexpected error opening: %v", err)
+	}
+	r, err := NewTSMReader(f)
+	if err != nil {
+		t.Fatalf("unexpected error created reader: %v", err)
+	}
+	defer r.Close()
+
+	entries := r.Entries([]byte("cpu"))
+	if len(entries) != 1 {
+		t.Fatalf("entries length mismatch: got %v, exp %v", len(entries), 1)
+	} else if got, exp := entries[0], entry; got != exp {
+		t.Fatalf("entry mismatch: got %v, exp %v", got, exp)
+	}
+
+	readValues, err := r.ReadAll([]byte("cpu"))
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if len(readValues) != len(values) {
+		t.Fatalf("read values length mismatch: got %v, exp %v", len(readValues), len(values))
+	}
+	for i, v := range values {
+		if v.Value() != readValues[i].Value() {
+			t.Fatalf("read value mismatch(%d): got %v, exp %v", i, readValues[i].Value(), v.Value())
+		}
+	}
+}
+
 func TestTSMReader_MMAP_ReadAll(t *testing.T) {
 	dir := mustTempDir()
 	defer os.RemoveAll(dir)
@@ -1940,3 +2047,51 @@ func BenchmarkBlockIterator_Next(b *testing.B) {
 		}
 	}
 }
+
+// mustWriteVersion1 writes a version 1 file holding a block of values for key
+// to f and closes it.  Returns the index entry of the block.
+func mustWriteVersion1(t *testing.T, f *os.File, key string, values []Value) IndexEntry {
+	block, err := Values(values).Encode(nil)
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %v", err)
+	}
+	typ, err := BlockType(block)
+	if err != nil {
+		t.Fatalf("unexpected error reading block type: %v", err)
+	}
+
+	// A version 1 file has the same blocks and 28 byte index entries without statistics.
+	var b []byte
+	b = append(b, 0, 0, 0, 0, 1)
+	binary.BigEndian.PutUint32(b[0:4], MagicNumber)
+
+	var crc [4]byte
+	binary.BigEndian.PutUint32(crc[:], crc32.ChecksumIEEE(block))
+	b = append(append(b, crc[:]...), block...)
+
+	n := 2 + len(key)
+	index := make([]byte, n+indexTypeSize+indexCountSize+indexEntrySizeV1)
+	binary.BigEndian.PutUint16(index[0:2], uint16(len(key)))
+	copy(index[2:n], key)
+	index[n] = typ
+	binary.BigEndian.PutUint16(index[n+1:n+3], 1)
+	entry := IndexEntry{
+		MinTime: values[0].UnixNano(),
+		MaxTime: values[len(values)-1].UnixNano(),
+		Offset:  5,
+		Size:    uint32(len(crc) + len(block)),
+	}
+	copy(index[n+3:], entry.AppendTo(nil)[:indexEntrySizeV1])
+
+	var footer [8]byte
+	binary.BigEndian.PutUint64(footer[:], uint64(len(b)))
+	b = append(append(b, index...), footer[:]...)
+
+	if _, err := f.Write(b); err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+	return entry
+}
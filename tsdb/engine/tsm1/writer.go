@@ -36,7 +36,10 @@ composed of a sequence of index entries ordered lexicographically by key and
 then by time.  Each index entry starts with a key length and key followed by a
 count of the number of blocks in the file.  Each block entry is composed of
 the min and max time for the block, the offset into the file where the block
-is located and the the size of the block.
+is located, the the size of the block and the statistics of its values: the
+number of values, the min and max value and the sum, first and last value.
+Version 1 files have no block statistics and their block entries end after the
+size.
 
 The index structure can provide efficient access to all blocks as well as the
 ability to determine the cost associated with acessing a given key.  Given a key
@@ -45,12 +48,17 @@ timestamp as well as where that block resides and how much data to read to
 retrieve the block.  If we know we need to read all or multiple blocks in a
 file, we can use the size to determine how much to read in a given IO.
 
-┌────────────────────────────────────────────────────────────────────────────┐
-│                                   Index                                    │
-├─────────┬─────────┬──────┬───────┬─────────┬─────────┬────────┬────────┬───┤
-│ Key Len │   Key   │ Type │ Count │Min Time │Max Time │ Offset │  Size  │...│
-│ 2 bytes │ N bytes │1 byte│2 bytes│ 8 bytes │ 8 bytes │8 bytes │4 bytes │   │
-└─────────┴─────────┴──────┴───────┴─────────┴─────────┴────────┴────────┴───┘
+┌────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────────┐
+│                                                           Index                                                            │
+├─────────┬─────────┬──────┬───────┬─────────┬─────────┬────────┬────────┬───────┬───────┬───────┬───────┬───────┬───────┬───┤
+│ Key Len │   Key   │ Type │ Count │Min Time │Max Time │ Offset │  Size  │ Count │  Min  │  Max  │  Sum  │ First │ Last  │...│
+│ 2 bytes │ N bytes │1 byte│2 bytes│ 8 bytes │ 8 bytes │8 bytes │4 bytes │4 bytes│8 bytes│8 bytes│8 bytes│8 bytes│8 bytes│   │
+└─────────┴─────────┴──────┴───────┴─────────┴─────────┴────────┴────────┴───────┴───────┴───────┴───────┴───────┴───────┴───┘
+
+The min, max, first and last value of a block are stored as the bits of a
+float64, int64 or uint64 value, or as 0 and 1 for false and true.  The sum is
+stored the same way for numeric blocks and is zero for boolean blocks.  They are
+all zero for string blocks.
 
 The last section is the footer that stores the offset of the start of the index.
 
@@ -81,10 +89,13 @@ const (
 	MagicNumber uint32 = 0x16D116D1
 
 	// Version indicates the version of the TSM file format.
-	Version byte = 1
+	Version byte = 2
 
 	// Size in bytes of an index entry
-	indexEntrySize = 28
+	indexEntrySize = 72
+
+	// Size in bytes of an index entry in a version 1 file, which has no block statistics
+	indexEntrySizeV1 = 28
 
 	// Size in bytes used to store the count of index entries for a key
 	indexCountSize = 2
@@ -135,6 +146,11 @@ type TSMWriter interface {
 	// timestamp values are used as the minimum and maximum values for the index entry.
 	WriteBlock(key []byte, minTime, maxTime int64, block []byte) error
 
+	// WriteBlockEntry writes a new block for key like WriteBlock, using the time range
+	// and statistics of entry for the index entry.  The offset and size of entry are
+	// ignored.  The statistics are computed from block if entry has none.
+	WriteBlockEntry(key []byte, entry IndexEntry, block []byte) error
+
 	// WriteIndex finishes the TSM write streams and writes the index.
 	WriteIndex() error
 
@@ -155,6 +171,9 @@ type IndexWriter interface {
 	// Add records a new block entry for a key in the index.
 	Add(key []byte, blockType byte, minTime, maxTime int64, offset int64, size uint32)
 
+	// AddEntry records a new block entry, including its statistics, for a key in the index.
+	AddEntry(key []byte, blockType byte, entry IndexEntry)
+
 	// Entries returns all index entries for a key.
 	Entries(key []byte) []IndexEntry
 
@@ -185,17 +204,43 @@ type IndexEntry struct {
 
 	// The size in bytes of the block in the file.
 	Size uint32
+
+	// The number of values in the block and the min and max value, encoded
+	// for the type of the block.  Count is zero if the block has no statistics.
+	Count              uint32
+	MinValue, MaxValue uint64
+
+	// The sum, first and last value of the block, encoded like the min and max
+	// value.  They are zero for string blocks.
+	SumValue, FirstValue, LastValue uint64
 }
 
 // UnmarshalBinary decodes an IndexEntry from a byte slice.
 func (e *IndexEntry) UnmarshalBinary(b []byte) error {
-	if len(b) < indexEntrySize {
-		return fmt.Errorf("unmarshalBinary: short buf: %v < %v", len(b), indexEntrySize)
+	return e.unmarshalBinary(b, indexEntrySize)
+}
+
+// unmarshalBinary decodes an IndexEntry of the given size from a byte slice.
+// Entries of version 1 files have no statistics.
+func (e *IndexEntry) unmarshalBinary(b []byte, size int) error {
+	if len(b) < size {
+		return fmt.Errorf("unmarshalBinary: short buf: %v < %v", len(b), size)
 	}
 	e.MinTime = int64(binary.BigEndian.Uint64(b[:8]))
 	e.MaxTime = int64(binary.BigEndian.Uint64(b[8:16]))
 	e.Offset = int64(binary.BigEndian.Uint64(b[16:24]))
 	e.Size = binary.BigEndian.Uint32(b[24:28])
+
+	e.resetStats()
+	if size < indexEntrySize {
+		return nil
+	}
+	e.Count = binary.BigEndian.Uint32(b[28:32])
+	e.MinValue = binary.BigEndian.Uint64(b[32:40])
+	e.MaxValue = binary.BigEndian.Uint64(b[40:48])
+	e.SumValue = binary.BigEndian.Uint64(b[48:56])
+	e.FirstValue = binary.BigEndian.Uint64(b[56:64])
+	e.LastValue = binary.BigEndian.Uint64(b[64:72])
 	return nil
 }
 
@@ -214,6 +259,12 @@ func (e *IndexEntry) AppendTo(b []byte) []byte {
 	binary.BigEndian.PutUint64(b[8:16], uint64(e.MaxTime))
 	binary.BigEndian.PutUint64(b[16:24], uint64(e.Offset))
 	binary.BigEndian.PutUint32(b[24:28], uint32(e.Size))
+	binary.BigEndian.PutUint32(b[28:32], e.Count)
+	binary.BigEndian.PutUint64(b[32:40], e.MinValue)
+	binary.BigEndian.PutUint64(b[40:48], e.MaxValue)
+	binary.BigEndian.PutUint64(b[48:56], e.SumValue)
+	binary.BigEndian.PutUint64(b[56:64], e.FirstValue)
+	binary.BigEndian.PutUint64(b[64:72], e.LastValue)
 
 	return b
 }
@@ -277,6 +328,15 @@ type directIndex struct {
 }
 
 func (d *directIndex) Add(key []byte, blockType byte, minTime, maxTime int64, offset int64, size uint32) {
+	d.AddEntry(key, blockType, IndexEntry{
+		MinTime: minTime,
+		MaxTime: maxTime,
+		Offset:  offset,
+		Size:    size,
+	})
+}
+
+func (d *directIndex) AddEntry(key []byte, blockType byte, entry IndexEntry) {
 	// Is this the first block being added?
 	if len(d.key) == 0 {
 		// size of the key stored in the index
@@ -289,12 +349,7 @@ func (d *directIndex) Add(key []byte, blockType byte, minTime, maxTime int64, of
 			d.indexEntries = &indexEntries{}
 		}
 		d.indexEntries.Type = blockType
-		d.indexEntries.entries = append(d.indexEntries.entries, IndexEntry{
-			MinTime: minTime,
-			MaxTime: maxTime,
-			Offset:  offset,
-			Size:    size,
-		})
+		d.indexEntries.entries = append(d.indexEntries.entries, entry)
 
 		// size of the encoded index entry
 		d.size += indexEntrySize
@@ -306,12 +361,7 @@ func (d *directIndex) Add(key []byte, blockType byte, minTime, maxTime int64, of
 	cmp := bytes.Compare(d.key, key)
 	if cmp == 0 {
 		// The last block is still this key
-		d.indexEntries.entries = append(d.indexEntries.entries, IndexEntry{
-			MinTime: minTime,
-			MaxTime: maxTime,
-			Offset:  offset,
-			Size:    size,
-		})
+		d.indexEntries.entries = append(d.indexEntries.entries, entry)
 
 		// size of the encoded index entry
 		d.size += indexEntrySize
@@ -328,12 +378,7 @@ func (d *directIndex) Add(key []byte, blockType byte, minTime, maxTime int64, of
 
 		d.key = key
 		d.indexEntries.Type = blockType
-		d.indexEntries.entries = append(d.indexEntries.entries, IndexEntry{
-			MinTime: minTime,
-			MaxTime: maxTime,
-			Offset:  offset,
-			Size:    size,
-		})
+		d.indexEntries.entries = append(d.indexEntries.entries, entry)
 
 		// size of the encoded index entry
 		d.size += indexEntrySize
@@ -554,6 +599,9 @@ type tsmWriter struct {
 
 	// The bytes written count of when we last fsync'd
 	lastSync int64
+
+	// stats computes the statistics of the blocks written.
+	stats blockStatsReader
 }
 
 // NewTSMWriter returns a new TSMWriter writing to w.
@@ -622,6 +670,11 @@ func (t *tsmWriter) Write(key []byte, values Values) error {
 		return err
 	}
 
+	entry := IndexEntry{MinTime: values[0].UnixNano(), MaxTime: values[len(values)-1].UnixNano(), Offset: t.n}
+	if err := t.stats.read(block, &entry); err != nil {
+		return err
+	}
+
 	var checksum [crc32.Size]byte
 	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(block))
 
@@ -637,7 +690,8 @@ func (t *tsmWriter) Write(key []byte, values Values) error {
 	n += len(checksum)
 
 	// Record this block in index
-	t.index.Add(key, blockType, values[0].UnixNano(), values[len(values)-1].UnixNano(), t.n, uint32(n))
+	entry.Size = uint32(n)
+	t.index.AddEntry(key, blockType, entry)
 
 	// Increment file position pointer
 	t.n += int64(n)
@@ -648,6 +702,12 @@ func (t *tsmWriter) Write(key []byte, values Values) error {
 // exceeds max entries for a given key, ErrMaxBlocksExceeded is returned.  This indicates
 // that the index is now full for this key and no future writes to this key will succeed.
 func (t *tsmWriter) WriteBlock(key []byte, minTime, maxTime int64, block []byte) error {
+	return t.WriteBlockEntry(key, IndexEntry{MinTime: minTime, MaxTime: maxTime}, block)
+}
+
+// WriteBlockEntry writes block for the given key with the time range and statistics of
+// entry to the TSM file.  The statistics are computed from block if entry has none.
+func (t *tsmWriter) WriteBlockEntry(key []byte, entry IndexEntry, block []byte) error {
 	if len(key) > maxKeyLength {
 		return ErrMaxKeyLengthExceeded
 	}
@@ -662,6 +722,12 @@ func (t *tsmWriter) WriteBlock(key []byte, minTime, maxTime int64, block []byte)
 		return err
 	}
 
+	if entry.Count == 0 {
+		if err := t.stats.read(block, &entry); err != nil {
+			return err
+		}
+	}
+
 	// Write header only after we have some data to write.
 	if t.n == 0 {
 		if err := t.writeHeader(); err != nil {
@@ -684,7 +750,8 @@ func (t *tsmWriter) WriteBlock(key []byte, minTime, maxTime int64, block []byte)
 	n += len(checksum)
 
 	// Record this block in index
-	t.index.Add(key, blockType, minTime, maxTime, t.n, uint32(n))
+	entry.Offset, entry.Size = t.n, uint32(n)
+	t.index.AddEntry(key, blockType, entry)
 
 	// Increment file position pointer (checksum + block len)
 	t.n += int64(n)
@@ -784,28 +851,28 @@ func (t *tsmWriter) Size() uint32 {
 	return uint32(t.n) + t.index.Size()
 }
 
-// verifyVersion verifies that the reader's bytes are a TSM byte
-// stream of the correct version (1)
-func verifyVersion(r io.ReadSeeker) error {
+// readVersion verifies that the reader's bytes are a TSM byte stream of
+// a supported version (1 or 2) and returns the version.
+func readVersion(r io.ReadSeeker) (byte, error) {
 	_, err := r.Seek(0, 0)
 	if err != nil {
-		return fmt.Errorf("init: failed to seek: %v", err)
+		return 0, fmt.Errorf("init: failed to seek: %v", err)
 	}
 	var b [4]byte
 	_, err = io.ReadFull(r, b[:])
 	if err != nil {
-		return fmt.Errorf("init: error reading magic number of file: %v", err)
+		return 0, fmt.Errorf("init: error reading magic number of file: %v", err)
 	}
 	if binary.BigEndian.Uint32(b[:]) != MagicNumber {
-		return fmt.Errorf("can only read from tsm file")
+		return 0, fmt.Errorf("can only read from tsm file")
 	}
 	_, err = io.ReadFull(r, b[:1])
 	if err != nil {
-		return fmt.Errorf("init: error reading version: %v", err)
+		return 0, fmt.Errorf("init: error reading version: %v", err)
 	}
-	if b[0] != Version {
-		return fmt.Errorf("init: file is version %b. expected %b", b[0], Version)
+	if b[0] < 1 || b[0] > Version {
+		return 0, fmt.Errorf("init: file is version %b. expected at most %b", b[0], Version)
 	}
 
-	return nil
+	return b[0], nil
 }
@@ -68,6 +68,19 @@ type Index interface {
 	Rebuild()
 }
 
+// SeriesTimeIndex is an index that records the time range of the points
+// written to each series.
+type SeriesTimeIndex interface {
+	// CreateSeriesListIfNotExistsAt creates the series like
+	// CreateSeriesListIfNotExists and widens their time ranges to include
+	// times, which holds the time of a point of each series.
+	CreateSeriesListIfNotExistsAt(keys, names [][]byte, tags []models.Tags, times []int64) error
+
+	// SeriesIDSetByTimeRange returns the set of series with points written
+	// between min and max, inclusive.
+	SeriesIDSetByTimeRange(min, max int64) (*SeriesIDSet, error)
+}
+
 // SeriesElem represents a generic series element.
 type SeriesElem interface {
 	Name() []byte
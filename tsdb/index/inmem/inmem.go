@@ -1102,6 +1102,7 @@ func (idx *ShardIndex) CreateSeriesListIfNotExists(keys, names [][]byte, tagsSli
 	var (
 		reason      string
 		droppedKeys [][]byte
+		rejected    []tsdb.RejectedPoint
 	)
 
 	// Ensure that no tags go over the maximum cardinality.
@@ -1123,12 +1124,14 @@ func (idx *ShardIndex) CreateSeriesListIfNotExists(keys, names [][]byte, tagsSli
 					continue
 				}
 
+				msg := fmt.Sprintf("max-values-per-tag limit exceeded (%d/%d): measurement=%q tag=%q value=%q",
+					n, maxValuesPerTag, name, string(tag.Key), string(tag.Value))
 				if reason == "" {
-					reason = fmt.Sprintf("max-values-per-tag limit exceeded (%d/%d): measurement=%q tag=%q value=%q",
-						n, maxValuesPerTag, name, string(tag.Key), string(tag.Value))
+					reason = msg
 				}
 
 				droppedKeys = append(droppedKeys, keys[i])
+				rejected = append(rejected, tsdb.RejectedPoint{Key: keys[i], Reason: tsdb.RejectMaxValuesPerTag, Message: msg})
 				continue outer
 			}
 
@@ -1156,11 +1159,13 @@ func (idx *ShardIndex) CreateSeriesListIfNotExists(keys, names [][]byte, tagsSli
 	} else {
 		for i := range keys {
 			if err := idx.Index.CreateSeriesListIfNotExists(idx.id, idx.seriesIDSet, keys[i:i+1], names[i:i+1], tagsSlice[i:i+1], &idx.opt, false); err == errMaxSeriesPerDatabaseExceeded {
+				msg := fmt.Sprintf("max-series-per-database limit exceeded: (%d)", idx.opt.Config.MaxSeriesPerDatabase)
 				if reason == "" {
-					reason = fmt.Sprintf("max-series-per-database limit exceeded: (%d)", idx.opt.Config.MaxSeriesPerDatabase)
+					reason = msg
 				}
 
 				droppedKeys = append(droppedKeys, keys[i])
+				rejected = append(rejected, tsdb.RejectedPoint{Key: keys[i], Reason: tsdb.RejectMaxSeriesPerDatabase, Message: msg})
 				continue
 			} else if err != nil {
 				return err
@@ -1176,6 +1181,7 @@ func (idx *ShardIndex) CreateSeriesListIfNotExists(keys, names [][]byte, tagsSli
 			Reason:      reason,
 			Dropped:     dropped,
 			DroppedKeys: droppedKeys,
+			Rejected:    rejected,
 		}
 	}
 
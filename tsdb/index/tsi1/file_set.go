@@ -395,6 +395,27 @@ func (fs *FileSet) TagValueSeriesIDIterator(name, key, value []byte) tsdb.Series
 	return tsdb.MergeSeriesIDIterators(a...)
 }
 
+// SeriesIDSetByTimeRange returns the set of series written to between min and
+// max, inclusive, in any of the files. Series written to in a file and dropped
+// in a newer one are excluded.
+func (fs *FileSet) SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error) {
+	ss, tombstones := tsdb.NewSeriesIDSet(), tsdb.NewSeriesIDSet()
+	for _, f := range fs.files {
+		other, err := f.SeriesIDSetByTimeRange(min, max)
+		if err != nil {
+			return nil, err
+		}
+		ss.Merge(other.AndNot(tombstones))
+
+		ts, err := f.TombstoneSeriesIDSet()
+		if err != nil {
+			return nil, err
+		}
+		tombstones.Merge(ts)
+	}
+	return ss, nil
+}
+
 // MeasurementsSketches returns the merged measurement sketches for the FileSet.
 func (fs *FileSet) MeasurementsSketches() (estimator.Sketch, estimator.Sketch, error) {
 	sketch, tsketch := hll.NewDefaultPlus(), hll.NewDefaultPlus()
@@ -438,6 +459,9 @@ type File interface {
 	SeriesIDSet() (*tsdb.SeriesIDSet, error)
 	TombstoneSeriesIDSet() (*tsdb.SeriesIDSet, error)
 
+	// Bitmap of the series written to within a time range.
+	SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error)
+
 	// Reference counting.
 	Retain()
 	Release()
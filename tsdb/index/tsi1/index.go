@@ -491,21 +491,37 @@ func (i *Index) DropMeasurement(name []byte) error {
 
 // CreateSeriesListIfNotExists creates a list of series if they doesn't exist in bulk.
 func (i *Index) CreateSeriesListIfNotExists(keys [][]byte, names [][]byte, tagsSlice []models.Tags) error {
+	return i.CreateSeriesListIfNotExistsAt(keys, names, tagsSlice, nil)
+}
+
+// CreateSeriesListIfNotExistsAt creates a list of series if they don't exist in
+// bulk and widens their time ranges to include times, which holds the time of
+// a point of each series.
+func (i *Index) CreateSeriesListIfNotExistsAt(keys [][]byte, names [][]byte, tagsSlice []models.Tags, times []int64) error {
 	// All slices must be of equal length.
 	if len(names) != len(tagsSlice) {
 		return errors.New("names/tags length mismatch in index")
+	} else if times != nil && len(names) != len(times) {
+		return errors.New("names/times length mismatch in index")
 	}
 
 	// We need to move different series into collections for each partition
 	// to process.
 	pNames := make([][][]byte, i.PartitionN)
 	pTags := make([][]models.Tags, i.PartitionN)
+	var pTimes [][]int64
+	if times != nil {
+		pTimes = make([][]int64, i.PartitionN)
+	}
 
 	// Determine partition for series using each series key.
 	for ki, key := range keys {
 		pidx := i.partitionIdx(key)
 		pNames[pidx] = append(pNames[pidx], names[ki])
 		pTags[pidx] = append(pTags[pidx], tagsSlice[ki])
+		if times != nil {
+			pTimes[pidx] = append(pTimes[pidx], times[ki])
+		}
 	}
 
 	// Process each subset of series on each partition.
@@ -522,7 +538,11 @@ func (i *Index) CreateSeriesListIfNotExists(keys [][]byte, names [][]byte, tagsS
 				if idx >= len(i.partitions) {
 					return // No more work.
 				}
-				errC <- i.partitions[idx].createSeriesListIfNotExists(pNames[idx], pTags[idx])
+				var times []int64
+				if pTimes != nil {
+					times = pTimes[idx]
+				}
+				errC <- i.partitions[idx].createSeriesListIfNotExists(pNames[idx], pTags[idx], times)
 			}
 		}()
 	}
@@ -538,7 +558,22 @@ func (i *Index) CreateSeriesListIfNotExists(keys [][]byte, names [][]byte, tagsS
 
 // CreateSeriesIfNotExists creates a series if it doesn't exist or is deleted.
 func (i *Index) CreateSeriesIfNotExists(key, name []byte, tags models.Tags) error {
-	return i.partition(key).createSeriesListIfNotExists([][]byte{name}, []models.Tags{tags})
+	return i.partition(key).createSeriesListIfNotExists([][]byte{name}, []models.Tags{tags}, nil)
+}
+
+// SeriesIDSetByTimeRange returns the set of series written to between min and
+// max, inclusive. Series created without the times of their points, such as
+// those loaded from existing shard data, are always included.
+func (i *Index) SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error) {
+	ss := tsdb.NewSeriesIDSet()
+	for _, p := range i.partitions {
+		other, err := p.SeriesIDSetByTimeRange(min, max)
+		if err != nil {
+			return nil, err
+		}
+		ss.Merge(other)
+	}
+	return ss, nil
 }
 
 // InitializeSeries is a no-op. This only applies to the in-memory index.
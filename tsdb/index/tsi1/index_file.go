@@ -15,7 +15,8 @@ import (
 )
 
 // IndexFileVersion is the current TSI1 index file version.
-const IndexFileVersion = 1
+// Version 2 adds the series time block.
+const IndexFileVersion = 2
 
 // FileSignature represents a magic number at the header of the index file.
 const FileSignature = "TSI1"
@@ -29,7 +30,11 @@ const (
 		8 + 8 + // measurement block offset + size
 		8 + 8 + // series id set offset + size
 		8 + 8 + // tombstone series id set offset + size
+		8 + 8 + // series time block offset + size
 		0
+
+	// Version 1 trailers have no series time block.
+	IndexFileTrailerSizeV1 = IndexFileTrailerSize - 8 - 8
 )
 
 // IndexFile errors.
@@ -47,6 +52,7 @@ type IndexFile struct {
 	sfile *tsdb.SeriesFile
 	tblks map[string]*TagBlock // tag blocks by measurement name
 	mblk  MeasurementBlock
+	stblk *SeriesTimeBlock // nil for files without series time ranges
 
 	// Raw series set data.
 	seriesIDSetData          []byte
@@ -90,6 +96,7 @@ func (f *IndexFile) Close() error {
 	f.sfile = nil
 	f.tblks = nil
 	f.mblk = MeasurementBlock{}
+	f.stblk = nil
 	return mmap.Unmap(f.data)
 }
 
@@ -158,6 +165,15 @@ func (f *IndexFile) UnmarshalBinary(data []byte) error {
 		return err
 	}
 
+	// Unmarshal series time block.
+	f.stblk = nil
+	if t.Version >= 2 {
+		f.stblk = &SeriesTimeBlock{}
+		if err := f.stblk.UnmarshalBinary(data[t.SeriesTimeBlock.Offset : t.SeriesTimeBlock.Offset+t.SeriesTimeBlock.Size]); err != nil {
+			return err
+		}
+	}
+
 	// Unmarshal each tag block.
 	f.tblks = make(map[string]*TagBlock)
 	itr := f.mblk.Iterator()
@@ -199,6 +215,39 @@ func (f *IndexFile) TombstoneSeriesIDSet() (*tsdb.SeriesIDSet, error) {
 	return ss, nil
 }
 
+// SeriesIDSetByTimeRange returns the set of series written to between min and
+// max, inclusive. Files written before series time ranges were recorded
+// return all their series.
+func (f *IndexFile) SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error) {
+	if f.stblk == nil {
+		return f.SeriesIDSet()
+	}
+
+	ss := tsdb.NewSeriesIDSet()
+	f.stblk.AddSeriesIDsByTimeRange(min, max, ss)
+	return ss, nil
+}
+
+// addSeriesTimeRanges widens the time ranges of m to include those of the
+// series of the file.
+func (f *IndexFile) addSeriesTimeRanges(m seriesTimeRanges) error {
+	if f.stblk == nil {
+		ss, err := f.SeriesIDSet()
+		if err != nil {
+			return err
+		}
+		ss.ForEach(func(id uint64) {
+			m.add(id, unknownSeriesTimeRange.min, unknownSeriesTimeRange.max)
+		})
+		return nil
+	}
+
+	for i, n := 0, f.stblk.N(); i < n; i++ {
+		m.add(f.stblk.entry(i))
+	}
+	return nil
+}
+
 // Measurement returns a measurement element.
 func (f *IndexFile) Measurement(name []byte) MeasurementElem {
 	e, ok := f.mblk.Elem(name)
@@ -362,12 +411,17 @@ func ReadIndexFileTrailer(data []byte) (IndexFileTrailer, error) {
 
 	// Read version.
 	t.Version = int(binary.BigEndian.Uint16(data[len(data)-IndexFileVersionSize:]))
-	if t.Version != IndexFileVersion {
+	trailerSize := IndexFileTrailerSize
+	switch t.Version {
+	case IndexFileVersion:
+	case 1:
+		trailerSize = IndexFileTrailerSizeV1
+	default:
 		return t, ErrUnsupportedIndexFileVersion
 	}
 
 	// Slice trailer data.
-	buf := data[len(data)-IndexFileTrailerSize:]
+	buf := data[len(data)-trailerSize:]
 
 	// Read measurement block info.
 	t.MeasurementBlock.Offset, buf = int64(binary.BigEndian.Uint64(buf[0:8])), buf[8:]
@@ -381,6 +435,12 @@ func ReadIndexFileTrailer(data []byte) (IndexFileTrailer, error) {
 	t.TombstoneSeriesIDSet.Offset, buf = int64(binary.BigEndian.Uint64(buf[0:8])), buf[8:]
 	t.TombstoneSeriesIDSet.Size, buf = int64(binary.BigEndian.Uint64(buf[0:8])), buf[8:]
 
+	// Read series time block info.
+	if t.Version >= 2 {
+		t.SeriesTimeBlock.Offset, buf = int64(binary.BigEndian.Uint64(buf[0:8])), buf[8:]
+		t.SeriesTimeBlock.Size, buf = int64(binary.BigEndian.Uint64(buf[0:8])), buf[8:]
+	}
+
 	return t, nil
 }
 
@@ -402,6 +462,11 @@ type IndexFileTrailer struct {
 		Offset int64
 		Size   int64
 	}
+
+	SeriesTimeBlock struct {
+		Offset int64
+		Size   int64
+	}
 }
 
 // WriteTo writes the trailer to w.
@@ -427,6 +492,13 @@ func (t *IndexFileTrailer) WriteTo(w io.Writer) (n int64, err error) {
 		return n, err
 	}
 
+	// Write series time block info.
+	if err := writeUint64To(w, uint64(t.SeriesTimeBlock.Offset), &n); err != nil {
+		return n, err
+	} else if err := writeUint64To(w, uint64(t.SeriesTimeBlock.Size), &n); err != nil {
+		return n, err
+	}
+
 	// Write index file encoding version.
 	if err := writeUint16To(w, IndexFileVersion, &n); err != nil {
 		return n, err
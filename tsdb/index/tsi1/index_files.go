@@ -83,6 +83,24 @@ func (p IndexFiles) buildSeriesIDSets() (seriesIDSet, tombstoneSeriesIDSet *tsdb
 	return seriesIDSet, tombstoneSeriesIDSet, nil
 }
 
+// buildSeriesTimeRanges merges the series time ranges of the files. The ranges
+// of a series are dropped when a newer file has its tombstone.
+func (p IndexFiles) buildSeriesTimeRanges() (seriesTimeRanges, error) {
+	m := make(seriesTimeRanges)
+	for i := len(p) - 1; i >= 0; i-- {
+		ts, err := p[i].TombstoneSeriesIDSet()
+		if err != nil {
+			return nil, err
+		}
+		ts.ForEach(func(id uint64) { delete(m, id) })
+
+		if err := p[i].addSeriesTimeRanges(m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
 // MeasurementNames returns a sorted list of all measurement names for all files.
 func (p *IndexFiles) MeasurementNames() [][]byte {
 	itr := p.MeasurementIterator()
@@ -205,6 +223,20 @@ func (p IndexFiles) CompactTo(w io.Writer, sfile *tsdb.SeriesFile, m, k uint64)
 	}
 	t.TombstoneSeriesIDSet.Size = n - t.TombstoneSeriesIDSet.Offset
 
+	// Build series time ranges.
+	seriesTimes, err := p.buildSeriesTimeRanges()
+	if err != nil {
+		return n, err
+	}
+
+	// Write series time block.
+	t.SeriesTimeBlock.Offset = n
+	nn, err = seriesTimes.WriteTo(bw)
+	if n += nn; err != nil {
+		return n, err
+	}
+	t.SeriesTimeBlock.Size = n - t.SeriesTimeBlock.Offset
+
 	// Write trailer.
 	nn, err = t.WriteTo(bw)
 	n += nn
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/tsdb/index/tsi1"
@@ -203,6 +204,88 @@ func TestIndex_DropMeasurement(t *testing.T) {
 	})
 }
 
+// Ensure index returns the series written to within a time range, including
+// after its log files are compacted.
+func TestIndex_SeriesIDSetByTimeRange(t *testing.T) {
+	idx := MustOpenIndex(1)
+	defer idx.Close()
+
+	hour := int64(time.Hour)
+	series := []Series{
+		{Name: []byte("cpu"), Tags: models.NewTags(map[string]string{"region": "east"})},
+		{Name: []byte("cpu"), Tags: models.NewTags(map[string]string{"region": "west"})},
+		{Name: []byte("mem"), Tags: models.NewTags(map[string]string{"region": "east"})},
+		{Name: []byte("disk"), Tags: models.NewTags(map[string]string{"region": "north"})},
+	}
+
+	// Add series to index. The mem series is created without the times of
+	// its points, so it may have points at any time.
+	if err := idx.CreateSeriesSliceIfNotExistsAt(series[:2], []int64{0, 2 * hour}); err != nil {
+		t.Fatal(err)
+	} else if err := idx.CreateSeriesSliceIfNotExists(series[2:3]); err != nil {
+		t.Fatal(err)
+	} else if err := idx.CreateSeriesSliceIfNotExistsAt(series[2:3], []int64{0}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]uint64, len(series))
+	for i, s := range series[:3] {
+		ids[i] = idx.SeriesFile.SeriesID(s.Name, s.Tags, nil)
+	}
+
+	check := func(t *testing.T, min, max int64, exp ...uint64) {
+		t.Helper()
+		if ss, err := idx.SeriesIDSetByTimeRange(min, max); err != nil {
+			t.Fatal(err)
+		} else if exp := NewSeriesIDSet(exp...); !ss.Equals(exp) {
+			t.Fatalf("%d-%d: got series %s, expected %s", min, max, ss, exp)
+		}
+	}
+
+	// compact compacts the log file of the partition into an index file.
+	compact := func(t *testing.T) {
+		p := idx.PartitionAt(0)
+		p.MaxLogFileSize = 1
+		if err := p.CheckLogFile(); err != nil {
+			t.Fatal(err)
+		}
+		idx.Wait()
+	}
+
+	idx.Run(t, func(t *testing.T) {
+		check(t, 0, 0, ids[0], ids[2])
+		check(t, 1, 2*hour-1, ids[2])
+		check(t, 2*hour, 3*hour, ids[1], ids[2])
+	})
+
+	// Widen a range in a new log file and compact it with the first one.
+	compact(t)
+	if err := idx.CreateSeriesSliceIfNotExistsAt(series[1:2], []int64{hour}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, 1, 2*hour-1, ids[1], ids[2])
+	compact(t)
+	check(t, 1, 2*hour-1, ids[1], ids[2])
+
+	// Add a series to a new log file and drop one compacted into an index file.
+	if err := idx.CreateSeriesSliceIfNotExistsAt(series[3:4], []int64{4 * hour}); err != nil {
+		t.Fatal(err)
+	}
+	ids[3] = idx.SeriesFile.SeriesID(series[3].Name, series[3].Tags, nil)
+	if err := idx.DropSeries(ids[1], models.MakeKey(series[1].Name, series[1].Tags), false); err != nil {
+		t.Fatal(err)
+	}
+
+	idx.Run(t, func(t *testing.T) {
+		check(t, 0, 0, ids[0], ids[2])
+		check(t, 1, 2*hour-1, ids[2])
+		check(t, 4*hour, 4*hour, ids[2], ids[3])
+	})
+	compact(t)
+	check(t, 1, 2*hour-1, ids[2])
+	check(t, 4*hour, 4*hour, ids[2], ids[3])
+}
+
 func TestIndex_Open(t *testing.T) {
 	// Opening a fresh index should set the MANIFEST version to current version.
 	idx := NewIndex(tsi1.DefaultPartitionN)
@@ -415,6 +498,20 @@ func (idx *Index) CreateSeriesSliceIfNotExists(a []Series) error {
 	return idx.CreateSeriesListIfNotExists(keys, names, tags)
 }
 
+// CreateSeriesSliceIfNotExistsAt creates multiple series at a time with the
+// time of a point of each series.
+func (idx *Index) CreateSeriesSliceIfNotExistsAt(a []Series, times []int64) error {
+	keys := make([][]byte, 0, len(a))
+	names := make([][]byte, 0, len(a))
+	tags := make([]models.Tags, 0, len(a))
+	for _, s := range a {
+		keys = append(keys, models.MakeKey(s.Name, s.Tags))
+		names = append(names, s.Name)
+		tags = append(tags, s.Tags)
+	}
+	return idx.CreateSeriesListIfNotExistsAt(keys, names, tags, times)
+}
+
 func BytesToStrings(a [][]byte) []string {
 	s := make([]string, 0, len(a))
 	for _, v := range a {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
+	"math"
 	"os"
 	"sort"
 	"sync"
@@ -32,8 +33,16 @@ const (
 	LogEntryMeasurementTombstoneFlag = 0x02
 	LogEntryTagKeyTombstoneFlag      = 0x04
 	LogEntryTagValueTombstoneFlag    = 0x08
+	LogEntrySeriesTimeFlag           = 0x10
 )
 
+// seriesTimeLogInterval is the precision, in nanoseconds, of the series time
+// ranges appended to the log as points are written. A range is appended
+// rounded out to the interval and only when a point falls outside the last one
+// appended for the series, so the log grows by at most one entry per series
+// and interval written to. The exact ranges are appended when the file closes.
+const seriesTimeLogInterval = int64(time.Hour)
+
 // LogFile represents an on-disk write-ahead log file.
 type LogFile struct {
 	mu   sync.RWMutex
@@ -54,6 +63,9 @@ type LogFile struct {
 	// In-memory series existence/tombstone sets.
 	seriesIDSet, tombstoneSeriesIDSet *tsdb.SeriesIDSet
 
+	// Time ranges of the points written to series while the file was active.
+	seriesTimes map[uint64]*logSeriesTime
+
 	// In-memory index.
 	mms logMeasurements
 
@@ -74,6 +86,7 @@ func NewLogFile(sfile *tsdb.SeriesFile, path string) *LogFile {
 
 		seriesIDSet:          tsdb.NewSeriesIDSet(),
 		tombstoneSeriesIDSet: tsdb.NewSeriesIDSet(),
+		seriesTimes:          make(map[uint64]*logSeriesTime),
 	}
 }
 
@@ -147,6 +160,7 @@ func (f *LogFile) Close() error {
 	f.wg.Wait()
 
 	if f.w != nil {
+		f.appendExactSeriesTimes()
 		f.w.Flush()
 		f.w = nil
 	}
@@ -161,6 +175,7 @@ func (f *LogFile) Close() error {
 	}
 
 	f.mms = make(logMeasurements)
+	f.seriesTimes = make(map[uint64]*logSeriesTime)
 
 	return nil
 }
@@ -462,6 +477,13 @@ func (f *LogFile) DeleteTagValue(name, key, value []byte) error {
 
 // AddSeriesList adds a list of series to the log file in bulk.
 func (f *LogFile) AddSeriesList(seriesSet *tsdb.SeriesIDSet, names [][]byte, tagsSlice []models.Tags) error {
+	return f.AddSeriesListAt(seriesSet, names, tagsSlice, nil)
+}
+
+// AddSeriesListAt adds a list of series to the log file in bulk and widens
+// their time ranges to include times, which holds the time of a point of each
+// series. Series added without times have an unknown time range.
+func (f *LogFile) AddSeriesListAt(seriesSet *tsdb.SeriesIDSet, names [][]byte, tagsSlice []models.Tags, times []int64) error {
 	buf := make([]byte, 2048)
 
 	seriesIDs, err := f.sfile.CreateSeriesListIfNotExists(names, tagsSlice, buf[:0])
@@ -482,14 +504,36 @@ func (f *LogFile) AddSeriesList(seriesSet *tsdb.SeriesIDSet, names [][]byte, tag
 	}
 	seriesSet.RUnlock()
 
-	// Exit if all series already exist.
-	if !writeRequired {
+	// Exit if all series already exist and there are no times to record.
+	if !writeRequired && times == nil {
 		return nil
 	}
 
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
+	if writeRequired {
+		if err := f.addSeriesEntries(seriesSet, entries); err != nil {
+			return err
+		}
+	}
+	if times != nil {
+		return f.addSeriesTimes(seriesIDs, times)
+	}
+
+	// Series created without times may have points at any time, so later
+	// writes don't narrow their range. The range isn't appended as replayed
+	// series without one are unknown as well.
+	for _, e := range entries {
+		if _, ok := f.seriesTimes[e.SeriesID]; !ok {
+			f.seriesTimes[e.SeriesID] = &logSeriesTime{seriesTimeRange: unknownSeriesTimeRange, logged: unknownSeriesTimeRange}
+		}
+	}
+	return nil
+}
+
+// addSeriesEntries appends the entries of the series not in seriesSet.
+func (f *LogFile) addSeriesEntries(seriesSet *tsdb.SeriesIDSet, entries []LogEntry) error {
 	seriesSet.Lock()
 	defer seriesSet.Unlock()
 
@@ -508,6 +552,101 @@ func (f *LogFile) AddSeriesList(seriesSet *tsdb.SeriesIDSet, names [][]byte, tag
 	return nil
 }
 
+// addSeriesTimes widens the time ranges of the series to include times and
+// appends the ranges that outgrow the last ones appended for their series.
+func (f *LogFile) addSeriesTimes(seriesIDs []uint64, times []int64) error {
+	for i, id := range seriesIDs {
+		t := times[i]
+		st, ok := f.seriesTimes[id]
+		if !ok {
+			st = &logSeriesTime{seriesTimeRange: seriesTimeRange{min: t, max: t}}
+			f.seriesTimes[id] = st
+		} else if t < st.min {
+			st.min = t
+		} else if t > st.max {
+			st.max = t
+		}
+
+		if ok && st.logged.min <= st.min && st.logged.max >= st.max {
+			continue
+		}
+
+		// The entry isn't executed as the replayed range replaces the exact one.
+		e := LogEntry{
+			Flag:     LogEntrySeriesTimeFlag,
+			SeriesID: id,
+			MinTime:  truncateTime(st.min, seriesTimeLogInterval),
+			MaxTime:  truncateTime(st.max, seriesTimeLogInterval) + (seriesTimeLogInterval - 1),
+		}
+		if e.MinTime > st.min {
+			e.MinTime = math.MinInt64
+		}
+		if e.MaxTime < st.max {
+			e.MaxTime = math.MaxInt64
+		}
+		if err := f.appendEntry(&e); err != nil {
+			return err
+		}
+		st.logged = seriesTimeRange{min: e.MinTime, max: e.MaxTime}
+	}
+	return nil
+}
+
+// appendExactSeriesTimes appends the time ranges of the series that are
+// narrower than the last ones appended for them.
+func (f *LogFile) appendExactSeriesTimes() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for id, st := range f.seriesTimes {
+		if st.logged == st.seriesTimeRange {
+			continue
+		}
+
+		e := LogEntry{Flag: LogEntrySeriesTimeFlag, SeriesID: id, MinTime: st.min, MaxTime: st.max}
+		if err := f.appendEntry(&e); err != nil {
+			return
+		}
+		st.logged = st.seriesTimeRange
+	}
+}
+
+// SeriesIDSetByTimeRange returns the set of series written to between min and
+// max, inclusive, while the file was active. Series created in the file without
+// the times of their points are included.
+func (f *LogFile) SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	ss := tsdb.NewSeriesIDSet()
+	for id, st := range f.seriesTimes {
+		if st.overlaps(min, max) {
+			ss.Add(id)
+		}
+	}
+	f.seriesIDSet.ForEach(func(id uint64) {
+		if _, ok := f.seriesTimes[id]; !ok {
+			ss.Add(id)
+		}
+	})
+	return ss, nil
+}
+
+// seriesTimeRanges returns the time ranges of the series written to or created
+// in the file.
+func (f *LogFile) seriesTimeRanges() seriesTimeRanges {
+	m := make(seriesTimeRanges, len(f.seriesTimes))
+	for id, st := range f.seriesTimes {
+		m[id] = st.seriesTimeRange
+	}
+	f.seriesIDSet.ForEach(func(id uint64) {
+		if _, ok := m[id]; !ok {
+			m[id] = unknownSeriesTimeRange
+		}
+	})
+	return m
+}
+
 // DeleteSeriesID adds a tombstone for a series id.
 func (f *LogFile) DeleteSeriesID(id uint64) error {
 	f.mu.Lock()
@@ -571,6 +710,8 @@ func (f *LogFile) execEntry(e *LogEntry) {
 		f.execDeleteTagKeyEntry(e)
 	case LogEntryTagValueTombstoneFlag:
 		f.execDeleteTagValueEntry(e)
+	case LogEntrySeriesTimeFlag:
+		f.execSeriesTimeEntry(e)
 	default:
 		f.execSeriesEntry(e)
 	}
@@ -606,6 +747,14 @@ func (f *LogFile) execDeleteTagValueEntry(e *LogEntry) {
 	mm.tagSet[string(e.Key)] = ts
 }
 
+// execSeriesTimeEntry replaces the time range of a series with the one of the
+// entry. Entries appended after a write include the previous range, so the
+// last entry of a series holds the range of all its points.
+func (f *LogFile) execSeriesTimeEntry(e *LogEntry) {
+	r := seriesTimeRange{min: e.MinTime, max: e.MaxTime}
+	f.seriesTimes[e.SeriesID] = &logSeriesTime{seriesTimeRange: r, logged: r}
+}
+
 func (f *LogFile) execSeriesEntry(e *LogEntry) {
 	seriesKey := f.sfile.SeriesKey(e.SeriesID)
 	assert(seriesKey != nil, fmt.Sprintf("series key for ID: %d not found", e.SeriesID))
@@ -658,6 +807,7 @@ func (f *LogFile) execSeriesEntry(e *LogEntry) {
 	} else {
 		f.seriesIDSet.Remove(e.SeriesID)
 		f.tombstoneSeriesIDSet.Add(e.SeriesID)
+		delete(f.seriesTimes, e.SeriesID)
 	}
 }
 
@@ -803,6 +953,14 @@ func (f *LogFile) CompactTo(w io.Writer, m, k uint64) (n int64, err error) {
 	}
 	t.TombstoneSeriesIDSet.Size = n - t.TombstoneSeriesIDSet.Offset
 
+	// Write series time block.
+	t.SeriesTimeBlock.Offset = n
+	nn, err = f.seriesTimeRanges().WriteTo(bw)
+	if n += nn; err != nil {
+		return n, err
+	}
+	t.SeriesTimeBlock.Size = n - t.SeriesTimeBlock.Offset
+
 	// Write trailer.
 	nn, err = t.WriteTo(bw)
 	n += nn
@@ -931,6 +1089,8 @@ type LogEntry struct {
 	Name     []byte // measurement name
 	Key      []byte // tag key
 	Value    []byte // tag value
+	MinTime  int64  // series time range, if LogEntrySeriesTimeFlag
+	MaxTime  int64
 	Checksum uint32 // checksum of flag/name/tags.
 	Size     int    // total size of record, in bytes.
 }
@@ -995,6 +1155,18 @@ func (e *LogEntry) UnmarshalBinary(data []byte) error {
 	}
 	e.Value, data = data[n:n+int(sz)], data[n+int(sz):]
 
+	// Parse series time range.
+	if e.Flag == LogEntrySeriesTimeFlag {
+		for _, v := range []*int64{&e.MinTime, &e.MaxTime} {
+			if len(data) < 1 {
+				return io.ErrShortBuffer
+			} else if *v, n = binary.Varint(data); n <= 0 {
+				return io.ErrShortBuffer
+			}
+			data = data[n:]
+		}
+	}
+
 	// Compute checksum.
 	chk := crc32.ChecksumIEEE(orig[:start-len(data)])
 
@@ -1043,6 +1215,14 @@ func appendLogEntry(dst []byte, e *LogEntry) []byte {
 	dst = append(dst, buf[:n]...)
 	dst = append(dst, e.Value...)
 
+	// Append series time range.
+	if e.Flag == LogEntrySeriesTimeFlag {
+		n = binary.PutVarint(buf[:], e.MinTime)
+		dst = append(dst, buf[:n]...)
+		n = binary.PutVarint(buf[:], e.MaxTime)
+		dst = append(dst, buf[:n]...)
+	}
+
 	// Calculate checksum.
 	e.Checksum = crc32.ChecksumIEEE(dst[start:])
 
@@ -1053,6 +1233,22 @@ func appendLogEntry(dst []byte, e *LogEntry) []byte {
 	return dst
 }
 
+// logSeriesTime is the time range of the points written to a series while
+// the log file was active, and the range last appended to the log for it.
+type logSeriesTime struct {
+	seriesTimeRange
+	logged seriesTimeRange
+}
+
+// truncateTime returns t rounded down to a multiple of d.
+func truncateTime(t, d int64) int64 {
+	r := t % d
+	if r < 0 {
+		r += d
+	}
+	return t - r
+}
+
 // logMeasurements represents a map of measurement names to measurements.
 type logMeasurements map[string]*logMeasurement
 
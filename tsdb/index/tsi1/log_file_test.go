@@ -67,6 +67,110 @@ func TestLogFile_AddSeriesList(t *testing.T) {
 	}
 }
 
+// Ensure log file records the time ranges of the points written to its series.
+func TestLogFile_SeriesIDSetByTimeRange(t *testing.T) {
+	sfile := MustOpenSeriesFile()
+	defer sfile.Close()
+
+	f := MustOpenLogFile(sfile.SeriesFile)
+	defer f.Close()
+	seriesSet := tsdb.NewSeriesIDSet()
+
+	hour := int64(time.Hour)
+	series := []Series{
+		{Name: []byte("cpu"), Tags: models.NewTags(map[string]string{"region": "east"})},
+		{Name: []byte("cpu"), Tags: models.NewTags(map[string]string{"region": "west"})},
+		{Name: []byte("mem"), Tags: models.NewTags(map[string]string{"region": "east"})},
+	}
+
+	// Add test data. The last series is created without the times of its points.
+	if err := f.AddSeriesListAt(seriesSet, [][]byte{series[0].Name, series[1].Name}, []models.Tags{series[0].Tags, series[1].Tags}, []int64{10, 2*hour + 10}); err != nil {
+		t.Fatal(err)
+	} else if err := f.AddSeriesListAt(seriesSet, [][]byte{series[0].Name}, []models.Tags{series[0].Tags}, []int64{hour / 2}); err != nil {
+		t.Fatal(err)
+	} else if err := f.AddSeriesList(seriesSet, [][]byte{series[2].Name}, []models.Tags{series[2].Tags}); err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]uint64, len(series))
+	for i, s := range series {
+		ids[i] = sfile.SeriesID(s.Name, s.Tags, nil)
+	}
+
+	// The exact ranges are used while the file is open and once it's closed.
+	exact := func(t *testing.T, file tsi1.File) {
+		for _, tt := range []struct {
+			min, max int64
+			exp      []uint64
+		}{
+			{min: 0, max: 9, exp: []uint64{ids[2]}},
+			{min: 0, max: 10, exp: []uint64{ids[0], ids[2]}},
+			{min: hour/2 + 1, max: 2*hour + 9, exp: []uint64{ids[2]}},
+			{min: hour / 2, max: 2*hour + 10, exp: []uint64{ids[0], ids[1], ids[2]}},
+		} {
+			ss, err := file.SeriesIDSetByTimeRange(tt.min, tt.max)
+			if err != nil {
+				t.Fatal(err)
+			} else if exp := NewSeriesIDSet(tt.exp...); !ss.Equals(exp) {
+				t.Fatalf("%d-%d: got series %s, expected %s", tt.min, tt.max, ss, exp)
+			}
+		}
+	}
+	exact(t, f.LogFile)
+
+	// A copy of the file replays the ranges appended as the points were
+	// written, which are rounded out to the hour.
+	if err := f.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(f.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewLogFile(sfile.SeriesFile)
+	if err := ioutil.WriteFile(g.Path(), data, 0666); err != nil {
+		t.Fatal(err)
+	} else if err := g.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	if ss, err := g.SeriesIDSetByTimeRange(hour/2+1, 2*hour-1); err != nil {
+		t.Fatal(err)
+	} else if exp := NewSeriesIDSet(ids[0], ids[2]); !ss.Equals(exp) {
+		t.Fatalf("got series %s, expected %s", ss, exp)
+	} else if ss, err := g.SeriesIDSetByTimeRange(hour, 2*hour); err != nil {
+		t.Fatal(err)
+	} else if exp := NewSeriesIDSet(ids[1], ids[2]); !ss.Equals(exp) {
+		t.Fatalf("got series %s, expected %s", ss, exp)
+	}
+
+	// Reopen file and re-verify.
+	if err := f.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+	exact(t, f.LogFile)
+
+	// Compact the file and verify the index file.
+	var buf bytes.Buffer
+	if _, err := f.CompactTo(&buf, M, K); err != nil {
+		t.Fatal(err)
+	}
+	var idx tsi1.IndexFile
+	if err := idx.UnmarshalBinary(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	exact(t, &idx)
+
+	// Dropped series are removed from the file.
+	if err := f.DeleteSeriesID(ids[0]); err != nil {
+		t.Fatal(err)
+	} else if ss, err := f.SeriesIDSetByTimeRange(0, 10); err != nil {
+		t.Fatal(err)
+	} else if exp := NewSeriesIDSet(ids[2]); !ss.Equals(exp) {
+		t.Fatalf("got series %s, expected %s", ss, exp)
+	}
+}
+
 func TestLogFile_SeriesStoredInOrder(t *testing.T) {
 	sfile := MustOpenSeriesFile()
 	defer sfile.Close()
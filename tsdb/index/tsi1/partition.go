@@ -577,13 +577,15 @@ func (i *Partition) DropMeasurement(name []byte) error {
 }
 
 // createSeriesListIfNotExists creates a list of series if they doesn't exist in
-// bulk.
-func (i *Partition) createSeriesListIfNotExists(names [][]byte, tagsSlice []models.Tags) error {
+// bulk. If times isn't nil, it holds the time of a point of each series.
+func (i *Partition) createSeriesListIfNotExists(names [][]byte, tagsSlice []models.Tags, times []int64) error {
 	// Is there anything to do? The partition may have been sent an empty batch.
 	if len(names) == 0 {
 		return nil
 	} else if len(names) != len(tagsSlice) {
 		return fmt.Errorf("uneven batch, partition %s sent %d names and %d tags", i.id, len(names), len(tagsSlice))
+	} else if times != nil && len(names) != len(times) {
+		return fmt.Errorf("uneven batch, partition %s sent %d names and %d times", i.id, len(names), len(times))
 	}
 
 	// Maintain reference count on files in file set.
@@ -596,7 +598,7 @@ func (i *Partition) createSeriesListIfNotExists(names [][]byte, tagsSlice []mode
 	// Ensure fileset cannot change during insert.
 	i.mu.RLock()
 	// Insert series into log file.
-	if err := i.activeLogFile.AddSeriesList(i.seriesIDSet, names, tagsSlice); err != nil {
+	if err := i.activeLogFile.AddSeriesListAt(i.seriesIDSet, names, tagsSlice, times); err != nil {
 		i.mu.RUnlock()
 		return err
 	}
@@ -620,6 +622,17 @@ func (i *Partition) DropSeries(seriesID uint64) error {
 	return nil
 }
 
+// SeriesIDSetByTimeRange returns the set of series written to between min and
+// max, inclusive.
+func (i *Partition) SeriesIDSetByTimeRange(min, max int64) (*tsdb.SeriesIDSet, error) {
+	fs, err := i.RetainFileSet()
+	if err != nil {
+		return nil, err
+	}
+	defer fs.Release()
+	return fs.SeriesIDSetByTimeRange(min, max)
+}
+
 // MeasurementsSketches returns the two sketches for the index by merging all
 // instances of the type sketch types in all the index files.
 func (i *Partition) MeasurementsSketches() (estimator.Sketch, estimator.Sketch, error) {
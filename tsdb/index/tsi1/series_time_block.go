@@ -0,0 +1,120 @@
+package tsi1
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+// Series time block field size constants.
+const (
+	// Each entry holds a series id and the min & max time of its points.
+	SeriesTimeEntrySize = SeriesIDSize + 8 + 8
+)
+
+// Series time block errors.
+var (
+	ErrSeriesTimeBlockSizeMismatch = errors.New("series time block size mismatch")
+)
+
+// SeriesTimeBlock represents the time ranges of the points written to the
+// series of an index file. Entries are sorted by descending max time so the
+// series written to after a given time are at the start of the block.
+type SeriesTimeBlock struct {
+	data []byte
+}
+
+// UnmarshalBinary unpacks data into the block. The block is not copied so data
+// should be retained and unchanged after being passed into this function.
+func (blk *SeriesTimeBlock) UnmarshalBinary(data []byte) error {
+	if len(data)%SeriesTimeEntrySize != 0 {
+		return ErrSeriesTimeBlockSizeMismatch
+	}
+	blk.data = data
+	return nil
+}
+
+// N returns the number of series in the block.
+func (blk *SeriesTimeBlock) N() int { return len(blk.data) / SeriesTimeEntrySize }
+
+// entry returns the series id and time range of the i-th entry.
+func (blk *SeriesTimeBlock) entry(i int) (id uint64, min, max int64) {
+	buf := blk.data[i*SeriesTimeEntrySize:]
+	id = binary.BigEndian.Uint64(buf[0:8])
+	min = int64(binary.BigEndian.Uint64(buf[8:16]))
+	max = int64(binary.BigEndian.Uint64(buf[16:24]))
+	return id, min, max
+}
+
+// AddSeriesIDsByTimeRange adds the ids of the series with a time range
+// overlapping min and max, inclusive, to ids.
+func (blk *SeriesTimeBlock) AddSeriesIDsByTimeRange(min, max int64, ids *tsdb.SeriesIDSet) {
+	for i, n := 0, blk.N(); i < n; i++ {
+		id, tmin, tmax := blk.entry(i)
+		if tmax < min {
+			return // the remaining series were last written to before min
+		} else if tmin <= max {
+			ids.Add(id)
+		}
+	}
+}
+
+// seriesTimeRange is the time range of the points written to a series.
+type seriesTimeRange struct {
+	min, max int64
+}
+
+// unknownSeriesTimeRange is the time range of series indexed without the
+// times of their points, such as the series loaded from existing shard data.
+var unknownSeriesTimeRange = seriesTimeRange{min: math.MinInt64, max: math.MaxInt64}
+
+// overlaps returns true if the range overlaps min and max, inclusive.
+func (r seriesTimeRange) overlaps(min, max int64) bool {
+	return r.min <= max && r.max >= min
+}
+
+// seriesTimeRanges is a set of series time ranges by series id.
+type seriesTimeRanges map[uint64]seriesTimeRange
+
+// add widens the time range of the series to include min and max.
+func (m seriesTimeRanges) add(id uint64, min, max int64) {
+	if r, ok := m[id]; ok {
+		if r.min < min {
+			min = r.min
+		}
+		if r.max > max {
+			max = r.max
+		}
+	}
+	m[id] = seriesTimeRange{min: min, max: max}
+}
+
+// WriteTo writes the time ranges as a series time block to w.
+func (m seriesTimeRanges) WriteTo(w io.Writer) (n int64, err error) {
+	ids := make([]uint64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if a, b := m[ids[i]], m[ids[j]]; a.max != b.max {
+			return a.max > b.max
+		}
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		r := m[id]
+		if err := writeUint64To(w, id, &n); err != nil {
+			return n, err
+		} else if err := writeUint64To(w, uint64(r.min), &n); err != nil {
+			return n, err
+		} else if err := writeUint64To(w, uint64(r.max), &n); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
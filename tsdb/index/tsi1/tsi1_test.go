@@ -282,6 +282,15 @@ type Series struct {
 	Deleted bool
 }
 
+// NewSeriesIDSet returns a new series id set containing ids.
+func NewSeriesIDSet(ids ...uint64) *tsdb.SeriesIDSet {
+	ss := tsdb.NewSeriesIDSet()
+	for _, id := range ids {
+		ss.Add(id)
+	}
+	return ss
+}
+
 // SeriesFile is a test wrapper for tsdb.SeriesFile.
 type SeriesFile struct {
 	*tsdb.SeriesFile
@@ -0,0 +1,220 @@
+package tsdb
+
+import (
+	"sync"
+
+	"github.com/influxdata/influxql"
+)
+
+// TimeBounded returns the index set with only the measurements, tag keys, tag
+// values and series that have points written within tr. Indexes that don't
+// record the time ranges of their series, such as inmem, are left unbounded.
+func (is IndexSet) TimeBounded(tr influxql.TimeRange) IndexSet {
+	if tr.Min.IsZero() && tr.Max.IsZero() {
+		return is
+	}
+
+	other := IndexSet{Indexes: make([]Index, len(is.Indexes)), SeriesFile: is.SeriesFile}
+	for i, idx := range is.Indexes {
+		tidx, ok := idx.(SeriesTimeIndex)
+		if !ok {
+			other.Indexes[i] = idx
+			continue
+		}
+		other.Indexes[i] = &timeBoundedIndex{
+			Index:  idx,
+			series: tidx,
+			min:    tr.MinTimeNano(),
+			max:    tr.MaxTimeNano(),
+		}
+	}
+	return other
+}
+
+// timeBoundedIndex is an index that only exposes the series with points
+// written between min and max, and the measurements, tag keys and tag values
+// that have at least one of them.
+type timeBoundedIndex struct {
+	Index
+	series   SeriesTimeIndex
+	min, max int64
+
+	once sync.Once
+	ids  *SeriesIDSet // series within the time range
+	err  error
+}
+
+// contains returns true if the series has points within the time range.
+func (i *timeBoundedIndex) contains(id uint64) (bool, error) {
+	i.once.Do(func() {
+		i.ids, i.err = i.series.SeriesIDSetByTimeRange(i.min, i.max)
+	})
+	if i.err != nil {
+		return false, i.err
+	}
+	return i.ids.Contains(id), nil
+}
+
+func (i *timeBoundedIndex) HasTagKey(name, key []byte) (bool, error) {
+	return i.hasSeries(i.Index.TagKeySeriesIDIterator(name, key))
+}
+
+func (i *timeBoundedIndex) HasTagValue(name, key, value []byte) (bool, error) {
+	return i.hasSeries(i.Index.TagValueSeriesIDIterator(name, key, value))
+}
+
+func (i *timeBoundedIndex) MeasurementTagKeysByExpr(name []byte, expr influxql.Expr) (map[string]struct{}, error) {
+	keys, err := i.Index.MeasurementTagKeysByExpr(name, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range keys {
+		if ok, err := i.HasTagKey(name, []byte(k)); err != nil {
+			return nil, err
+		} else if !ok {
+			delete(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+func (i *timeBoundedIndex) MeasurementIterator() (MeasurementIterator, error) {
+	itr, err := i.Index.MeasurementIterator()
+	if err != nil || itr == nil {
+		return itr, err
+	}
+	defer itr.Close()
+
+	var names [][]byte
+	for {
+		name, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if name == nil {
+			return NewMeasurementSliceIterator(names), nil
+		}
+
+		if ok, err := i.hasSeries(i.Index.MeasurementSeriesIDIterator(name)); err != nil {
+			return nil, err
+		} else if ok {
+			names = append(names, name)
+		}
+	}
+}
+
+func (i *timeBoundedIndex) TagKeyIterator(name []byte) (TagKeyIterator, error) {
+	itr, err := i.Index.TagKeyIterator(name)
+	if err != nil || itr == nil {
+		return itr, err
+	}
+	defer itr.Close()
+
+	var keys [][]byte
+	for {
+		key, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if key == nil {
+			return NewTagKeySliceIterator(keys), nil
+		}
+
+		if ok, err := i.HasTagKey(name, key); err != nil {
+			return nil, err
+		} else if ok {
+			keys = append(keys, key)
+		}
+	}
+}
+
+func (i *timeBoundedIndex) TagValueIterator(name, key []byte) (TagValueIterator, error) {
+	itr, err := i.Index.TagValueIterator(name, key)
+	if err != nil || itr == nil {
+		return itr, err
+	}
+	defer itr.Close()
+
+	var values [][]byte
+	for {
+		value, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if value == nil {
+			return NewTagValueSliceIterator(values), nil
+		}
+
+		if ok, err := i.HasTagValue(name, key, value); err != nil {
+			return nil, err
+		} else if ok {
+			values = append(values, value)
+		}
+	}
+}
+
+func (i *timeBoundedIndex) MeasurementSeriesIDIterator(name []byte) (SeriesIDIterator, error) {
+	return i.filter(i.Index.MeasurementSeriesIDIterator(name))
+}
+
+func (i *timeBoundedIndex) TagKeySeriesIDIterator(name, key []byte) (SeriesIDIterator, error) {
+	return i.filter(i.Index.TagKeySeriesIDIterator(name, key))
+}
+
+func (i *timeBoundedIndex) TagValueSeriesIDIterator(name, key, value []byte) (SeriesIDIterator, error) {
+	return i.filter(i.Index.TagValueSeriesIDIterator(name, key, value))
+}
+
+// filter returns an iterator over the series of itr within the time range.
+func (i *timeBoundedIndex) filter(itr SeriesIDIterator, err error) (SeriesIDIterator, error) {
+	if err != nil || itr == nil {
+		return itr, err
+	}
+	return &timeBoundedSeriesIDIterator{itr: itr, index: i}, nil
+}
+
+// hasSeries returns true if itr has a series within the time range. It closes
+// the iterator.
+func (i *timeBoundedIndex) hasSeries(itr SeriesIDIterator, err error) (bool, error) {
+	if err != nil || itr == nil {
+		return false, err
+	}
+	defer itr.Close()
+
+	for {
+		e, err := itr.Next()
+		if err != nil {
+			return false, err
+		} else if e.SeriesID == 0 {
+			return false, nil
+		} else if ok, err := i.contains(e.SeriesID); err != nil {
+			return false, err
+		} else if ok {
+			return true, nil
+		}
+	}
+}
+
+// timeBoundedSeriesIDIterator filters out the series of an iterator without
+// values within the time range of an index.
+type timeBoundedSeriesIDIterator struct {
+	itr   SeriesIDIterator
+	index *timeBoundedIndex
+}
+
+func (itr *timeBoundedSeriesIDIterator) Close() error {
+	return itr.itr.Close()
+}
+
+func (itr *timeBoundedSeriesIDIterator) Next() (SeriesIDElem, error) {
+	for {
+		e, err := itr.itr.Next()
+		if err != nil || e.SeriesID == 0 {
+			return e, err
+		}
+
+		if ok, err := itr.index.contains(e.SeriesID); err != nil {
+			return SeriesIDElem{}, err
+		} else if ok {
+			return e, nil
+		}
+	}
+}
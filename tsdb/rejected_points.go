@@ -0,0 +1,315 @@
+package tsdb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// rejectedPointsFileName is the name of the active rejected points file.
+	rejectedPointsFileName = "rejected.lp"
+
+	// rejectedPointsRotatedFileName is the name the active file is moved to
+	// once it reaches its maximum size.
+	rejectedPointsRotatedFileName = "rejected.1.lp"
+)
+
+var (
+	// ErrRejectedPointsLogClosed is returned when writing to a closed rejected points log.
+	ErrRejectedPointsLogClosed = errors.New("tsdb: rejected points log closed")
+
+	// ErrInvalidRejectedPointsEntry is returned when an entry in a rejected
+	// points file cannot be decoded.
+	ErrInvalidRejectedPointsEntry = errors.New("tsdb: invalid rejected points entry")
+)
+
+// RejectedPointsEntry is a single entry in a rejected points log.
+//
+// Each entry is stored as a line protocol comment holding a JSON header,
+// followed by the line protocol of the point if it is known. This allows a
+// rejected points file to be written back to the database as-is once the
+// cause of the rejection has been resolved.
+type RejectedPointsEntry struct {
+	// The time the point was rejected.
+	Time time.Time `json:"time"`
+
+	// The retention policy the point was written to. Empty for the default
+	// retention policy.
+	RetentionPolicy string `json:"rp,omitempty"`
+
+	// The category of the rejection. This is one of the Reject* constants.
+	Reason string `json:"reason"`
+
+	// A description of the specific failure.
+	Message string `json:"message,omitempty"`
+
+	// The line protocol of the rejected point, or the raw line if it could
+	// not be parsed. This is empty if neither is known.
+	Point []byte `json:"-"`
+
+	// Size is the length of Point, used to delimit entries on disk.
+	Size int `json:"size"`
+}
+
+// RejectedPointsLog is an append-only, on-disk log of the points dropped from
+// writes to a database.
+type RejectedPointsLog struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	w    *bufio.Writer
+	size int64
+
+	// MaxSize is the size in bytes at which the active file is rotated. The
+	// previously rotated file is removed, so at most twice this size is kept
+	// on disk. A value of 0 disables rotation.
+	MaxSize int64
+
+	Logger *zap.Logger
+}
+
+// NewRejectedPointsLog returns a new instance of RejectedPointsLog.
+func NewRejectedPointsLog(path string) *RejectedPointsLog {
+	return &RejectedPointsLog{
+		path:   path,
+		Logger: zap.NewNop(),
+	}
+}
+
+// Open opens the active file of the log, creating it if it does not exist.
+func (l *RejectedPointsLog) Open() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(l.path, 0777); err != nil {
+		return err
+	}
+	return l.openFile()
+}
+
+// openFile opens the active file for appending. Must be called under lock.
+func (l *RejectedPointsLog) openFile() error {
+	f, err := os.OpenFile(filepath.Join(l.path, rejectedPointsFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	l.f, l.w, l.size = f, bufio.NewWriter(f), fi.Size()
+	return nil
+}
+
+// Close flushes and closes the log.
+func (l *RejectedPointsLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closeFile()
+}
+
+// closeFile flushes and closes the active file. Must be called under lock.
+func (l *RejectedPointsLog) closeFile() error {
+	if l.f == nil {
+		return nil
+	}
+
+	err := l.w.Flush()
+	if e := l.f.Close(); e != nil && err == nil {
+		err = e
+	}
+	l.f, l.w = nil, nil
+	return err
+}
+
+// Path returns the directory of the log.
+func (l *RejectedPointsLog) Path() string { return l.path }
+
+// Entries returns all entries in the log, ordered from oldest to newest.
+func (l *RejectedPointsLog) Entries() ([]*RejectedPointsEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.w != nil {
+		if err := l.w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+	return ReadRejectedPointsDir(l.path)
+}
+
+// WriteRejectedPoints appends the rejected points to the log.
+func (l *RejectedPointsLog) WriteRejectedPoints(rejected []RejectedPoint) error {
+	if len(rejected) == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.f == nil {
+		return ErrRejectedPointsLogClosed
+	}
+
+	now := time.Now().UTC()
+	for _, r := range rejected {
+		entry := RejectedPointsEntry{Time: now, RetentionPolicy: r.RetentionPolicy, Reason: r.Reason, Message: r.Message}
+		if r.Point != nil {
+			entry.Point = []byte(r.Point.String())
+		} else if r.Line != nil {
+			entry.Point = r.Line
+		}
+
+		n, err := writeRejectedPointsEntry(l.w, &entry)
+		if err != nil {
+			return err
+		}
+		l.size += int64(n)
+	}
+
+	if err := l.w.Flush(); err != nil {
+		return err
+	}
+
+	if l.MaxSize > 0 && l.size >= l.MaxSize {
+		return l.rotate()
+	}
+	return nil
+}
+
+// rotate moves the active file aside, replacing any previously rotated file,
+// and opens a new active file. Must be called under lock.
+func (l *RejectedPointsLog) rotate() error {
+	if err := l.closeFile(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(filepath.Join(l.path, rejectedPointsFileName), filepath.Join(l.path, rejectedPointsRotatedFileName)); err != nil {
+		return err
+	}
+	l.Logger.Info("Rotated rejected points log", zap.String("path", l.path))
+
+	return l.openFile()
+}
+
+// writeRejectedPointsEntry encodes a single entry to w.
+func writeRejectedPointsEntry(w io.Writer, entry *RejectedPointsEntry) (int, error) {
+	entry.Size = len(entry.Point)
+	hdr, err := json.Marshal(entry)
+	if err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# ")
+	buf.Write(hdr)
+	buf.WriteByte('\n')
+	if len(entry.Point) > 0 {
+		buf.Write(entry.Point)
+		buf.WriteByte('\n')
+	}
+	return w.Write(buf.Bytes())
+}
+
+// RejectedPointsFiles returns the files of the rejected points log in dir,
+// ordered from oldest to newest.
+func RejectedPointsFiles(dir string) ([]string, error) {
+	var a []string
+	for _, name := range []string{rejectedPointsRotatedFileName, rejectedPointsFileName} {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		a = append(a, path)
+	}
+	return a, nil
+}
+
+// RejectedPointsReader decodes entries from a rejected points file.
+type RejectedPointsReader struct {
+	r *bufio.Reader
+}
+
+// NewRejectedPointsReader returns a new instance of RejectedPointsReader.
+func NewRejectedPointsReader(r io.Reader) *RejectedPointsReader {
+	return &RejectedPointsReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next entry. Returns io.EOF once all entries have been read.
+func (r *RejectedPointsReader) Next() (*RejectedPointsEntry, error) {
+	line, err := r.r.ReadBytes('\n')
+	if err == io.EOF && len(line) == 0 {
+		return nil, io.EOF
+	} else if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	line = bytes.TrimSuffix(line, []byte{'\n'})
+	if !bytes.HasPrefix(line, []byte("# ")) {
+		return nil, ErrInvalidRejectedPointsEntry
+	}
+
+	var entry RejectedPointsEntry
+	if err := json.Unmarshal(line[2:], &entry); err != nil {
+		return nil, fmt.Errorf("%s: %s", ErrInvalidRejectedPointsEntry, err)
+	}
+
+	if entry.Size > 0 {
+		// Read the point and its trailing newline.
+		entry.Point = make([]byte, entry.Size+1)
+		if _, err := io.ReadFull(r.r, entry.Point); err != nil {
+			return nil, ErrInvalidRejectedPointsEntry
+		}
+		entry.Point = entry.Point[:entry.Size]
+	}
+	return &entry, nil
+}
+
+// ReadRejectedPointsDir reads all entries from the rejected points log in dir,
+// ordered from oldest to newest.
+func ReadRejectedPointsDir(dir string) ([]*RejectedPointsEntry, error) {
+	paths, err := RejectedPointsFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*RejectedPointsEntry
+	for _, path := range paths {
+		if err := func() error {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			r := NewRejectedPointsReader(f)
+			for {
+				entry, err := r.Next()
+				if err == io.EOF {
+					return nil
+				} else if err != nil {
+					return fmt.Errorf("%s: %s", path, err)
+				}
+				entries = append(entries, entry)
+			}
+		}(); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
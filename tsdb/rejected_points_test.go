@@ -0,0 +1,114 @@
+package tsdb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+// Ensure rejected points can be written to and read back from the log.
+func TestRejectedPointsLog_WriteRejectedPoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tsdb-rejected-points-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := tsdb.NewRejectedPointsLog(dir)
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	if err := l.WriteRejectedPoints([]tsdb.RejectedPoint{
+		{Point: models.MustNewPoint("cpu", models.NewTags(map[string]string{"host": "a"}), models.Fields{"value": "x"}, time.Unix(0, 10)), RetentionPolicy: "rp1", Reason: tsdb.RejectFieldTypeConflict, Message: "conflict"},
+		{Line: []byte("cpu value="), Reason: tsdb.RejectParseError, Message: "unable to parse 'cpu value='"},
+		{Reason: tsdb.RejectParseError, Message: "unable to parse graphite line"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := l.Entries()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 3 {
+		t.Fatalf("unexpected entry count: %d", len(entries))
+	}
+
+	if got, exp := string(entries[0].Point), `cpu,host=a value="x" 10`; got != exp {
+		t.Fatalf("unexpected point: got %q, exp %q", got, exp)
+	} else if got, exp := entries[0].Reason, tsdb.RejectFieldTypeConflict; got != exp {
+		t.Fatalf("unexpected reason: got %q, exp %q", got, exp)
+	} else if got, exp := entries[0].Message, "conflict"; got != exp {
+		t.Fatalf("unexpected message: got %q, exp %q", got, exp)
+	} else if got, exp := entries[0].RetentionPolicy, "rp1"; got != exp {
+		t.Fatalf("unexpected retention policy: got %q, exp %q", got, exp)
+	}
+
+	// The raw line is kept for lines that failed to parse.
+	if got, exp := string(entries[1].Point), "cpu value="; got != exp {
+		t.Fatalf("unexpected point: got %q, exp %q", got, exp)
+	} else if got, exp := entries[1].Reason, tsdb.RejectParseError; got != exp {
+		t.Fatalf("unexpected reason: got %q, exp %q", got, exp)
+	} else if entries[1].RetentionPolicy != "" {
+		t.Fatalf("unexpected retention policy: %q", entries[1].RetentionPolicy)
+	}
+
+	if len(entries[2].Point) != 0 {
+		t.Fatalf("unexpected point: %q", entries[2].Point)
+	}
+
+	// The log should be readable as line protocol. Only the raw line fails
+	// to parse again.
+	buf, err := ioutil.ReadFile(filepath.Join(l.Path(), "rejected.lp"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, err := models.ParsePoints(buf)
+	if perr, ok := err.(*models.ParseError); !ok || len(perr.Lines) != 1 {
+		t.Fatalf("unexpected parse error: %v", err)
+	} else if len(points) != 1 {
+		t.Fatalf("unexpected point count: %d", len(points))
+	}
+}
+
+// Ensure the log is rotated once it reaches its maximum size.
+func TestRejectedPointsLog_Rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tsdb-rejected-points-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := tsdb.NewRejectedPointsLog(dir)
+	l.MaxSize = 1
+	if err := l.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	for _, msg := range []string{"a", "b", "c"} {
+		if err := l.WriteRejectedPoints([]tsdb.RejectedPoint{{Reason: tsdb.RejectParseError, Message: msg}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if files, err := tsdb.RejectedPointsFiles(dir); err != nil {
+		t.Fatal(err)
+	} else if len(files) != 2 {
+		t.Fatalf("unexpected file count: %d", len(files))
+	}
+
+	// Only the most recently rotated file should be kept.
+	entries, err := l.Entries()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 || entries[0].Message != "c" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
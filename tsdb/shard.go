@@ -105,6 +105,40 @@ func (e ShardError) Error() string {
 	return fmt.Sprintf("[shard %d] %s", e.id, e.Err)
 }
 
+// Reasons a point may be rejected from a write request.
+const (
+	RejectParseError           = "parse error"
+	RejectInvalidTag           = "invalid tag"
+	RejectInvalidField         = "invalid field"
+	RejectFieldTypeConflict    = "field type conflict"
+	RejectBeyondRetention      = "beyond retention"
+	RejectMaxSeriesPerDatabase = "max-series-per-database"
+	RejectMaxValuesPerTag      = "max-values-per-tag"
+	RejectSeriesCreation       = "series creation"
+)
+
+// RejectedPoint describes a single point that was dropped from a write request.
+type RejectedPoint struct {
+	// The point that was dropped. This is nil if only the series key is known.
+	Point models.Point
+
+	// The series key of the dropped point.
+	Key []byte
+
+	// The raw line protocol of a line that could not be parsed.
+	Line []byte
+
+	// The retention policy the point was written to. Empty for the default
+	// retention policy.
+	RetentionPolicy string
+
+	// The category of the rejection. This is one of the Reject* constants.
+	Reason string
+
+	// A description of the specific failure.
+	Message string
+}
+
 // PartialWriteError indicates a write request could only write a portion of the
 // requested values.
 type PartialWriteError struct {
@@ -113,12 +147,26 @@ type PartialWriteError struct {
 
 	// A sorted slice of series keys that were dropped.
 	DroppedKeys [][]byte
+
+	// The individual points that were dropped. This may contain fewer
+	// entries than Dropped if a point was counted more than once.
+	Rejected []RejectedPoint
 }
 
 func (e PartialWriteError) Error() string {
 	return fmt.Sprintf("partial write: %s dropped=%d", e.Reason, e.Dropped)
 }
 
+// Merge adds the dropped points from other to e. The reason of e is kept
+// unless it is empty.
+func (e *PartialWriteError) Merge(other PartialWriteError) {
+	if e.Reason == "" {
+		e.Reason = other.Reason
+	}
+	e.Dropped += other.Dropped
+	e.Rejected = append(e.Rejected, other.Rejected...)
+}
+
 // Shard represents a self-contained time series database. An inverted index of
 // the measurement and tag data is kept along with the raw time series data.
 // Data can be split across many shards. The query engine in TSDB is responsible
@@ -493,6 +541,17 @@ func (s *Shard) WritePoints(points []models.Point) error {
 		// There was a partial write (points dropped), hold onto the error to return
 		// to the caller, but continue on writing the remaining points.
 		writeError = err
+
+		// Record the dropped points so they can be inspected and replayed.
+		if rlog := s.options.RejectedPoints; rlog != nil {
+			rejected := writeError.(PartialWriteError).Rejected
+			for i := range rejected {
+				rejected[i].RetentionPolicy = s.retentionPolicy
+			}
+			if err := rlog.WriteRejectedPoints(rejected); err != nil {
+				s.logger.Info("Failed to record rejected points", zap.Error(err))
+			}
+		}
 	}
 	atomic.AddInt64(&s.stats.FieldsCreated, int64(len(fieldsToCreate)))
 
@@ -513,6 +572,21 @@ func (s *Shard) WritePoints(points []models.Point) error {
 	return writeError
 }
 
+// createSeriesListIfNotExists creates the series of the points. Indexes that
+// record the time ranges of their series are passed the times of the points.
+func (s *Shard) createSeriesListIfNotExists(engine Engine, points []models.Point, keys, names [][]byte, tagsSlice []models.Tags) error {
+	index, ok := s.index.(SeriesTimeIndex)
+	if !ok {
+		return engine.CreateSeriesListIfNotExists(keys, names, tagsSlice)
+	}
+
+	times := make([]int64, len(points))
+	for i, p := range points {
+		times[i] = p.UnixNano()
+	}
+	return index.CreateSeriesListIfNotExistsAt(keys, names, tagsSlice, times)
+}
+
 // validateSeriesAndFields checks which series and fields are new and whose metadata should be saved and indexed.
 func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point, []*FieldCreate, error) {
 	var (
@@ -520,6 +594,7 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 		err            error
 		dropped        int
 		reason         string // only first error reason is set unless returned from CreateSeriesListIfNotExists
+		rejected       []RejectedPoint
 	)
 
 	// Create all series against the index in bulk.
@@ -533,9 +608,11 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 		tags := p.Tags()
 		if v := tags.Get(timeBytes); v != nil {
 			dropped++
+			msg := fmt.Sprintf("invalid tag key: input tag \"%s\" on measurement \"%s\" is invalid", "time", string(p.Name()))
 			if reason == "" {
-				reason = fmt.Sprintf("invalid tag key: input tag \"%s\" on measurement \"%s\" is invalid", "time", string(p.Name()))
+				reason = msg
 			}
+			rejected = append(rejected, RejectedPoint{Point: p, Key: p.Key(), Reason: RejectInvalidTag, Message: msg})
 			continue
 		}
 		keys[j] = p.Key()
@@ -553,12 +630,17 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 
 	// Add new series. Check for partial writes.
 	var droppedKeys [][]byte
-	if err := engine.CreateSeriesListIfNotExists(keys, names, tagsSlice); err != nil {
+	var droppedReasons map[string]RejectedPoint
+	if err := s.createSeriesListIfNotExists(engine, points, keys, names, tagsSlice); err != nil {
 		switch err := err.(type) {
 		case *PartialWriteError:
 			reason = err.Reason
 			dropped += err.Dropped
 			droppedKeys = err.DroppedKeys
+			droppedReasons = make(map[string]RejectedPoint, len(err.Rejected))
+			for _, r := range err.Rejected {
+				droppedReasons[string(r.Key)] = r
+			}
 			atomic.AddInt64(&s.stats.WritePointsDropped, int64(err.Dropped))
 		default:
 			return nil, nil, err
@@ -585,9 +667,11 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 
 		if !validField {
 			dropped++
+			msg := fmt.Sprintf("invalid field name: input field \"%s\" on measurement \"%s\" is invalid", "time", string(p.Name()))
 			if reason == "" {
-				reason = fmt.Sprintf("invalid field name: input field \"%s\" on measurement \"%s\" is invalid", "time", string(p.Name()))
+				reason = msg
 			}
+			rejected = append(rejected, RejectedPoint{Point: p, Key: keys[i], Reason: RejectInvalidField, Message: msg})
 			continue
 		}
 
@@ -596,6 +680,13 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 		// Skip points if keys have been dropped.
 		// The drop count has already been incremented during series creation.
 		if len(droppedKeys) > 0 && bytesutil.Contains(droppedKeys, keys[i]) {
+			r, ok := droppedReasons[string(keys[i])]
+			if !ok {
+				// The index dropped the series without saying why.
+				r = RejectedPoint{Key: keys[i], Reason: RejectSeriesCreation, Message: reason}
+			}
+			r.Point = p
+			rejected = append(rejected, r)
 			continue
 		}
 
@@ -637,8 +728,12 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 				if f.Type != fieldType {
 					atomic.AddInt64(&s.stats.WritePointsDropped, 1)
 					dropped++
+					msg := fmt.Sprintf("%s: input field \"%s\" on measurement \"%s\" is type %s, already exists as type %s", ErrFieldTypeConflict, iter.FieldKey(), name, fieldType, f.Type)
 					if reason == "" {
-						reason = fmt.Sprintf("%s: input field \"%s\" on measurement \"%s\" is type %s, already exists as type %s", ErrFieldTypeConflict, iter.FieldKey(), name, fieldType, f.Type)
+						reason = msg
+					}
+					if !skip {
+						rejected = append(rejected, RejectedPoint{Point: p, Key: keys[i], Reason: RejectFieldTypeConflict, Message: msg})
 					}
 					skip = true
 				} else {
@@ -659,7 +754,7 @@ func (s *Shard) validateSeriesAndFields(points []models.Point) ([]models.Point,
 	points = points[:n]
 
 	if dropped > 0 {
-		err = PartialWriteError{Reason: reason, Dropped: dropped}
+		err = PartialWriteError{Reason: reason, Dropped: dropped, Rejected: rejected}
 	}
 
 	return points, fieldsToCreate, err
@@ -822,6 +917,16 @@ func (s *Shard) CreateCursor(ctx context.Context, r *CursorRequest) (Cursor, err
 	return engine.CreateCursor(ctx, r)
 }
 
+// EstimateSeriesCost returns an estimate of the cost of reading the values of
+// the field of the series between tmin and tmax.
+func (s *Shard) EstimateSeriesCost(seriesKey, field string, tmin, tmax int64) (query.IteratorCost, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return query.IteratorCost{}, err
+	}
+	return engine.EstimateSeriesCost(seriesKey, field, tmin, tmax), nil
+}
+
 // FieldDimensions returns unique sets of fields and dimensions across a list of sources.
 func (s *Shard) FieldDimensions(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
 	engine, err := s.engine()
@@ -1178,6 +1283,39 @@ func (a Shards) MeasurementsByRegex(re *regexp.Regexp) []string {
 	return names
 }
 
+// MeasurementNamesByExpr returns the sorted, unique set of measurements
+// matching the optional condition, for all the shards. A time range in the
+// condition restricts the measurements to those with points in that range.
+func (a Shards) MeasurementNamesByExpr(auth query.Authorizer, cond influxql.Expr) ([][]byte, error) {
+	cond, timeRange, err := influxql.ConditionExpr(cond, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	is := IndexSet{Indexes: make([]Index, 0, len(a))}
+	for _, sh := range a {
+		index, err := sh.Index()
+		if err != nil {
+			return nil, err
+		}
+
+		if is.SeriesFile == nil {
+			is.SeriesFile = sh.sfile
+		}
+		is.Indexes = append(is.Indexes, index)
+	}
+
+	if len(is.Indexes) == 0 {
+		return nil, nil
+	}
+
+	is = is.DedupeInmemIndexes()
+	if a[0].options.Config.MetadataTimeBoundsEnabled {
+		is = is.TimeBounded(timeRange)
+	}
+	return is.MeasurementNamesByExpr(auth, cond)
+}
+
 func (a Shards) FieldDimensions(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
 	fields = make(map[string]influxql.DataType)
 	dimensions = make(map[string]struct{})
@@ -262,6 +262,16 @@ func TestShard_MaxTagValuesLimit(t *testing.T) {
 		t.Fatalf("unexpected error message:\n\texp = %s\n\tgot = %s", exp, got)
 	}
 
+	// The dropped point should be reported with its rejection reason.
+	werr := err.(tsdb.PartialWriteError)
+	if got, exp := len(werr.Rejected), 1; got != exp {
+		t.Fatalf("unexpected number of rejected points: got %d, exp %d", got, exp)
+	} else if got, exp := werr.Rejected[0].Reason, tsdb.RejectMaxValuesPerTag; got != exp {
+		t.Fatalf("unexpected rejection reason: got %q, exp %q", got, exp)
+	} else if werr.Rejected[0].Point != pt {
+		t.Fatalf("unexpected rejected point: %v", werr.Rejected[0].Point)
+	}
+
 	sh.Close()
 }
 
@@ -46,6 +46,7 @@ type Store struct {
 	shards            map[uint64]*Shard
 	databases         map[string]struct{}
 	sfiles            map[string]*SeriesFile
+	rlogs             map[string]*RejectedPointsLog
 	SeriesFileMaxSize int64 // Determines size of series file mmap. Can be altered in tests.
 	path              string
 
@@ -70,6 +71,7 @@ func NewStore(path string) *Store {
 		databases:     make(map[string]struct{}),
 		path:          path,
 		sfiles:        make(map[string]*SeriesFile),
+		rlogs:         make(map[string]*RejectedPointsLog),
 		indexes:       make(map[string]interface{}),
 		EngineOptions: NewEngineOptions(),
 		Logger:        logger,
@@ -224,6 +226,12 @@ func (s *Store) loadShards() error {
 			return err
 		}
 
+		// Open the rejected points log, if enabled.
+		rlog, err := s.openRejectedPointsLog(db.Name())
+		if err != nil {
+			return err
+		}
+
 		// Load each retention policy within the database directory.
 		rpDirs, err := ioutil.ReadDir(filepath.Join(s.path, db.Name()))
 		if err != nil {
@@ -266,6 +274,7 @@ func (s *Store) loadShards() error {
 					// Copy options and assign shared index.
 					opt := s.EngineOptions
 					opt.InmemIndex = idx
+					opt.RejectedPoints = rlog
 
 					// Provide an implementation of the ShardIDSets
 					opt.SeriesIDSets = shardSet{store: s, db: db}
@@ -348,8 +357,15 @@ func (s *Store) Close() error {
 		}
 	}
 
+	for _, rlog := range s.rlogs {
+		if err := rlog.Close(); err != nil {
+			return err
+		}
+	}
+
 	s.shards = nil
 	s.sfiles = map[string]*SeriesFile{}
+	s.rlogs = map[string]*RejectedPointsLog{}
 	s.opened = false // Store may now be opened again.
 	s.mu.Unlock()
 	return nil
@@ -377,6 +393,70 @@ func (s *Store) seriesFile(database string) *SeriesFile {
 	return s.sfiles[database]
 }
 
+// openRejectedPointsLog either returns or creates the rejected points log for
+// the provided database. Returns nil if rejected points are not being recorded.
+// It must be called under a full lock.
+func (s *Store) openRejectedPointsLog(database string) (*RejectedPointsLog, error) {
+	if !s.EngineOptions.Config.RejectedPointsEnabled {
+		return nil, nil
+	} else if rlog := s.rlogs[database]; rlog != nil {
+		return rlog, nil
+	}
+
+	rlog := NewRejectedPointsLog(filepath.Join(s.EngineOptions.Config.RejectedPointsDir, database))
+	rlog.MaxSize = int64(s.EngineOptions.Config.RejectedPointsMaxSize)
+	rlog.Logger = s.baseLogger
+	if err := rlog.Open(); err != nil {
+		return nil, err
+	}
+	s.rlogs[database] = rlog
+	return rlog, nil
+}
+
+// WriteRejectedPoints records points dropped from writes to a database outside
+// of a shard, such as those rejected by an input service. It is a no-op if
+// rejected points are not being recorded or the store has no data for the
+// database.
+func (s *Store) WriteRejectedPoints(database string, rejected []RejectedPoint) error {
+	if len(rejected) == 0 || !s.EngineOptions.Config.RejectedPointsEnabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	if _, ok := s.databases[database]; !ok {
+		s.mu.Unlock()
+		return nil
+	}
+	rlog, err := s.openRejectedPointsLog(database)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return rlog.WriteRejectedPoints(rejected)
+}
+
+// RejectedPoints returns the points that have been rejected from writes to a
+// database, ordered from oldest to newest.
+func (s *Store) RejectedPoints(database string) ([]*RejectedPointsEntry, error) {
+	if !s.EngineOptions.Config.RejectedPointsEnabled {
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	_, ok := s.databases[database]
+	rlog := s.rlogs[database]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, nil
+	} else if rlog == nil {
+		// Nothing has been rejected since the store was opened, but the log
+		// may hold points rejected before.
+		return ReadRejectedPointsDir(filepath.Join(s.EngineOptions.Config.RejectedPointsDir, database))
+	}
+	return rlog.Entries()
+}
+
 // createIndexIfNotExists returns a shared index for a database, if the inmem
 // index is being used. If the TSI index is being used, then this method is
 // basically a no-op.
@@ -486,10 +566,17 @@ func (s *Store) CreateShard(database, retentionPolicy string, shardID uint64, en
 		return err
 	}
 
+	// Retrieve the rejected points log, if enabled.
+	rlog, err := s.openRejectedPointsLog(database)
+	if err != nil {
+		return err
+	}
+
 	// Copy index options and pass in shared index.
 	opt := s.EngineOptions
 	opt.InmemIndex = idx
 	opt.SeriesIDSets = shardSet{store: s, db: database}
+	opt.RejectedPoints = rlog
 
 	path := filepath.Join(s.path, database, retentionPolicy, strconv.FormatUint(shardID, 10))
 	shard := NewShard(shardID, path, walPath, sfile, opt)
@@ -635,6 +722,14 @@ func (s *Store) DeleteDatabase(name string) error {
 		}
 	}
 
+	// Close the rejected points log.
+	if rlog := s.rlogs[name]; rlog != nil {
+		delete(s.rlogs, name)
+		if err := rlog.Close(); err != nil {
+			return err
+		}
+	}
+
 	// extra sanity check to make sure that even if someone named their database "../.."
 	// that we don't delete everything because of it, they'll just have extra files forever
 	if filepath.Clean(s.path) != filepath.Dir(dbPath) {
@@ -647,6 +742,11 @@ func (s *Store) DeleteDatabase(name string) error {
 	if err := os.RemoveAll(filepath.Join(s.EngineOptions.Config.WALDir, name)); err != nil {
 		return err
 	}
+	if dir := s.EngineOptions.Config.RejectedPointsDir; dir != "" {
+		if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
 
 	for _, sh := range shards {
 		delete(s.shards, sh.id)
@@ -1130,8 +1230,14 @@ func (s *Store) WriteToShard(shardID uint64, points []models.Point) error {
 
 // MeasurementNames returns a slice of all measurements. Measurements accepts an
 // optional condition expression. If cond is nil, then all measurements for the
-// database will be returned.
+// database will be returned. A time range in cond restricts the measurements
+// to those with points in that range.
 func (s *Store) MeasurementNames(auth query.Authorizer, database string, cond influxql.Expr) ([][]byte, error) {
+	cond, timeRange, err := influxql.ConditionExpr(cond, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	s.mu.RLock()
 	shards := s.filterShards(byDatabase(database))
 	s.mu.RUnlock()
@@ -1151,6 +1257,9 @@ func (s *Store) MeasurementNames(auth query.Authorizer, database string, cond in
 		is.Indexes = append(is.Indexes, index)
 	}
 	is = is.DedupeInmemIndexes()
+	if s.EngineOptions.Config.MetadataTimeBoundsEnabled {
+		is = is.TimeBounded(timeRange)
+	}
 	return is.MeasurementNamesByExpr(auth, cond)
 }
 
@@ -1184,11 +1293,18 @@ func (a tagKeysSlice) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a tagKeysSlice) Less(i, j int) bool { return bytes.Compare(a[i].name, a[j].name) == -1 }
 
 // TagKeys returns the tag keys in the given database, matching the condition.
+// A time range in the condition restricts the tag keys to those of the series
+// with points in that range.
 func (s *Store) TagKeys(auth query.Authorizer, shardIDs []uint64, cond influxql.Expr) ([]TagKeys, error) {
 	if len(shardIDs) == 0 {
 		return nil, nil
 	}
 
+	cond, timeRange, err := influxql.ConditionExpr(cond, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	measurementExpr := influxql.CloneExpr(cond)
 	measurementExpr = influxql.Reduce(influxql.RewriteExpr(measurementExpr, func(e influxql.Expr) influxql.Expr {
 		switch e := e.(type) {
@@ -1238,6 +1354,9 @@ func (s *Store) TagKeys(auth query.Authorizer, shardIDs []uint64, cond influxql.
 
 	// Determine list of measurements.
 	is = is.DedupeInmemIndexes()
+	if s.EngineOptions.Config.MetadataTimeBoundsEnabled {
+		is = is.TimeBounded(timeRange)
+	}
 	names, err := is.MeasurementNamesByExpr(nil, measurementExpr)
 	if err != nil {
 		return nil, err
@@ -1339,12 +1458,20 @@ func (a tagValuesSlice) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a tagValuesSlice) Less(i, j int) bool { return bytes.Compare(a[i].name, a[j].name) == -1 }
 
 // TagValues returns the tag keys and values for the provided shards, where the
-// tag values satisfy the provided condition.
+// tag values satisfy the provided condition. A time range in the condition
+// restricts the values to those of the series with points in that range.
 func (s *Store) TagValues(auth query.Authorizer, shardIDs []uint64, cond influxql.Expr) ([]TagValues, error) {
 	if cond == nil {
 		return nil, errors.New("a condition is required")
 	}
 
+	cond, timeRange, err := influxql.ConditionExpr(cond, nil)
+	if err != nil {
+		return nil, err
+	} else if cond == nil {
+		return nil, errors.New("a condition is required")
+	}
+
 	measurementExpr := influxql.CloneExpr(cond)
 	measurementExpr = influxql.Reduce(influxql.RewriteExpr(measurementExpr, func(e influxql.Expr) influxql.Expr {
 		switch e := e.(type) {
@@ -1391,6 +1518,9 @@ func (s *Store) TagValues(auth query.Authorizer, shardIDs []uint64, cond influxq
 	}
 	s.mu.RUnlock()
 	is = is.DedupeInmemIndexes()
+	if s.EngineOptions.Config.MetadataTimeBoundsEnabled {
+		is = is.TimeBounded(timeRange)
+	}
 
 	// Stores each list of TagValues for each measurement.
 	var allResults []tagValues
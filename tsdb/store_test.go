@@ -1339,6 +1339,139 @@ func TestStore_TagValues_Auth(t *testing.T) {
 	}
 }
 
+// Ensure the time range of a condition bounds the tag values and measurements
+// to those of the series written to within it when the index records the time
+// ranges of its series.
+func TestStore_TagValues_TimeBounded(t *testing.T) {
+	t.Parallel()
+
+	test := func(index string) error {
+		s := MustOpenStore(index)
+		defer s.Close()
+
+		s.MustCreateShardWithData("db0", "rp0", 0,
+			`cpu,host=serverA value=1 10`,
+			`cpu,host=serverB value=2 100`,
+			`mem,host=serverA value=3 10`,
+		)
+
+		check := func(exp []tsdb.TagValues, expNames [][]byte) error {
+			values, err := s.TagValues(nil, []uint64{0}, influxql.MustParseExpr(`_tagKey = 'host' AND time >= 50s`))
+			if err != nil {
+				return err
+			} else if !reflect.DeepEqual(values, exp) {
+				return fmt.Errorf("got tag values %v, expected %v", values, exp)
+			}
+
+			names, err := s.MeasurementNames(nil, "db0", influxql.MustParseExpr(`time >= 50s`))
+			if err != nil {
+				return err
+			} else if !reflect.DeepEqual(names, expNames) {
+				return fmt.Errorf("got names %s, expected %s", names, expNames)
+			}
+			return nil
+		}
+
+		all := []tsdb.TagValues{
+			createTagValues("cpu", map[string][]string{"host": {"serverA", "serverB"}}),
+			createTagValues("mem", map[string][]string{"host": {"serverA"}}),
+		}
+		allNames := [][]byte{[]byte("cpu"), []byte("mem")}
+
+		// The inmem index doesn't record the time ranges of its series.
+		if index == "inmem" {
+			return check(all, allNames)
+		}
+
+		exp := []tsdb.TagValues{createTagValues("cpu", map[string][]string{"host": {"serverB"}})}
+		if err := check(exp, [][]byte{[]byte("cpu")}); err != nil {
+			return err
+		} else if err := s.Reopen(); err != nil {
+			return err
+		} else if err := check(exp, [][]byte{[]byte("cpu")}); err != nil {
+			return err
+		}
+
+		// The time range is ignored if time bounds are disabled.
+		s.EngineOptions.Config.MetadataTimeBoundsEnabled = false
+		if err := check(all, allNames); err != nil {
+			return err
+		}
+		s.EngineOptions.Config.MetadataTimeBoundsEnabled = true
+
+		// Dropped series are excluded.
+		if err := s.DeleteSeries("db0", nil, influxql.MustParseExpr(`host = 'serverB'`)); err != nil {
+			return err
+		}
+		return check([]tsdb.TagValues{}, nil)
+	}
+
+	for _, index := range tsdb.RegisteredIndexes() {
+		t.Run(index, func(t *testing.T) {
+			if err := test(index); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// Ensure rejected points are recorded outside of the data directory and only
+// for the databases of the store.
+func TestStore_WriteRejectedPoints(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "influxdb-rejected-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	open := func(s *Store) error {
+		s.EngineOptions.Config.RejectedPointsEnabled = true
+		s.EngineOptions.Config.RejectedPointsDir = dir
+		return s.Open()
+	}
+
+	s := NewStore()
+	if err := open(s); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	rejected := []tsdb.RejectedPoint{{Reason: tsdb.RejectParseError, Message: "bad line"}}
+	if err := s.WriteRejectedPoints("db1", rejected); err != nil {
+		t.Fatal(err)
+	} else if _, err := os.Stat(filepath.Join(dir, "db1")); !os.IsNotExist(err) {
+		t.Fatalf("expected no rejected points log for an unknown database: %v", err)
+	}
+
+	// A retention policy may have any name.
+	s.MustCreateShardWithData("db0", "_rejected", 0, `cpu value=1 10`)
+	if err := s.WriteRejectedPoints("db0", rejected); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.Store = tsdb.NewStore(s.Path())
+	s.EngineOptions.Config.WALDir = filepath.Join(s.Path(), "wal")
+	if err := open(s); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Shard(0) == nil {
+		t.Fatal("expected shard to be loaded")
+	}
+
+	entries, err := s.RejectedPoints("db0")
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 || entries[0].Message != "bad line" {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+}
+
 // Helper to create some tag values
 func createTagValues(mname string, kvs map[string][]string) tsdb.TagValues {
 	var sz int